@@ -40,6 +40,9 @@ rm -f ../../zoneinfo.zip
 zip -0 -r ../../zoneinfo.zip *
 cd ../..
 
+# Regenerate the compressed copy embedded by package time/tzdata.
+(cd ../../src/pkg/time/tzdata && go run generate_zipdata.go ../../../../lib/time/zoneinfo.zip)
+
 echo
 if [ "$1" == "-work" ]; then 
 	echo Left workspace behind in work/.
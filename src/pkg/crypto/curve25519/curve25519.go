@@ -0,0 +1,72 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package curve25519 implements the X25519 function, elliptic curve
+// Diffie-Hellman over Curve25519, as specified in RFC 7748.
+//
+// All operations take time independent of the secret scalar.
+package curve25519
+
+import "crypto/internal/field25519"
+
+var a24 = field25519.Element{0xdb41, 1} // (486662-2)/4 = 121665
+
+// ScalarMult sets dst to the product in*base where dst and base are the x
+// coordinates of group points and all values are in little-endian form.
+// The scalar is clamped as described in RFC 7748.
+func ScalarMult(dst, in, base *[32]byte) {
+	var z [32]byte
+	copy(z[:], in[:])
+	z[0] &= 248
+	z[31] &= 127
+	z[31] |= 64
+
+	var x, a, b, c, d, e, f field25519.Element
+	x.Unpack(base[:])
+	b = x
+	a[0] = 1
+	d[0] = 1
+
+	// Montgomery ladder. (a:c) and (b:d) hold x(n*P) and x((n+1)*P) in
+	// projective form.
+	for i := 254; i >= 0; i-- {
+		r := int64(z[i>>3]>>uint(i&7)) & 1
+		field25519.Swap(&a, &b, r)
+		field25519.Swap(&c, &d, r)
+		field25519.Add(&e, &a, &c)
+		field25519.Sub(&a, &a, &c)
+		field25519.Add(&c, &b, &d)
+		field25519.Sub(&b, &b, &d)
+		field25519.Square(&d, &e)
+		field25519.Square(&f, &a)
+		field25519.Mul(&a, &c, &a)
+		field25519.Mul(&c, &b, &e)
+		field25519.Add(&e, &a, &c)
+		field25519.Sub(&a, &a, &c)
+		field25519.Square(&b, &a)
+		field25519.Sub(&c, &d, &f)
+		field25519.Mul(&a, &c, &a24)
+		field25519.Add(&a, &a, &d)
+		field25519.Mul(&c, &c, &a)
+		field25519.Mul(&a, &d, &f)
+		field25519.Mul(&d, &b, &x)
+		field25519.Square(&b, &e)
+		field25519.Swap(&a, &b, r)
+		field25519.Swap(&c, &d, r)
+	}
+
+	field25519.Invert(&c, &c)
+	field25519.Mul(&a, &a, &c)
+	a.Pack(dst[:])
+}
+
+// Basepoint is the x coordinate of the generator of the curve.
+var Basepoint = [32]byte{9}
+
+// ScalarBaseMult sets dst to the product in*base where dst and base are the
+// x coordinates of group points, base is the standard generator and all
+// values are in little-endian form.
+func ScalarBaseMult(dst, in *[32]byte) {
+	ScalarMult(dst, in, &Basepoint)
+}
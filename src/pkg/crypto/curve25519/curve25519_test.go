@@ -0,0 +1,84 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package curve25519
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func fromHex(s string) (out [32]byte) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	copy(out[:], b)
+	return
+}
+
+// From RFC 7748, section 5.2.
+var scalarMultTests = []struct {
+	scalar, point, out string
+}{
+	{
+		"a546e36bf0527c9d3b16154b82465edd62144c0ac1fc5a18506a2244ba449ac4",
+		"e6db6867583030db3594c1a424b15f7c726624ec26b3353b10a903a6d0ab1c4c",
+		"c3da55379de9c6908e94ea4df28d084f32eccf03491c71f754b4075577a28552",
+	},
+	{
+		"4b66e9d4d1b4673c5ad22691957d6af5c11b6421e0ea01d42ca4169e7918ba0d",
+		"e5210f12786811d3f4b7959d0538ae2c31dbe7106fc03c3efc4cd549c715a493",
+		"95cbde9476e8907d7aade45cb4b873f88b595a68799fa152e6f8f7647aac7957",
+	},
+}
+
+func TestScalarMult(t *testing.T) {
+	for i, test := range scalarMultTests {
+		scalar, point, want := fromHex(test.scalar), fromHex(test.point), fromHex(test.out)
+		var out [32]byte
+		ScalarMult(&out, &scalar, &point)
+		if out != want {
+			t.Errorf("#%d: got %x, want %x", i, out, want)
+		}
+	}
+}
+
+// TestIterated runs the iterated test from RFC 7748, section 5.2.
+func TestIterated(t *testing.T) {
+	want := fromHex("684cf59ba83309552800ef566f2f4d3c1c3887c49360e3875f2eb94d99532c51")
+	k, u := Basepoint, Basepoint
+	for i := 0; i < 1000; i++ {
+		var out [32]byte
+		ScalarMult(&out, &k, &u)
+		u, k = k, out
+	}
+	if k != want {
+		t.Errorf("after 1000 iterations got %x, want %x", k, want)
+	}
+}
+
+func TestDiffieHellman(t *testing.T) {
+	alicePriv := fromHex("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	bobPriv := fromHex("5dab087e624a8a4b79e17f8b83800ee66f3bb1292618b6fd1c2f8b27ff88e0eb")
+	alicePub := fromHex("8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a")
+	bobPub := fromHex("de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f")
+	shared := fromHex("4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742")
+
+	var out, out2 [32]byte
+	ScalarBaseMult(&out, &alicePriv)
+	if out != alicePub {
+		t.Errorf("alice public: got %x, want %x", out, alicePub)
+	}
+	ScalarBaseMult(&out, &bobPriv)
+	if out != bobPub {
+		t.Errorf("bob public: got %x, want %x", out, bobPub)
+	}
+	ScalarMult(&out, &alicePriv, &bobPub)
+	ScalarMult(&out2, &bobPriv, &alicePub)
+	if !bytes.Equal(out[:], shared[:]) || out2 != shared {
+		t.Errorf("shared secrets: got %x and %x, want %x", out, out2, shared)
+	}
+}
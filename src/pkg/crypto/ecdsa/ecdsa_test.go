@@ -7,11 +7,13 @@ package ecdsa
 import (
 	"bufio"
 	"compress/bzip2"
+	"crypto"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/sha512"
+	"encoding/asn1"
 	"encoding/hex"
 	"hash"
 	"io"
@@ -72,6 +74,41 @@ func TestSignAndVerify(t *testing.T) {
 	testSignAndVerify(t, elliptic.P521(), "p521")
 }
 
+func testSigner(t *testing.T, c elliptic.Curve, tag string) {
+	priv, _ := GenerateKey(c, rand.Reader)
+
+	var signer crypto.Signer = priv
+	if pub, ok := signer.Public().(*PublicKey); !ok || pub != &priv.PublicKey {
+		t.Errorf("%s: Public returned the wrong key", tag)
+	}
+
+	hashed := []byte("testing")
+	der, err := signer.Sign(rand.Reader, hashed, crypto.SHA256)
+	if err != nil {
+		t.Errorf("%s: error signing: %s", tag, err)
+		return
+	}
+
+	var sig ecdsaSignature
+	if rest, err := asn1.Unmarshal(der, &sig); err != nil || len(rest) != 0 {
+		t.Errorf("%s: error parsing signature: %v", tag, err)
+		return
+	}
+	if !Verify(&priv.PublicKey, hashed, sig.R, sig.S) {
+		t.Errorf("%s: Verify failed", tag)
+	}
+}
+
+func TestSigner(t *testing.T) {
+	testSigner(t, elliptic.P224(), "p224")
+	if testing.Short() {
+		return
+	}
+	testSigner(t, elliptic.P256(), "p256")
+	testSigner(t, elliptic.P384(), "p384")
+	testSigner(t, elliptic.P521(), "p521")
+}
+
 func fromHex(s string) *big.Int {
 	r, ok := new(big.Int).SetString(s, 16)
 	if !ok {
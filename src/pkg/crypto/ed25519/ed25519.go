@@ -0,0 +1,262 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ed25519 implements the Ed25519 signature algorithm. See
+// http://ed25519.cr.yp.to/ and RFC 8032.
+//
+// These functions are also compatible with the “Ed25519” function defined in
+// RFC 8032. Signing takes time independent of the private key.
+package ed25519
+
+import (
+	"bytes"
+	"crypto"
+	cryptorand "crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"io"
+	"strconv"
+)
+
+const (
+	// PublicKeySize is the size, in bytes, of public keys as used in this package.
+	PublicKeySize = 32
+	// PrivateKeySize is the size, in bytes, of private keys as used in this package.
+	PrivateKeySize = 64
+	// SignatureSize is the size, in bytes, of signatures generated and verified by this package.
+	SignatureSize = 64
+	// SeedSize is the size, in bytes, of private key seeds. These are the private key representations used by RFC 8032.
+	SeedSize = 32
+)
+
+// PublicKey is the type of Ed25519 public keys.
+type PublicKey []byte
+
+// PrivateKey is the type of Ed25519 private keys. It implements crypto.Signer.
+type PrivateKey []byte
+
+// Public returns the PublicKey corresponding to priv.
+func (priv PrivateKey) Public() crypto.PublicKey {
+	publicKey := make([]byte, PublicKeySize)
+	copy(publicKey, priv[32:])
+	return PublicKey(publicKey)
+}
+
+// Seed returns the private key seed corresponding to priv. It is provided for
+// interoperability with RFC 8032. RFC 8032's private keys correspond to seeds
+// in this package.
+func (priv PrivateKey) Seed() []byte {
+	seed := make([]byte, SeedSize)
+	copy(seed, priv[:32])
+	return seed
+}
+
+// Sign signs the given message with priv. Ed25519 performs two passes over
+// messages to be signed and therefore cannot handle pre-hashed messages. Thus
+// opts.HashFunc() must return zero to indicate the message hasn't been
+// hashed. This can be achieved by passing crypto.Hash(0) as the value for
+// opts.
+func (priv PrivateKey) Sign(rand io.Reader, message []byte, opts crypto.SignerOpts) (signature []byte, err error) {
+	if opts.HashFunc() != crypto.Hash(0) {
+		return nil, errors.New("ed25519: cannot sign hashed message")
+	}
+	return Sign(priv, message), nil
+}
+
+// GenerateKey generates a public/private key pair using entropy from rand.
+// If rand is nil, crypto/rand.Reader will be used.
+func GenerateKey(rand io.Reader) (PublicKey, PrivateKey, error) {
+	if rand == nil {
+		rand = cryptorand.Reader
+	}
+
+	seed := make([]byte, SeedSize)
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		return nil, nil, err
+	}
+
+	privateKey := NewKeyFromSeed(seed)
+	publicKey := make([]byte, PublicKeySize)
+	copy(publicKey, privateKey[32:])
+
+	return publicKey, privateKey, nil
+}
+
+// NewKeyFromSeed calculates a private key from a seed. It will panic if
+// len(seed) is not SeedSize. This function is provided for interoperability
+// with RFC 8032. RFC 8032's private keys correspond to seeds in this
+// package.
+func NewKeyFromSeed(seed []byte) PrivateKey {
+	if l := len(seed); l != SeedSize {
+		panic("ed25519: bad seed length: " + strconv.Itoa(l))
+	}
+
+	digest := sha512.Sum512(seed)
+	clamp(digest[:32])
+
+	var p point
+	scalarBaseMult(&p, digest[:32])
+
+	privateKey := make([]byte, PrivateKeySize)
+	copy(privateKey, seed)
+	p.pack(privateKey[32:])
+
+	return privateKey
+}
+
+// clamp prepares the first half of a key digest for use as a scalar.
+func clamp(s []byte) {
+	s[0] &= 248
+	s[31] &= 127
+	s[31] |= 64
+}
+
+// Sign signs the message with privateKey and returns a signature. It will
+// panic if len(privateKey) is not PrivateKeySize.
+func Sign(privateKey PrivateKey, message []byte) []byte {
+	if l := len(privateKey); l != PrivateKeySize {
+		panic("ed25519: bad private key length: " + strconv.Itoa(l))
+	}
+
+	h := sha512.New()
+	h.Write(privateKey[:32])
+	var digest, messageDigest, hramDigest [64]byte
+	h.Sum(digest[:0])
+	clamp(digest[:32])
+
+	h.Reset()
+	h.Write(digest[32:])
+	h.Write(message)
+	h.Sum(messageDigest[:0])
+
+	var r [32]byte
+	reduce(r[:], &messageDigest)
+
+	var R point
+	scalarBaseMult(&R, r[:])
+
+	signature := make([]byte, SignatureSize)
+	R.pack(signature[:32])
+
+	h.Reset()
+	h.Write(signature[:32])
+	h.Write(privateKey[32:])
+	h.Write(message)
+	h.Sum(hramDigest[:0])
+
+	var k [32]byte
+	reduce(k[:], &hramDigest)
+
+	// s = r + k*a mod l
+	var x [64]int64
+	for i := 0; i < 32; i++ {
+		x[i] = int64(r[i])
+	}
+	for i := 0; i < 32; i++ {
+		for j := 0; j < 32; j++ {
+			x[i+j] += int64(k[i]) * int64(digest[j])
+		}
+	}
+	modL(signature[32:], &x)
+
+	return signature
+}
+
+// Verify reports whether sig is a valid signature of message by publicKey. It
+// will panic if len(publicKey) is not PublicKeySize.
+func Verify(publicKey PublicKey, message, sig []byte) bool {
+	if l := len(publicKey); l != PublicKeySize {
+		panic("ed25519: bad public key length: " + strconv.Itoa(l))
+	}
+
+	if len(sig) != SignatureSize || !isCanonicalScalar(sig[32:]) {
+		return false
+	}
+
+	var A point
+	if !A.unpackNegated(publicKey) {
+		return false
+	}
+
+	h := sha512.New()
+	h.Write(sig[:32])
+	h.Write(publicKey[:])
+	h.Write(message)
+	var digest [64]byte
+	h.Sum(digest[:0])
+
+	var k [32]byte
+	reduce(k[:], &digest)
+
+	// Check that [s]B - [k]A = R.
+	var p, q point
+	scalarMult(&p, &A, k[:])
+	scalarBaseMult(&q, sig[32:])
+	p.add(&q)
+
+	var checkR [32]byte
+	p.pack(checkR[:])
+	return bytes.Equal(sig[:32], checkR[:])
+}
+
+// order is the order of the base point, l = 2^252 +
+// 27742317777372353535851937790883648493, in little-endian form.
+var order = [32]int64{
+	0xed, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58,
+	0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0x10,
+}
+
+// isCanonicalScalar reports whether the little-endian scalar s is less than
+// the group order, which rules out malleable signatures.
+func isCanonicalScalar(s []byte) bool {
+	for i := 31; i >= 0; i-- {
+		switch {
+		case int64(s[i]) < order[i]:
+			return true
+		case int64(s[i]) > order[i]:
+			return false
+		}
+	}
+	return false
+}
+
+// modL sets r to x mod l, where x holds 64 little-endian limbs of 8 bits
+// that may have grown past 8 bits.
+func modL(r []byte, x *[64]int64) {
+	for i := 63; i >= 32; i-- {
+		var carry int64
+		j := i - 32
+		for ; j < i-12; j++ {
+			x[j] += carry - 16*x[i]*order[j-(i-32)]
+			carry = (x[j] + 128) >> 8
+			x[j] -= carry << 8
+		}
+		x[j] += carry
+		x[i] = 0
+	}
+	var carry int64
+	for j := 0; j < 32; j++ {
+		x[j] += carry - (x[31]>>4)*order[j]
+		carry = x[j] >> 8
+		x[j] &= 255
+	}
+	for j := 0; j < 32; j++ {
+		x[j] -= carry * order[j]
+	}
+	for i := 0; i < 32; i++ {
+		x[i+1] += x[i] >> 8
+		r[i] = byte(x[i])
+	}
+}
+
+// reduce sets r to the 512-bit little-endian value h mod l.
+func reduce(r []byte, h *[64]byte) {
+	var x [64]int64
+	for i := range h {
+		x[i] = int64(h[i])
+	}
+	modL(r, &x)
+}
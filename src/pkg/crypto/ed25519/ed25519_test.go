@@ -0,0 +1,168 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ed25519
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+)
+
+type zeroReader struct{}
+
+func (zeroReader) Read(buf []byte) (int, error) {
+	for i := range buf {
+		buf[i] = 0
+	}
+	return len(buf), nil
+}
+
+// From RFC 8032, section 7.1.
+var rfc8032Tests = []struct {
+	seed, public, message, signature string
+}{
+	{
+		"9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		"",
+		"e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+	},
+	{
+		"4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		"3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		"72",
+		"92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+	},
+}
+
+func fromHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestRFC8032Vectors(t *testing.T) {
+	for i, test := range rfc8032Tests {
+		priv := NewKeyFromSeed(fromHex(test.seed))
+		pub := priv.Public().(PublicKey)
+		if !bytes.Equal(pub, fromHex(test.public)) {
+			t.Errorf("#%d: got public key %x, want %s", i, pub, test.public)
+		}
+		message := fromHex(test.message)
+		sig := Sign(priv, message)
+		if !bytes.Equal(sig, fromHex(test.signature)) {
+			t.Errorf("#%d: got signature %x, want %s", i, sig, test.signature)
+		}
+		if !Verify(pub, message, sig) {
+			t.Errorf("#%d: signature failed to verify", i)
+		}
+		if !bytes.Equal(priv.Seed(), fromHex(test.seed)) {
+			t.Errorf("#%d: Seed returned %x, want %s", i, priv.Seed(), test.seed)
+		}
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	var zero zeroReader
+	public, private, _ := GenerateKey(zero)
+
+	message := []byte("test message")
+	sig := Sign(private, message)
+	if !Verify(public, message, sig) {
+		t.Errorf("valid signature rejected")
+	}
+
+	wrongMessage := []byte("wrong message")
+	if Verify(public, wrongMessage, sig) {
+		t.Errorf("signature of different message accepted")
+	}
+
+	sig[0] ^= 1
+	if Verify(public, message, sig) {
+		t.Errorf("corrupted signature accepted")
+	}
+}
+
+func TestCryptoSigner(t *testing.T) {
+	public, private, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signer crypto.Signer = private
+	if !bytes.Equal(signer.Public().(PublicKey), public) {
+		t.Errorf("public key doesn't match")
+	}
+
+	message := []byte("message")
+	sig, err := signer.Sign(zeroReader{}, message, crypto.Hash(0))
+	if err != nil {
+		t.Fatalf("error from Sign(): %s", err)
+	}
+	if !Verify(public, message, sig) {
+		t.Errorf("Verify failed on signature from Sign()")
+	}
+
+	if _, err := signer.Sign(zeroReader{}, message, crypto.SHA256); err == nil {
+		t.Errorf("Sign accepted a pre-hashed message")
+	}
+}
+
+func TestMalleability(t *testing.T) {
+	// The second signature is the first with l added to s, which must be
+	// rejected.
+	seed := fromHex(rfc8032Tests[0].seed)
+	public := NewKeyFromSeed(seed).Public().(PublicKey)
+	sig := fromHex(rfc8032Tests[0].signature)
+
+	var carry int
+	for i := 0; i < 32; i++ {
+		v := int(sig[32+i]) + int(order[i]) + carry
+		sig[32+i] = byte(v)
+		carry = v >> 8
+	}
+	if Verify(public, nil, sig) {
+		t.Errorf("non-canonical signature accepted")
+	}
+}
+
+func TestBadPublicKey(t *testing.T) {
+	// y = 2 is not the y coordinate of any point on the curve.
+	public := make(PublicKey, PublicKeySize)
+	public[0] = 2
+	sig := fromHex(rfc8032Tests[0].signature)
+	if Verify(public, nil, sig) {
+		t.Errorf("signature accepted for invalid public key")
+	}
+}
+
+func BenchmarkSigning(b *testing.B) {
+	_, priv, err := GenerateKey(zeroReader{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	message := []byte("Hello, world!")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Sign(priv, message)
+	}
+}
+
+func BenchmarkVerification(b *testing.B) {
+	pub, priv, err := GenerateKey(zeroReader{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	message := []byte("Hello, world!")
+	signature := Sign(priv, message)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(pub, message, signature)
+	}
+}
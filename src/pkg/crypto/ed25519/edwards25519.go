@@ -0,0 +1,172 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ed25519
+
+import "crypto/internal/field25519"
+
+// This file implements the group of points on the twisted Edwards curve
+// -x^2 + y^2 = 1 + d*x^2*y^2 over GF(2^255-19), using the field arithmetic
+// of package crypto/internal/field25519.
+
+var (
+	feZero = field25519.Element{}
+	feOne  = field25519.Element{1}
+
+	// d is the curve constant -121665/121666.
+	feD = field25519.Element{
+		0x78a3, 0x1359, 0x4dca, 0x75eb, 0xd8ab, 0x4141, 0x0a4d, 0x0070,
+		0xe898, 0x7779, 0x4079, 0x8cc7, 0xfe73, 0x2b6f, 0x6cee, 0x5203,
+	}
+	feD2 = field25519.Element{
+		0xf159, 0x26b2, 0x9b94, 0xebd6, 0xb156, 0x8283, 0x149a, 0x00e0,
+		0xd130, 0xeef3, 0x80f2, 0x198e, 0xfce7, 0x56df, 0xd9dc, 0x2406,
+	}
+	// feSqrtM1 is a square root of -1.
+	feSqrtM1 = field25519.Element{
+		0xa0b0, 0x4a0e, 0x1b27, 0xc4ee, 0xe478, 0xad2f, 0x1806, 0x2f43,
+		0xd7a7, 0x3dfb, 0x0099, 0x2b4d, 0xdf0b, 0x4fc1, 0x2480, 0x2b83,
+	}
+	// baseX and baseY are the affine coordinates of the base point.
+	baseX = field25519.Element{
+		0xd51a, 0x8f25, 0x2d60, 0xc956, 0xa7b2, 0x9525, 0xc760, 0x692c,
+		0xdc5c, 0xfdd6, 0xe231, 0xc0a4, 0x53fe, 0xcd6e, 0x36d3, 0x2169,
+	}
+	baseY = field25519.Element{
+		0x6658, 0x6666, 0x6666, 0x6666, 0x6666, 0x6666, 0x6666, 0x6666,
+		0x6666, 0x6666, 0x6666, 0x6666, 0x6666, 0x6666, 0x6666, 0x6666,
+	}
+)
+
+// parity returns the least significant bit of the reduced value of a.
+func parity(a *field25519.Element) byte {
+	var d [32]byte
+	a.Pack(d[:])
+	return d[0] & 1
+}
+
+func feEqual(a, b *field25519.Element) bool {
+	var c, d [32]byte
+	a.Pack(c[:])
+	b.Pack(d[:])
+	return c == d
+}
+
+// point is a point on the curve in extended coordinates (X:Y:Z:T), where
+// x = X/Z, y = Y/Z and x*y = T/Z.
+type point [4]field25519.Element
+
+// add sets p to p+q.
+func (p *point) add(q *point) {
+	var a, b, c, d, t, e, f, g, h field25519.Element
+
+	field25519.Sub(&a, &p[1], &p[0])
+	field25519.Sub(&t, &q[1], &q[0])
+	field25519.Mul(&a, &a, &t)
+	field25519.Add(&b, &p[0], &p[1])
+	field25519.Add(&t, &q[0], &q[1])
+	field25519.Mul(&b, &b, &t)
+	field25519.Mul(&c, &p[3], &q[3])
+	field25519.Mul(&c, &c, &feD2)
+	field25519.Mul(&d, &p[2], &q[2])
+	field25519.Add(&d, &d, &d)
+	field25519.Sub(&e, &b, &a)
+	field25519.Sub(&f, &d, &c)
+	field25519.Add(&g, &d, &c)
+	field25519.Add(&h, &b, &a)
+
+	field25519.Mul(&p[0], &e, &f)
+	field25519.Mul(&p[1], &h, &g)
+	field25519.Mul(&p[2], &g, &f)
+	field25519.Mul(&p[3], &e, &h)
+}
+
+// pointSwap exchanges p and q if b is 1 and leaves them unchanged if b is
+// 0, without branching on b.
+func pointSwap(p, q *point, b int64) {
+	for i := 0; i < 4; i++ {
+		field25519.Swap(&p[i], &q[i], b)
+	}
+}
+
+// pack encodes p as the y coordinate with the sign of x in the top bit.
+func (p *point) pack(r []byte) {
+	var tx, ty, zi field25519.Element
+	field25519.Invert(&zi, &p[2])
+	field25519.Mul(&tx, &p[0], &zi)
+	field25519.Mul(&ty, &p[1], &zi)
+	ty.Pack(r)
+	r[31] ^= parity(&tx) << 7
+}
+
+// unpackNegated sets p to the negation of the point encoded in s. It
+// reports whether s is a valid encoding.
+func (p *point) unpackNegated(s []byte) bool {
+	var t, chk, num, den, den2, den4, den6 field25519.Element
+
+	p[2] = feOne
+	p[1].Unpack(s)
+	field25519.Square(&num, &p[1])
+	field25519.Mul(&den, &num, &feD)
+	field25519.Sub(&num, &num, &p[2])
+	field25519.Add(&den, &p[2], &den)
+
+	field25519.Square(&den2, &den)
+	field25519.Square(&den4, &den2)
+	field25519.Mul(&den6, &den4, &den2)
+	field25519.Mul(&t, &den6, &num)
+	field25519.Mul(&t, &t, &den)
+
+	field25519.Pow22523(&t, &t)
+	field25519.Mul(&t, &t, &num)
+	field25519.Mul(&t, &t, &den)
+	field25519.Mul(&t, &t, &den)
+	field25519.Mul(&p[0], &t, &den)
+
+	field25519.Square(&chk, &p[0])
+	field25519.Mul(&chk, &chk, &den)
+	if !feEqual(&chk, &num) {
+		field25519.Mul(&p[0], &p[0], &feSqrtM1)
+	}
+
+	field25519.Square(&chk, &p[0])
+	field25519.Mul(&chk, &chk, &den)
+	if !feEqual(&chk, &num) {
+		return false
+	}
+
+	if parity(&p[0]) == s[31]>>7 {
+		field25519.Sub(&p[0], &feZero, &p[0])
+	}
+
+	field25519.Mul(&p[3], &p[0], &p[1])
+	return true
+}
+
+// scalarMult sets p to s*q, where s is a 32-byte little-endian scalar. q is
+// overwritten.
+func scalarMult(p, q *point, s []byte) {
+	p[0] = feZero
+	p[1] = feOne
+	p[2] = feOne
+	p[3] = feZero
+
+	for i := 255; i >= 0; i-- {
+		b := int64(s[i/8]>>uint(i&7)) & 1
+		pointSwap(p, q, b)
+		q.add(p)
+		p.add(p)
+		pointSwap(p, q, b)
+	}
+}
+
+// scalarBaseMult sets p to s*B, where B is the base point.
+func scalarBaseMult(p *point, s []byte) {
+	var q point
+	q[0] = baseX
+	q[1] = baseY
+	q[2] = feOne
+	field25519.Mul(&q[3], &baseX, &baseY)
+	scalarMult(p, &q, s)
+}
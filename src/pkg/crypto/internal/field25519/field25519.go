@@ -0,0 +1,133 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package field25519 implements arithmetic in the field GF(2^255-19), for
+// use by packages crypto/curve25519 and crypto/ed25519.
+//
+// All operations take time independent of the values of their operands.
+package field25519
+
+// Element represents an element of the field GF(2^255-19) as sixteen
+// signed limbs of 16 bits each, least significant first. Limbs may
+// temporarily exceed 16 bits between carries.
+type Element [16]int64
+
+// carry propagates the excess bits of each limb into the next one, folding
+// the top limb back into the bottom since 2^256 = 38 mod p.
+func (o *Element) carry() {
+	for i := 0; i < 16; i++ {
+		c := o[i] >> 16
+		o[i] -= c << 16
+		if i < 15 {
+			o[i+1] += c
+		} else {
+			o[0] += 38 * c
+		}
+	}
+}
+
+// Swap exchanges p and q if b is 1 and leaves them unchanged if b is 0,
+// without branching on b.
+func Swap(p, q *Element, b int64) {
+	c := ^(b - 1)
+	for i := 0; i < 16; i++ {
+		t := c & (p[i] ^ q[i])
+		p[i] ^= t
+		q[i] ^= t
+	}
+}
+
+// Add sets o to a+b.
+func Add(o, a, b *Element) {
+	for i := 0; i < 16; i++ {
+		o[i] = a[i] + b[i]
+	}
+}
+
+// Sub sets o to a-b.
+func Sub(o, a, b *Element) {
+	for i := 0; i < 16; i++ {
+		o[i] = a[i] - b[i]
+	}
+}
+
+// Mul sets o to a*b.
+func Mul(o, a, b *Element) {
+	var t [31]int64
+	for i := 0; i < 16; i++ {
+		for j := 0; j < 16; j++ {
+			t[i+j] += a[i] * b[j]
+		}
+	}
+	for i := 0; i < 15; i++ {
+		t[i] += 38 * t[i+16]
+	}
+	copy(o[:], t[:16])
+	o.carry()
+	o.carry()
+}
+
+// Square sets o to a*a.
+func Square(o, a *Element) {
+	Mul(o, a, a)
+}
+
+// Invert sets o to i^(p-2) = 1/i.
+func Invert(o, i *Element) {
+	c := *i
+	for a := 253; a >= 0; a-- {
+		Square(&c, &c)
+		if a != 2 && a != 4 {
+			Mul(&c, &c, i)
+		}
+	}
+	*o = c
+}
+
+// Pow22523 sets o to i^((p-5)/8), which is used to compute square roots.
+func Pow22523(o, i *Element) {
+	c := *i
+	for a := 250; a >= 0; a-- {
+		Square(&c, &c)
+		if a != 1 {
+			Mul(&c, &c, i)
+		}
+	}
+	*o = c
+}
+
+// Unpack decodes the 32-byte little-endian field element in n, ignoring
+// the top bit.
+func (o *Element) Unpack(n []byte) {
+	for i := 0; i < 16; i++ {
+		o[i] = int64(n[2*i]) + int64(n[2*i+1])<<8
+	}
+	o[15] &= 0x7fff
+}
+
+// Pack encodes the fully reduced value of n into the 32 bytes of o in
+// little-endian form.
+func (n *Element) Pack(o []byte) {
+	t := *n
+	t.carry()
+	t.carry()
+	t.carry()
+	// Subtract p twice, keeping each result only if it didn't underflow.
+	for j := 0; j < 2; j++ {
+		var m Element
+		m[0] = t[0] - 0xffed
+		for i := 1; i < 15; i++ {
+			m[i] = t[i] - 0xffff - ((m[i-1] >> 16) & 1)
+			m[i-1] &= 0xffff
+		}
+		m[15] = t[15] - 0x7fff - ((m[14] >> 16) & 1)
+		b := (m[15] >> 16) & 1
+		m[14] &= 0xffff
+		Swap(&t, &m, 1-b)
+	}
+	for i := 0; i < 16; i++ {
+		o[2*i] = byte(t[i])
+		o[2*i+1] = byte(t[i] >> 8)
+	}
+}
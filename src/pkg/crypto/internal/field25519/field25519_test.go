@@ -0,0 +1,45 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package field25519
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestInvert(t *testing.T) {
+	var a, inv, prod Element
+	for i := range a {
+		a[i] = int64(i*0x1111) & 0xffff
+	}
+	Invert(&inv, &a)
+	Mul(&prod, &a, &inv)
+
+	var got [32]byte
+	prod.Pack(got[:])
+	want := [32]byte{1}
+	if got != want {
+		t.Errorf("a * 1/a = %x, want 1", got)
+	}
+}
+
+func TestPackReduces(t *testing.T) {
+	// p+1 is not a canonical encoding and must pack as 1.
+	in := []byte{
+		0xee, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+	}
+	var a Element
+	a.Unpack(in)
+	out := make([]byte, 32)
+	a.Pack(out)
+	want := make([]byte, 32)
+	want[0] = 1
+	if !bytes.Equal(out, want) {
+		t.Errorf("Pack(p+1) = %x, want %x", out, want)
+	}
+}
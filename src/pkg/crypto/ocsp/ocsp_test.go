@@ -0,0 +1,232 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ocsp
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestCert(t *testing.T, cn string, serial int64, isCA bool, parent *x509.Certificate, parentKey crypto.Signer) (*x509.Certificate, crypto.Signer) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Unix(1000, 0),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	if parent == nil {
+		parent, parentKey = template, priv
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &priv.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, priv
+}
+
+func TestOCSPRequest(t *testing.T) {
+	issuer, issuerKey := newTestCert(t, "issuer", 1, true, nil, nil)
+	leaf, _ := newTestCert(t, "leaf", 42, false, issuer, issuerKey)
+
+	for _, hash := range []crypto.Hash{0, crypto.SHA1, crypto.SHA256, crypto.SHA384, crypto.SHA512} {
+		der, err := CreateRequest(leaf, issuer, &RequestOptions{Hash: hash})
+		if err != nil {
+			t.Errorf("hash %d: CreateRequest: %s", hash, err)
+			continue
+		}
+		req, err := ParseRequest(der)
+		if err != nil {
+			t.Errorf("hash %d: ParseRequest: %s", hash, err)
+			continue
+		}
+		want := hash
+		if want == 0 {
+			want = crypto.SHA1
+		}
+		if req.HashAlgorithm != want {
+			t.Errorf("hash %d: got hash algorithm %d", hash, req.HashAlgorithm)
+		}
+		if req.SerialNumber.Cmp(leaf.SerialNumber) != 0 {
+			t.Errorf("hash %d: got serial %s, want %s", hash, req.SerialNumber, leaf.SerialNumber)
+		}
+		h := want.New()
+		h.Write(issuer.RawSubject)
+		if !bytes.Equal(req.IssuerNameHash, h.Sum(nil)) {
+			t.Errorf("hash %d: bad issuer name hash %x", hash, req.IssuerNameHash)
+		}
+		if len(req.IssuerKeyHash) != want.Size() {
+			t.Errorf("hash %d: bad issuer key hash %x", hash, req.IssuerKeyHash)
+		}
+	}
+}
+
+func TestOCSPRequestNilOptions(t *testing.T) {
+	issuer, issuerKey := newTestCert(t, "issuer", 1, true, nil, nil)
+	leaf, _ := newTestCert(t, "leaf", 42, false, issuer, issuerKey)
+
+	der, err := CreateRequest(leaf, issuer, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := ParseRequest(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.HashAlgorithm != crypto.SHA1 || len(req.IssuerNameHash) != sha1.Size {
+		t.Errorf("unexpected request %#v", req)
+	}
+}
+
+func TestOCSPResponse(t *testing.T) {
+	issuer, issuerKey := newTestCert(t, "issuer", 1, true, nil, nil)
+
+	thisUpdate := time.Date(2010, 7, 7, 15, 1, 5, 0, time.UTC)
+	nextUpdate := time.Date(2010, 7, 7, 18, 35, 17, 0, time.UTC)
+	revokedAt := time.Date(2010, 7, 7, 10, 0, 0, 0, time.UTC)
+
+	tests := []Response{
+		{Status: Good},
+		{Status: Unknown},
+		{Status: Revoked, RevokedAt: revokedAt, RevocationReason: KeyCompromise},
+		{Status: Good, IssuerHash: crypto.SHA256},
+	}
+
+	for i, template := range tests {
+		template.SerialNumber = big.NewInt(int64(i + 100))
+		template.ThisUpdate = thisUpdate
+		template.NextUpdate = nextUpdate
+
+		der, err := CreateResponse(issuer, issuer, template, issuerKey)
+		if err != nil {
+			t.Errorf("#%d: CreateResponse: %s", i, err)
+			continue
+		}
+		resp, err := ParseResponse(der, issuer)
+		if err != nil {
+			t.Errorf("#%d: ParseResponse: %s", i, err)
+			continue
+		}
+
+		if resp.Status != template.Status {
+			t.Errorf("#%d: got status %d, want %d", i, resp.Status, template.Status)
+		}
+		if resp.SerialNumber.Cmp(template.SerialNumber) != 0 {
+			t.Errorf("#%d: got serial %s, want %s", i, resp.SerialNumber, template.SerialNumber)
+		}
+		if !resp.ThisUpdate.Equal(thisUpdate) {
+			t.Errorf("#%d: got thisUpdate %s, want %s", i, resp.ThisUpdate, thisUpdate)
+		}
+		if !resp.NextUpdate.Equal(nextUpdate) {
+			t.Errorf("#%d: got nextUpdate %s, want %s", i, resp.NextUpdate, nextUpdate)
+		}
+		if !resp.RevokedAt.Equal(template.RevokedAt) {
+			t.Errorf("#%d: got revokedAt %s, want %s", i, resp.RevokedAt, template.RevokedAt)
+		}
+		if resp.RevocationReason != template.RevocationReason {
+			t.Errorf("#%d: got revocation reason %d, want %d", i, resp.RevocationReason, template.RevocationReason)
+		}
+		wantHash := template.IssuerHash
+		if wantHash == 0 {
+			wantHash = crypto.SHA1
+		}
+		if resp.IssuerHash != wantHash {
+			t.Errorf("#%d: got issuer hash %d, want %d", i, resp.IssuerHash, wantHash)
+		}
+		if resp.SignatureAlgorithm != x509.ECDSAWithSHA256 {
+			t.Errorf("#%d: got signature algorithm %d", i, resp.SignatureAlgorithm)
+		}
+		if resp.Certificate != nil {
+			t.Errorf("#%d: unexpected embedded certificate", i)
+		}
+	}
+}
+
+func TestOCSPResponseDelegatedResponder(t *testing.T) {
+	issuer, issuerKey := newTestCert(t, "issuer", 1, true, nil, nil)
+	responder, responderKey := newTestCert(t, "responder", 2, false, issuer, issuerKey)
+
+	template := Response{
+		Status:       Good,
+		SerialNumber: big.NewInt(42),
+		ThisUpdate:   time.Date(2010, 7, 7, 15, 1, 5, 0, time.UTC),
+		NextUpdate:   time.Date(2010, 7, 7, 18, 35, 17, 0, time.UTC),
+		Certificate:  responder,
+	}
+	der, err := CreateResponse(issuer, responder, template, responderKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := ParseResponse(der, issuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Certificate == nil || !reflect.DeepEqual(resp.Certificate.Raw, responder.Raw) {
+		t.Errorf("embedded responder certificate was not returned")
+	}
+
+	// A different issuer must not validate the responder certificate.
+	other, _ := newTestCert(t, "other", 3, true, nil, nil)
+	if _, err := ParseResponse(der, other); err == nil {
+		t.Errorf("ParseResponse succeeded with the wrong issuer")
+	}
+}
+
+func TestOCSPResponseBadSignature(t *testing.T) {
+	issuer, issuerKey := newTestCert(t, "issuer", 1, true, nil, nil)
+	other, _ := newTestCert(t, "other", 2, true, nil, nil)
+
+	template := Response{
+		Status:       Good,
+		SerialNumber: big.NewInt(42),
+		ThisUpdate:   time.Date(2010, 7, 7, 15, 1, 5, 0, time.UTC),
+	}
+	der, err := CreateResponse(issuer, issuer, template, issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseResponse(der, other); err == nil {
+		t.Errorf("ParseResponse succeeded with the wrong issuer")
+	}
+	// Without an issuer the signature can't be checked but the response
+	// should still parse.
+	if _, err := ParseResponse(der, nil); err != nil {
+		t.Errorf("ParseResponse with nil issuer: %s", err)
+	}
+}
+
+func TestOCSPErrorResponse(t *testing.T) {
+	// An OCSPResponse with status "unauthorized" and no body.
+	der := []byte{0x30, 0x03, 0x0a, 0x01, 0x06}
+	_, err := ParseResponse(der, nil)
+	respErr, ok := err.(ResponseError)
+	if !ok {
+		t.Fatalf("expected ResponseError, got %v", err)
+	}
+	if respErr.Status != Unauthorized {
+		t.Errorf("got status %s, want %s", respErr.Status, Unauthorized)
+	}
+}
@@ -63,6 +63,22 @@ func TestDecryptPKCS1v15(t *testing.T) {
 	}
 }
 
+func TestDecryptPKCS1v15Decrypter(t *testing.T) {
+	decrypter := crypto.Decrypter(rsaPrivateKey)
+	for _, opts := range []crypto.DecrypterOpts{nil, &PKCS1v15DecryptOptions{}} {
+		for i, test := range decryptPKCS1v15Tests {
+			out, err := decrypter.Decrypt(nil, decodeBase64(test.in), opts)
+			if err != nil {
+				t.Errorf("#%d error decrypting: %s", i, err)
+			}
+			want := []byte(test.out)
+			if !bytes.Equal(out, want) {
+				t.Errorf("#%d got:%#v want:%#v", i, out, want)
+			}
+		}
+	}
+}
+
 func TestEncryptPKCS1v15(t *testing.T) {
 	random := rand.Reader
 	k := (rsaPrivateKey.N.BitLen() + 7) / 8
@@ -138,6 +154,22 @@ func TestEncryptPKCS1v15SessionKey(t *testing.T) {
 	}
 }
 
+func TestEncryptPKCS1v15DecrypterSessionKey(t *testing.T) {
+	for i, test := range decryptPKCS1v15SessionKeyTests {
+		plaintext, err := rsaPrivateKey.Decrypt(rand.Reader, decodeBase64(test.in), &PKCS1v15DecryptOptions{SessionKeyLen: 4})
+		if err != nil {
+			t.Fatalf("#%d: error decrypting: %s", i, err)
+		}
+		if len(plaintext) != 4 {
+			t.Fatalf("#%d: incorrect length plaintext: got %d, want 4", i, len(plaintext))
+		}
+
+		if test.out != "FAIL" && !bytes.Equal(plaintext, []byte(test.out)) {
+			t.Errorf("#%d: incorrect plaintext: got %x, want %x", i, plaintext, test.out)
+		}
+	}
+}
+
 func TestNonZeroRandomBytes(t *testing.T) {
 	random := rand.Reader
 
@@ -182,6 +214,25 @@ func TestSignPKCS1v15(t *testing.T) {
 	}
 }
 
+func TestSignPKCS1v15Signer(t *testing.T) {
+	for i, test := range signPKCS1v15Tests {
+		h := sha1.New()
+		h.Write([]byte(test.in))
+		digest := h.Sum(nil)
+
+		var signer crypto.Signer = rsaPrivateKey
+		s, err := signer.Sign(nil, digest, crypto.SHA1)
+		if err != nil {
+			t.Errorf("#%d %s", i, err)
+		}
+
+		expected, _ := hex.DecodeString(test.out)
+		if !bytes.Equal(s, expected) {
+			t.Errorf("#%d got: %x want: %x", i, s, expected)
+		}
+	}
+}
+
 func TestVerifyPKCS1v15(t *testing.T) {
 	for i, test := range signPKCS1v15Tests {
 		h := sha1.New()
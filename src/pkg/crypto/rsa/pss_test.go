@@ -224,6 +224,26 @@ func TestPSSSigning(t *testing.T) {
 	}
 }
 
+func TestPSSSigner(t *testing.T) {
+	hash := crypto.MD5
+	h := hash.New()
+	h.Write([]byte("testing"))
+	hashed := h.Sum(nil)
+
+	var signer crypto.Signer = rsaPrivateKey
+	opts := &PSSOptions{SaltLength: PSSSaltLengthEqualsHash, Hash: hash}
+	sig, err := signer.Sign(rand.Reader, hashed, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPSS(&rsaPrivateKey.PublicKey, hash, hashed, sig, opts); err != nil {
+		t.Error(err)
+	}
+	if err := VerifyPKCS1v15(&rsaPrivateKey.PublicKey, hash, hashed, sig); err == nil {
+		t.Error("PSS signature verified as PKCS#1 v1.5")
+	}
+}
+
 func bigFromHex(hex string) *big.Int {
 	n, ok := new(big.Int).SetString(hex, 16)
 	if !ok {
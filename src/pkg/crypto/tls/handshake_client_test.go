@@ -6,11 +6,22 @@ package tls
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
 	"flag"
 	"io"
+	"io/ioutil"
+	"math/big"
 	"net"
 	"os"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func testClientScript(t *testing.T, name string, clientScript [][]byte, config *Config) {
@@ -82,6 +93,483 @@ func TestHandshakeClientTLS12(t *testing.T) {
 	testClientScript(t, "TLS12", clientTLS12Script, &config)
 }
 
+var (
+	localListenerOnce sync.Once
+	localListener     net.Listener
+	localListenerErr  error
+)
+
+// localPipe returns both ends of a loopback TCP connection. Unlike
+// net.Pipe, writes are buffered, so a side that aborts the handshake
+// with an alert doesn't deadlock against the other side's flight.
+// The listener is shared so that the server address, and thus the
+// client session cache key, stays the same across calls.
+func localPipe() (net.Conn, net.Conn, error) {
+	localListenerOnce.Do(func() {
+		localListener, localListenerErr = net.Listen("tcp", "127.0.0.1:0")
+	})
+	l, err := localListener, localListenerErr
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		return nil, nil, err
+	}
+	s, err := l.Accept()
+	if err != nil {
+		c.Close()
+		return nil, nil, err
+	}
+	return c, s, nil
+}
+
+// testClientHandshake runs a handshake between a Client and a Server over a
+// loopback connection and returns the client's view of the connection.
+func testClientHandshake(clientConfig, serverConfig *Config) (state ConnectionState, err error) {
+	c, s, err := localPipe()
+	if err != nil {
+		return
+	}
+	done := make(chan bool)
+	go func() {
+		srv := Server(s, serverConfig)
+		srv.Handshake()
+		s.Close()
+		done <- true
+	}()
+
+	cli := Client(c, clientConfig)
+	err = cli.Handshake()
+	if err == nil {
+		state = cli.ConnectionState()
+	}
+	c.Close()
+	<-done
+	return
+}
+
+func TestClientResumption(t *testing.T) {
+	serverConfig := &Config{
+		CipherSuites: []uint16{TLS_RSA_WITH_RC4_128_SHA, TLS_ECDHE_RSA_WITH_RC4_128_SHA},
+		Certificates: testConfig.Certificates,
+	}
+	clientConfig := &Config{
+		CipherSuites:       []uint16{TLS_RSA_WITH_RC4_128_SHA},
+		InsecureSkipVerify: true,
+		ClientSessionCache: NewLRUClientSessionCache(32),
+	}
+
+	testResumeState := func(test string, didResume bool) {
+		state, err := testClientHandshake(clientConfig, serverConfig)
+		if err != nil {
+			t.Fatalf("%s: handshake failed: %s", test, err)
+		}
+		if state.DidResume != didResume {
+			t.Fatalf("%s: DidResume = %t, want %t", test, state.DidResume, didResume)
+		}
+		if len(state.PeerCertificates) == 0 {
+			t.Fatalf("%s: no peer certificates", test)
+		}
+	}
+
+	getTicket := func() []byte {
+		cache := clientConfig.ClientSessionCache.(*lruSessionCache)
+		return cache.q.Front().Value.(*lruSessionCacheEntry).state.sessionTicket
+	}
+
+	testResumeState("Handshake", false)
+	ticket := getTicket()
+	testResumeState("Resume", true)
+	if !bytes.Equal(ticket, getTicket()) {
+		t.Fatal("first ticket doesn't match ticket after resumption")
+	}
+
+	key := serverConfig.SessionTicketKey
+	serverConfig.SessionTicketKey = [32]byte{1}
+	testResumeState("InvalidTicket", false)
+	if bytes.Equal(ticket, getTicket()) {
+		t.Fatal("ticket was not replaced after a full handshake")
+	}
+	testResumeState("ResumeWithNewTicket", true)
+
+	serverConfig.SessionTicketKey = key
+	clientConfig.CipherSuites = []uint16{TLS_ECDHE_RSA_WITH_RC4_128_SHA}
+	testResumeState("DifferentCipherSuite", false)
+	testResumeState("DifferentCipherSuiteRecovers", true)
+
+	clientConfig.SessionTicketsDisabled = true
+	testResumeState("TicketsDisabled", false)
+	clientConfig.SessionTicketsDisabled = false
+
+	clientConfig.ClientSessionCache = nil
+	testResumeState("WithoutSessionCache", false)
+}
+
+func TestClientResumptionMismatch(t *testing.T) {
+	serverConfig := &Config{
+		CipherSuites: []uint16{TLS_RSA_WITH_RC4_128_SHA, TLS_ECDHE_RSA_WITH_RC4_128_SHA},
+		Certificates: testConfig.Certificates,
+	}
+
+	// tamper makes a full handshake, then alters the cached session so
+	// that it no longer matches what the server resumes from its ticket.
+	tamper := func(test string, f func(*ClientSessionState)) {
+		clientConfig := &Config{
+			CipherSuites:       []uint16{TLS_RSA_WITH_RC4_128_SHA, TLS_ECDHE_RSA_WITH_RC4_128_SHA},
+			InsecureSkipVerify: true,
+			ClientSessionCache: NewLRUClientSessionCache(32),
+		}
+		if _, err := testClientHandshake(clientConfig, serverConfig); err != nil {
+			t.Fatalf("%s: handshake failed: %s", test, err)
+		}
+		cache := clientConfig.ClientSessionCache.(*lruSessionCache)
+		f(cache.q.Front().Value.(*lruSessionCacheEntry).state)
+
+		_, err := testClientHandshake(clientConfig, serverConfig)
+		if err == nil || !strings.Contains(err.Error(), "server resumed a session with a different") {
+			t.Errorf("%s: resumption error = %v, want mismatch error", test, err)
+		}
+	}
+
+	tamper("CipherSuite", func(s *ClientSessionState) {
+		if s.cipherSuite != TLS_RSA_WITH_RC4_128_SHA {
+			t.Fatalf("session cipher suite = %#x, want %#x", s.cipherSuite, TLS_RSA_WITH_RC4_128_SHA)
+		}
+		s.cipherSuite = TLS_ECDHE_RSA_WITH_RC4_128_SHA
+	})
+	tamper("Version", func(s *ClientSessionState) {
+		s.vers = VersionTLS11
+	})
+}
+
+func TestConnectionStateVersion(t *testing.T) {
+	serverConfig := &Config{
+		CipherSuites: []uint16{TLS_RSA_WITH_RC4_128_SHA},
+		Certificates: testConfig.Certificates,
+	}
+	for _, vers := range []uint16{VersionTLS10, VersionTLS11, VersionTLS12} {
+		clientConfig := &Config{
+			CipherSuites:       []uint16{TLS_RSA_WITH_RC4_128_SHA},
+			InsecureSkipVerify: true,
+			MaxVersion:         vers,
+		}
+		state, err := testClientHandshake(clientConfig, serverConfig)
+		if err != nil {
+			t.Fatalf("version %#x: handshake failed: %s", vers, err)
+		}
+		if state.Version != vers {
+			t.Errorf("Version = %#x, want %#x", state.Version, vers)
+		}
+	}
+}
+
+func TestALPN(t *testing.T) {
+	serverConfig := &Config{
+		CipherSuites: []uint16{TLS_RSA_WITH_RC4_128_SHA},
+		Certificates: testConfig.Certificates,
+		NextProtos:   []string{"proto1", "proto3"},
+	}
+	clientConfig := &Config{
+		CipherSuites:       []uint16{TLS_RSA_WITH_RC4_128_SHA},
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"proto2", "proto1", "proto3"},
+	}
+
+	state, err := testClientHandshake(clientConfig, serverConfig)
+	if err != nil {
+		t.Fatalf("handshake failed: %s", err)
+	}
+	// The server's preference wins.
+	if state.NegotiatedProtocol != "proto1" {
+		t.Errorf("NegotiatedProtocol = %q, want %q", state.NegotiatedProtocol, "proto1")
+	}
+	if !state.NegotiatedProtocolIsMutual {
+		t.Error("NegotiatedProtocolIsMutual = false, want true")
+	}
+
+	// Without a protocol in common, nothing is negotiated.
+	clientConfig.NextProtos = []string{"proto4"}
+	state, err = testClientHandshake(clientConfig, serverConfig)
+	if err != nil {
+		t.Fatalf("handshake failed: %s", err)
+	}
+	if state.NegotiatedProtocol != "" {
+		t.Errorf("NegotiatedProtocol = %q, want none", state.NegotiatedProtocol)
+	}
+}
+
+func TestALPNUnadvertisedProtocol(t *testing.T) {
+	c, s := net.Pipe()
+	defer c.Close()
+	go func() {
+		io.Copy(ioutil.Discard, s)
+		s.Close()
+	}()
+
+	hs := &clientHandshakeState{
+		c:           Client(c, &Config{NextProtos: []string{"proto1"}}),
+		hello:       &clientHelloMsg{alpnProtocols: []string{"proto1"}},
+		serverHello: &serverHelloMsg{alpnProtocol: "proto2"},
+	}
+	if _, err := hs.processServerHello(); err == nil || !strings.Contains(err.Error(), "unadvertised ALPN protocol") {
+		t.Errorf("processServerHello error = %v, want unadvertised ALPN protocol error", err)
+	}
+	if hs.c.clientProtocol != "" {
+		t.Errorf("clientProtocol = %q, want none", hs.c.clientProtocol)
+	}
+}
+
+func TestCurvePreferences(t *testing.T) {
+	tests := []struct {
+		client, server []CurveID
+		ok             bool
+	}{
+		{nil, nil, true},
+		{[]CurveID{X25519}, nil, true},
+		{nil, []CurveID{X25519}, true},
+		{[]CurveID{CurveP384}, []CurveID{X25519, CurveP384}, true},
+		{[]CurveID{X25519}, []CurveID{CurveP256}, false},
+		{[]CurveID{CurveP521}, []CurveID{CurveP256, CurveP384}, false},
+	}
+	for i, test := range tests {
+		serverConfig := &Config{
+			CipherSuites:     []uint16{TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA},
+			Certificates:     testConfig.Certificates,
+			CurvePreferences: test.server,
+		}
+		clientConfig := &Config{
+			CipherSuites:       []uint16{TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA},
+			InsecureSkipVerify: true,
+			CurvePreferences:   test.client,
+		}
+		_, err := testClientHandshake(clientConfig, serverConfig)
+		if test.ok && err != nil {
+			t.Errorf("#%d: handshake failed: %s", i, err)
+		} else if !test.ok && err == nil {
+			t.Errorf("#%d: handshake succeeded without a common curve", i)
+		}
+	}
+}
+
+func TestX25519KeyAgreement(t *testing.T) {
+	ka := &ecdheKeyAgreement{version: VersionTLS12, sigType: signatureRSA}
+	clientHello := &clientHelloMsg{
+		random:          make([]byte, 32),
+		supportedCurves: []CurveID{X25519},
+	}
+	serverHello := &serverHelloMsg{random: make([]byte, 32)}
+	skx, err := ka.generateServerKeyExchange(testConfig, &testConfig.Certificates[0], clientHello, serverHello)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ka.curveid != X25519 {
+		t.Fatalf("server selected curve %d, want X25519", ka.curveid)
+	}
+
+	// A client public value of zero is of low order and must be rejected.
+	ckx := &clientKeyExchangeMsg{ciphertext: make([]byte, 33)}
+	ckx.ciphertext[0] = 32
+	if _, err := ka.processClientKeyExchange(testConfig, &testConfig.Certificates[0], ckx, VersionTLS12); err == nil {
+		t.Errorf("low-order X25519 public value accepted")
+	}
+
+	leaf, err := x509.ParseCertificate(testConfig.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientKA := &ecdheKeyAgreement{version: VersionTLS12, sigType: signatureRSA}
+	if err := clientKA.processServerKeyExchange(testConfig, clientHello, serverHello, leaf, skx); err != nil {
+		t.Fatalf("processServerKeyExchange: %s", err)
+	}
+	clientSecret, ckx, err := clientKA.generateClientKeyExchange(testConfig, clientHello, leaf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverSecret, err := ka.processClientKeyExchange(testConfig, &testConfig.Certificates[0], ckx, VersionTLS12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clientSecret) != 32 || !bytes.Equal(clientSecret, serverSecret) {
+		t.Errorf("pre-master secrets differ: %x and %x", clientSecret, serverSecret)
+	}
+}
+
+func TestEd25519Certificate(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "ed25519.example.com"},
+		NotBefore:    time.Unix(1000, 0),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+		DNSNames:     []string{"ed25519.example.com"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverConfig := &Config{
+		CipherSuites: []uint16{TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA},
+		Certificates: []Certificate{{Certificate: [][]byte{der}, PrivateKey: priv}},
+		MaxVersion:   VersionTLS12,
+	}
+	clientConfig := &Config{
+		CipherSuites:       []uint16{TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA},
+		InsecureSkipVerify: true,
+		MaxVersion:         VersionTLS12,
+	}
+	state, err := testClientHandshake(clientConfig, serverConfig)
+	if err != nil {
+		t.Fatalf("handshake failed: %s", err)
+	}
+	if _, ok := state.PeerCertificates[0].PublicKey.(ed25519.PublicKey); !ok {
+		t.Errorf("server certificate has public key %T, want ed25519.PublicKey", state.PeerCertificates[0].PublicKey)
+	}
+
+	// Ed25519 signatures can only be used in TLS 1.2.
+	clientConfig.MaxVersion = VersionTLS11
+	if _, err := testClientHandshake(clientConfig, serverConfig); err == nil {
+		t.Errorf("Ed25519 handshake succeeded with TLS 1.1")
+	}
+}
+
+func TestOCSPAndSCT(t *testing.T) {
+	ocsp := []byte("fake OCSP response")
+	scts := [][]byte{[]byte("sct one"), []byte("sct two")}
+
+	cert := testConfig.Certificates[0]
+	cert.OCSPStaple = ocsp
+	cert.SignedCertificateTimestamps = scts
+	serverConfig := &Config{
+		CipherSuites: []uint16{TLS_RSA_WITH_RC4_128_SHA},
+		Certificates: []Certificate{cert},
+	}
+	clientConfig := &Config{
+		CipherSuites:       []uint16{TLS_RSA_WITH_RC4_128_SHA},
+		InsecureSkipVerify: true,
+		ClientSessionCache: NewLRUClientSessionCache(32),
+	}
+
+	// The values are restored from the session on resumption.
+	for _, resume := range []bool{false, true} {
+		state, err := testClientHandshake(clientConfig, serverConfig)
+		if err != nil {
+			t.Fatalf("handshake failed: %s", err)
+		}
+		if state.DidResume != resume {
+			t.Fatalf("DidResume = %t, want %t", state.DidResume, resume)
+		}
+		if !bytes.Equal(state.OCSPResponse, ocsp) {
+			t.Errorf("resume %t: OCSPResponse = %q, want %q", resume, state.OCSPResponse, ocsp)
+		}
+		if !reflect.DeepEqual(state.SignedCertificateTimestamps, scts) {
+			t.Errorf("resume %t: SignedCertificateTimestamps = %q, want %q", resume, state.SignedCertificateTimestamps, scts)
+		}
+	}
+}
+
+func TestKeyLog(t *testing.T) {
+	var serverBuf, clientBuf bytes.Buffer
+
+	serverConfig := &Config{
+		CipherSuites: []uint16{TLS_RSA_WITH_RC4_128_SHA},
+		Certificates: testConfig.Certificates,
+		KeyLogWriter: &serverBuf,
+	}
+	clientConfig := &Config{
+		CipherSuites:       []uint16{TLS_RSA_WITH_RC4_128_SHA},
+		InsecureSkipVerify: true,
+		KeyLogWriter:       &clientBuf,
+		ClientSessionCache: NewLRUClientSessionCache(32),
+	}
+
+	checkKeylogLine := func(side, loggedLine string) {
+		if len(loggedLine) == 0 {
+			t.Fatalf("%s: no keylog line was produced", side)
+		}
+		const expectedLen = 13 /* "CLIENT_RANDOM" */ +
+			1 /* space */ +
+			32*2 /* hex client nonce */ +
+			1 /* space */ +
+			48*2 /* hex master secret */ +
+			1 /* new line */
+		if len(loggedLine) != expectedLen {
+			t.Fatalf("%s: keylog line has incorrect length (want %d, got %d): %q", side, expectedLen, len(loggedLine), loggedLine)
+		}
+		fields := strings.Fields(loggedLine)
+		if len(fields) != 3 || fields[0] != "CLIENT_RANDOM" {
+			t.Fatalf("%s: keylog line has incorrect structure: %q", side, loggedLine)
+		}
+		for _, field := range fields[1:] {
+			if _, err := hex.DecodeString(field); err != nil {
+				t.Fatalf("%s: keylog line contains invalid hex %q: %s", side, field, err)
+			}
+		}
+	}
+
+	// Resumed handshakes are logged too.
+	for _, resume := range []bool{false, true} {
+		serverBuf.Reset()
+		clientBuf.Reset()
+		state, err := testClientHandshake(clientConfig, serverConfig)
+		if err != nil {
+			t.Fatalf("handshake failed: %s", err)
+		}
+		if state.DidResume != resume {
+			t.Fatalf("DidResume = %t, want %t", state.DidResume, resume)
+		}
+
+		checkKeylogLine("client", clientBuf.String())
+		checkKeylogLine("server", serverBuf.String())
+		if clientBuf.String() != serverBuf.String() {
+			t.Errorf("client and server logged different secrets:\n%q\n%q", clientBuf.String(), serverBuf.String())
+		}
+	}
+}
+
+func TestLRUClientSessionCache(t *testing.T) {
+	// Initialize cache of capacity 4.
+	cache := NewLRUClientSessionCache(4)
+	cs := make([]ClientSessionState, 6)
+	keys := []string{"0", "1", "2", "3", "4", "5", "6"}
+
+	// Add 4 entries to the cache and look them up.
+	for i := 0; i < 4; i++ {
+		cache.Put(keys[i], &cs[i])
+	}
+	for i := 0; i < 4; i++ {
+		if s, ok := cache.Get(keys[i]); !ok || s != &cs[i] {
+			t.Fatalf("session cache failed lookup for added key: %s", keys[i])
+		}
+	}
+
+	// Add 2 more entries to the cache. First 2 should be evicted.
+	for i := 4; i < 6; i++ {
+		cache.Put(keys[i], &cs[i])
+	}
+	for i := 0; i < 2; i++ {
+		if s, ok := cache.Get(keys[i]); ok || s != nil {
+			t.Fatalf("session cache should have evicted key: %s", keys[i])
+		}
+	}
+
+	// Touch entry 2. LRU should evict 3 next.
+	cache.Get(keys[2])
+	cache.Put(keys[0], &cs[0])
+	if s, ok := cache.Get(keys[3]); ok || s != nil {
+		t.Fatalf("session cache should have evicted key 3")
+	}
+
+	// Update entry 0 in place.
+	cache.Put(keys[0], &cs[3])
+	if s, ok := cache.Get(keys[0]); !ok || s != &cs[3] {
+		t.Fatalf("session cache failed update for key 0")
+	}
+}
+
 var connect = flag.Bool("connect", false, "connect to a TLS server on :10443")
 
 func TestRunClient(t *testing.T) {
@@ -185,18 +673,18 @@ func TestEmptyRecords(t *testing.T) {
 // -----END CERTIFICATE-----
 var rsaRC4ClientScript = [][]byte{
 	{
-		0x16, 0x03, 0x01, 0x00, 0x4a, 0x01, 0x00, 0x00,
-		0x46, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x16, 0x03, 0x01, 0x00, 0x50, 0x01, 0x00, 0x00,
+		0x4c, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x05,
-		0x01, 0x00, 0x00, 0x1b, 0x00, 0x05, 0x00, 0x05,
+		0x01, 0x00, 0x00, 0x21, 0x00, 0x05, 0x00, 0x05,
 		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
-		0x08, 0x00, 0x06, 0x00, 0x17, 0x00, 0x18, 0x00,
-		0x19, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00,
+		0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00,
+		0x18, 0x00, 0x19, 0x00, 0x0b, 0x00, 0x02, 0x01,
+		0x00, 0x00, 0x12, 0x00, 0x00,
 	},
-
 	{
 		0x16, 0x03, 0x01, 0x00, 0x4a, 0x02, 0x00, 0x00,
 		0x46, 0x03, 0x01, 0x4d, 0x0a, 0x56, 0x16, 0xb5,
@@ -274,7 +762,6 @@ var rsaRC4ClientScript = [][]byte{
 		0xbc, 0x17, 0xf1, 0x16, 0x03, 0x01, 0x00, 0x04,
 		0x0e, 0x00, 0x00, 0x00,
 	},
-
 	{
 		0x16, 0x03, 0x01, 0x00, 0x46, 0x10, 0x00, 0x00,
 		0x42, 0x00, 0x40, 0x87, 0xa1, 0x1f, 0x14, 0xe1,
@@ -287,35 +774,35 @@ var rsaRC4ClientScript = [][]byte{
 		0x10, 0x08, 0xc6, 0x9b, 0xd4, 0x67, 0xcd, 0x28,
 		0xbe, 0x9c, 0x48, 0x14, 0x03, 0x01, 0x00, 0x01,
 		0x01, 0x16, 0x03, 0x01, 0x00, 0x24, 0xc1, 0xb8,
-		0xd3, 0x7f, 0xc5, 0xc2, 0x5a, 0x1d, 0x6d, 0x5b,
-		0x2d, 0x5c, 0x82, 0x87, 0xc2, 0x6f, 0x0d, 0x63,
-		0x7b, 0x72, 0x2b, 0xda, 0x69, 0xc4, 0xfe, 0x3c,
-		0x84, 0xa1, 0x5a, 0x62, 0x38, 0x37, 0xc6, 0x54,
-		0x25, 0x2a,
+		0xd3, 0x7f, 0x8a, 0x7e, 0x68, 0x5d, 0x48, 0xcc,
+		0x7d, 0x55, 0x5a, 0x3d, 0xfd, 0x89, 0xe5, 0xff,
+		0x80, 0x2a, 0x00, 0x07, 0xcc, 0x95, 0x14, 0xa0,
+		0x0c, 0xe5, 0x77, 0x22, 0x7c, 0x27, 0xaf, 0xa2,
+		0xe6, 0xeb,
 	},
-
 	{
 		0x14, 0x03, 0x01, 0x00, 0x01, 0x01, 0x16, 0x03,
-		0x01, 0x00, 0x24, 0xea, 0x88, 0x9c, 0x00, 0xf6,
-		0x35, 0xb8, 0x42, 0x7f, 0x15, 0x17, 0x76, 0x5e,
-		0x4b, 0x24, 0xcb, 0x7e, 0xa0, 0x7b, 0xc3, 0x70,
-		0x52, 0x0a, 0x88, 0x2a, 0x7a, 0x45, 0x59, 0x90,
-		0x59, 0xac, 0xc6, 0xb5, 0x56, 0x55, 0x96,
+		0x01, 0x00, 0x24, 0xea, 0x88, 0x9c, 0x00, 0x64,
+		0x67, 0x9e, 0xa8, 0xc0, 0xcc, 0x6d, 0x11, 0xea,
+		0x35, 0xa1, 0xfd, 0xdc, 0x7c, 0x64, 0x63, 0x81,
+		0x39, 0xe9, 0xa7, 0x03, 0xdc, 0x1e, 0xb1, 0xa7,
+		0x74, 0x08, 0x35, 0x78, 0x9e, 0xfd, 0x2a,
 	},
 }
 
 var ecdheRSAAESClientScript = [][]byte{
 	{
-		0x16, 0x03, 0x01, 0x00, 0x4a, 0x01, 0x00, 0x00,
-		0x46, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x16, 0x03, 0x01, 0x00, 0x50, 0x01, 0x00, 0x00,
+		0x4c, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xc0, 0x13,
-		0x01, 0x00, 0x00, 0x1b, 0x00, 0x05, 0x00, 0x05,
+		0x01, 0x00, 0x00, 0x21, 0x00, 0x05, 0x00, 0x05,
 		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
-		0x08, 0x00, 0x06, 0x00, 0x17, 0x00, 0x18, 0x00,
-		0x19, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00,
+		0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00,
+		0x18, 0x00, 0x19, 0x00, 0x0b, 0x00, 0x02, 0x01,
+		0x00, 0x00, 0x12, 0x00, 0x00,
 	},
 	{
 		0x16, 0x03, 0x01, 0x00, 0x52, 0x02, 0x00, 0x00,
@@ -432,66 +919,55 @@ var ecdheRSAAESClientScript = [][]byte{
 		0xe2, 0x32, 0x42, 0xe9, 0x58, 0xb6, 0xd7, 0x49,
 		0xa6, 0xb5, 0x68, 0x1a, 0x41, 0x03, 0x56, 0x6b,
 		0xdc, 0x5a, 0x89, 0x14, 0x03, 0x01, 0x00, 0x01,
-		0x01, 0x16, 0x03, 0x01, 0x00, 0x30, 0xd9, 0xa7,
-		0x80, 0x56, 0x3f, 0xa3, 0x8f, 0x96, 0x72, 0x4e,
-		0x4e, 0x6e, 0x23, 0x41, 0x8f, 0xda, 0x91, 0xb2,
-		0x9e, 0x63, 0x23, 0x82, 0x64, 0xcd, 0x07, 0x24,
-		0xd3, 0x40, 0x20, 0x22, 0x4c, 0xe3, 0xff, 0x38,
-		0xbb, 0x43, 0x9d, 0x57, 0x11, 0xd5, 0x46, 0xa5,
-		0x05, 0x29, 0x92, 0x02, 0xce, 0xdf,
+		0x01, 0x16, 0x03, 0x01, 0x00, 0x30, 0xf7, 0x9c,
+		0xf3, 0xe6, 0x34, 0x0b, 0x5a, 0xe4, 0x62, 0x18,
+		0xc5, 0x9f, 0x37, 0xf0, 0x60, 0x54, 0x13, 0x81,
+		0x90, 0x39, 0xd1, 0x94, 0xfc, 0x25, 0x85, 0xad,
+		0x66, 0x4a, 0x8f, 0xa0, 0xca, 0x4e, 0xb0, 0x3d,
+		0x0e, 0x3b, 0x16, 0xa0, 0x65, 0x36, 0x19, 0x16,
+		0x02, 0xc1, 0x1c, 0x2c, 0xb3, 0xd6,
 	},
 	{
 		0x14, 0x03, 0x01, 0x00, 0x01, 0x01, 0x16, 0x03,
-		0x01, 0x00, 0x90, 0xe7, 0xba, 0x0e, 0xb1, 0xda,
-		0x92, 0xb5, 0x77, 0x56, 0x38, 0xa6, 0x22, 0xc1,
-		0x72, 0xeb, 0x8a, 0x68, 0x09, 0xb6, 0x74, 0xad,
-		0xb3, 0x4a, 0xf2, 0xdd, 0x09, 0x9b, 0xc9, 0x4f,
-		0x84, 0x73, 0x8b, 0xd6, 0x97, 0x50, 0x23, 0x1c,
-		0xa0, 0xc2, 0x0c, 0x25, 0x18, 0xdd, 0x5e, 0x15,
-		0x4d, 0xd9, 0xef, 0x4f, 0x6a, 0x43, 0x61, 0x9c,
-		0x95, 0xde, 0x3c, 0x66, 0xc4, 0xc1, 0x33, 0x56,
-		0xdd, 0x2f, 0x90, 0xaf, 0x68, 0x5c, 0x9c, 0xa4,
-		0x90, 0x6d, 0xbf, 0x51, 0x1d, 0x68, 0xcb, 0x81,
-		0x77, 0x52, 0xa0, 0x93, 0x2a, 0xf8, 0xc7, 0x61,
-		0x87, 0x76, 0xca, 0x93, 0x9e, 0xd6, 0xee, 0x6f,
-		0x3f, 0xeb, 0x7d, 0x06, 0xdd, 0x73, 0x4e, 0x27,
-		0x16, 0x63, 0x92, 0xe4, 0xb2, 0x3f, 0x91, 0x23,
-		0x21, 0x97, 0x90, 0xce, 0x53, 0xb8, 0xb0, 0x9d,
-		0xbd, 0xbd, 0x33, 0x84, 0xad, 0x6b, 0x2e, 0x7b,
-		0xf5, 0xeb, 0x1d, 0x64, 0x37, 0x2e, 0x29, 0x4e,
-		0xb0, 0x93, 0xdb, 0x92, 0xc7, 0xaa, 0x94, 0xa5,
-		0x3b, 0x64, 0xd0,
+		0x01, 0x00, 0x30, 0x67, 0xb3, 0xcc, 0xdb, 0x7b,
+		0x34, 0x2c, 0x3a, 0x4b, 0x9a, 0x05, 0x49, 0xc6,
+		0x4d, 0x4b, 0xfa, 0x04, 0xc8, 0x8f, 0x23, 0x19,
+		0xed, 0x0f, 0x4f, 0x26, 0x86, 0x93, 0xa0, 0xdb,
+		0x50, 0x35, 0x4e, 0xa6, 0x08, 0x9c, 0x40, 0xe4,
+		0xa6, 0x0d, 0xa6, 0x37, 0x77, 0x2c, 0x48, 0x0e,
+		0x22, 0x61, 0xfb,
 	},
 	{
-		0x17, 0x03, 0x01, 0x00, 0x20, 0x11, 0xd8, 0x6b,
-		0x3c, 0xf6, 0xbe, 0xf4, 0x54, 0x87, 0xec, 0x75,
-		0x0c, 0x44, 0xdb, 0x92, 0xfc, 0xde, 0x7e, 0x0f,
-		0x9f, 0x87, 0x87, 0x9c, 0x03, 0xd5, 0x07, 0x84,
-		0xe0, 0x3a, 0xf8, 0xae, 0x14, 0x17, 0x03, 0x01,
-		0x00, 0x20, 0xba, 0x54, 0xef, 0x5b, 0xce, 0xfd,
-		0x47, 0x76, 0x6d, 0xa1, 0x8b, 0xfd, 0x48, 0xde,
-		0x6e, 0x26, 0xc1, 0x0c, 0x9d, 0x54, 0xbf, 0x98,
-		0xf6, 0x1c, 0x80, 0xb9, 0xca, 0x93, 0x81, 0x0a,
-		0x2e, 0x06, 0x15, 0x03, 0x01, 0x00, 0x20, 0x93,
-		0x3e, 0x38, 0x17, 0xc9, 0x0a, 0xc3, 0xea, 0xd3,
-		0x92, 0x75, 0xa6, 0x53, 0x37, 0x4d, 0x74, 0x94,
-		0xbe, 0x01, 0xdc, 0x5c, 0x5a, 0x0f, 0x09, 0xf6,
-		0x57, 0x33, 0xc3, 0xbc, 0x3f, 0x7a, 0x4d,
+		0x17, 0x03, 0x01, 0x00, 0x20, 0xe5, 0x11, 0x34,
+		0xcb, 0x4f, 0x8f, 0x59, 0x48, 0x6f, 0xdb, 0xf1,
+		0x50, 0x0c, 0x26, 0x4d, 0x5f, 0xda, 0x89, 0xe2,
+		0x89, 0xdb, 0xed, 0x84, 0x43, 0xd7, 0x0e, 0x8a,
+		0x4d, 0xfa, 0x5e, 0x47, 0xc3, 0x17, 0x03, 0x01,
+		0x00, 0x20, 0x55, 0x9d, 0x62, 0x41, 0x06, 0x00,
+		0xba, 0x8f, 0x77, 0x73, 0xa6, 0x7e, 0x45, 0xfc,
+		0xc2, 0x98, 0xea, 0xbc, 0x3b, 0x6b, 0x57, 0xdc,
+		0x0d, 0x78, 0x05, 0x8a, 0x89, 0xc3, 0x9d, 0x0b,
+		0xd2, 0x01, 0x15, 0x03, 0x01, 0x00, 0x20, 0x3d,
+		0xc3, 0x78, 0x92, 0x5f, 0xa0, 0x14, 0x2d, 0xb1,
+		0x63, 0x0b, 0xcd, 0x90, 0x64, 0xcf, 0xd7, 0x06,
+		0xac, 0x06, 0xbd, 0x0e, 0x38, 0x1d, 0x4f, 0x4b,
+		0x0d, 0xbc, 0x65, 0xd7, 0x1a, 0xb3, 0xac,
 	},
 }
 
 var emptyRecordScript = [][]byte{
 	{
-		0x16, 0x03, 0x01, 0x00, 0x4a, 0x01, 0x00, 0x00,
-		0x46, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x16, 0x03, 0x01, 0x00, 0x50, 0x01, 0x00, 0x00,
+		0x4c, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x35,
-		0x01, 0x00, 0x00, 0x1b, 0x00, 0x05, 0x00, 0x05,
+		0x01, 0x00, 0x00, 0x21, 0x00, 0x05, 0x00, 0x05,
 		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
-		0x08, 0x00, 0x06, 0x00, 0x17, 0x00, 0x18, 0x00,
-		0x19, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00,
+		0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00,
+		0x18, 0x00, 0x19, 0x00, 0x0b, 0x00, 0x02, 0x01,
+		0x00, 0x00, 0x12, 0x00, 0x00,
 	},
 	{
 		0x16, 0x03, 0x01, 0x00, 0x4a, 0x02, 0x00, 0x00,
@@ -940,110 +1416,111 @@ var emptyRecordScript = [][]byte{
 		0x06, 0x44, 0x7b, 0x3c, 0x8b, 0x13, 0x96, 0xf5,
 		0x02, 0xb1, 0x4f, 0x3c, 0x2d, 0x4a, 0x16, 0x03,
 		0x01, 0x00, 0x86, 0x0f, 0x00, 0x00, 0x82, 0x00,
-		0x80, 0x52, 0xb1, 0x0d, 0xfc, 0x85, 0x34, 0x56,
-		0xb9, 0xdf, 0xa7, 0x8e, 0xf4, 0xfd, 0x02, 0x46,
-		0x8a, 0x23, 0xcc, 0x53, 0x3b, 0x0f, 0xa7, 0x61,
-		0xf3, 0xb5, 0xbf, 0xfe, 0x59, 0x77, 0x10, 0xd6,
-		0x56, 0x93, 0x19, 0x6b, 0x2c, 0xf1, 0x35, 0x71,
-		0xe3, 0x36, 0x2f, 0xa0, 0x90, 0x4e, 0x5a, 0xdf,
-		0x8d, 0x06, 0x88, 0xcf, 0xb1, 0x06, 0x56, 0x8b,
-		0x74, 0x8f, 0x02, 0x8e, 0x10, 0xd2, 0xab, 0x8d,
-		0x3f, 0x3e, 0x02, 0xf1, 0x1a, 0x80, 0x6d, 0x0f,
-		0x9e, 0x77, 0xd8, 0xfa, 0x92, 0xb3, 0x16, 0x40,
-		0xeb, 0x9e, 0xca, 0xd7, 0xe4, 0x31, 0xcc, 0x63,
-		0x5f, 0xe2, 0x4c, 0x85, 0x0e, 0xf2, 0xdd, 0xd3,
-		0xfe, 0x7e, 0xa7, 0x60, 0x1c, 0xb4, 0x00, 0xd8,
-		0xbe, 0x4b, 0x9b, 0x66, 0x78, 0x0f, 0xfb, 0x3b,
-		0x52, 0x30, 0x2b, 0x8b, 0xd9, 0xef, 0x82, 0x0a,
-		0xa4, 0x18, 0x1d, 0xb0, 0xb5, 0xbf, 0x54, 0x97,
-		0x0c, 0x14, 0x03, 0x01, 0x00, 0x01, 0x01, 0x16,
-		0x03, 0x01, 0x00, 0x30, 0xa1, 0x74, 0x22, 0xd8,
-		0x86, 0x6a, 0xbe, 0x53, 0x34, 0x1d, 0xb3, 0x73,
-		0xff, 0x51, 0xc0, 0xce, 0x8e, 0x7d, 0x9b, 0xab,
-		0xcb, 0x8b, 0x79, 0xae, 0x04, 0x01, 0xa7, 0xf2,
-		0x8e, 0x9d, 0xab, 0xa3, 0x73, 0x80, 0x5c, 0xff,
-		0x96, 0x20, 0xbb, 0x8d, 0xc0, 0x02, 0x66, 0x6c,
-		0x83, 0x4b, 0x78, 0x20,
+		0x80, 0x04, 0x6d, 0x7c, 0x3b, 0x67, 0xe8, 0x60,
+		0xd6, 0x02, 0xdd, 0xd8, 0x56, 0x27, 0x9a, 0xb0,
+		0x82, 0xb4, 0x15, 0x33, 0x36, 0x02, 0x7c, 0x55,
+		0x5f, 0x57, 0xba, 0x6e, 0xf3, 0x03, 0xd2, 0xd9,
+		0xf8, 0xc6, 0xe7, 0xd2, 0x75, 0xeb, 0xa6, 0x73,
+		0x73, 0x83, 0xb2, 0x43, 0x16, 0x30, 0x08, 0x12,
+		0x67, 0xc0, 0xce, 0x97, 0x7e, 0x9b, 0xb2, 0x5f,
+		0x01, 0x29, 0x1d, 0x31, 0xab, 0xf3, 0xc6, 0x24,
+		0x15, 0xb2, 0x20, 0x1b, 0x77, 0xb9, 0x9a, 0xb5,
+		0x86, 0x0b, 0xcd, 0xb8, 0x41, 0xda, 0x98, 0xc2,
+		0xac, 0x22, 0x70, 0x3b, 0x87, 0x75, 0x15, 0x03,
+		0x0b, 0x37, 0x01, 0xd9, 0x7b, 0xaf, 0x86, 0xa5,
+		0x3b, 0x82, 0x9a, 0x7e, 0xca, 0x71, 0x1f, 0x4d,
+		0xfa, 0x85, 0x58, 0x22, 0x99, 0x46, 0x14, 0xe3,
+		0x2f, 0xc2, 0x70, 0x7e, 0x80, 0xe7, 0x80, 0xcf,
+		0x9c, 0x7f, 0x12, 0xe0, 0xb4, 0x9a, 0x95, 0xa2,
+		0xda, 0x14, 0x03, 0x01, 0x00, 0x01, 0x01, 0x16,
+		0x03, 0x01, 0x00, 0x30, 0x06, 0x30, 0xd4, 0x36,
+		0x67, 0x2a, 0x8d, 0xee, 0x93, 0x94, 0x30, 0xcf,
+		0xab, 0xc8, 0x5b, 0xee, 0xb2, 0xda, 0xca, 0x5f,
+		0x3a, 0xd8, 0xd2, 0x65, 0x36, 0xcb, 0x5b, 0x20,
+		0xbd, 0xff, 0x42, 0xfb, 0x0b, 0xe1, 0x8c, 0x5e,
+		0xae, 0xcd, 0xba, 0xf8, 0xf6, 0xdd, 0x2c, 0x58,
+		0x52, 0x60, 0xe1, 0xce,
 	},
 	{
 		0x14, 0x03, 0x01, 0x00, 0x01, 0x01, 0x16, 0x03,
-		0x01, 0x00, 0x30, 0x29, 0xd4, 0xfd, 0x03, 0x8b,
-		0x30, 0x20, 0xf7, 0xca, 0xc0, 0x6c, 0x83, 0x5d,
-		0x73, 0xcb, 0x81, 0x60, 0xe0, 0x9a, 0x09, 0xcb,
-		0x33, 0x03, 0x80, 0x81, 0x4e, 0x84, 0x47, 0xd5,
-		0x74, 0x6c, 0x3b, 0xb5, 0xc0, 0x48, 0x0d, 0x52,
-		0xdd, 0xbe, 0xc2, 0x06, 0xf5, 0x79, 0x2b, 0x3e,
-		0x99, 0x56, 0x94, 0x17, 0x03, 0x01, 0x00, 0x20,
-		0x26, 0x46, 0x90, 0x9d, 0xef, 0x59, 0x00, 0xb6,
-		0x70, 0xe8, 0x1e, 0x1a, 0x80, 0x8b, 0x04, 0xb2,
-		0xfc, 0x51, 0xf8, 0x93, 0xbe, 0x00, 0x28, 0xba,
-		0xb8, 0xdc, 0x51, 0x7e, 0x92, 0x80, 0xfa, 0xf2,
-		0x17, 0x03, 0x01, 0x00, 0xe0, 0xb8, 0x2e, 0xc4,
-		0x6b, 0x3f, 0xda, 0x39, 0x87, 0x7f, 0x03, 0x43,
-		0x28, 0xdd, 0xb9, 0xf9, 0x9e, 0x16, 0xf5, 0xce,
-		0x3f, 0x7e, 0x6a, 0x7b, 0xb3, 0x60, 0x14, 0xe1,
-		0xea, 0x54, 0xc5, 0xe6, 0x05, 0x0a, 0x6c, 0xe0,
-		0xef, 0x58, 0x29, 0x8a, 0x77, 0x64, 0x77, 0x5d,
-		0x9c, 0xe2, 0xe0, 0x3c, 0x6d, 0x87, 0x82, 0xbe,
-		0x47, 0x63, 0xd4, 0xfd, 0x0c, 0x25, 0xc4, 0xb1,
-		0xfe, 0x29, 0x6f, 0x84, 0xfb, 0xab, 0x6e, 0xa7,
-		0xf9, 0x22, 0x89, 0x97, 0x5b, 0x91, 0x0a, 0x07,
-		0xe0, 0xef, 0x3d, 0x67, 0xee, 0x87, 0xa8, 0x33,
-		0x02, 0x64, 0x33, 0xca, 0x15, 0x10, 0xb9, 0x57,
-		0xd8, 0xe5, 0x1a, 0x4b, 0xe3, 0x45, 0xc1, 0x62,
-		0x85, 0x50, 0xf1, 0x79, 0x54, 0xe1, 0x2e, 0x25,
-		0x01, 0x3c, 0xdb, 0x2d, 0x39, 0x14, 0x2f, 0x9b,
-		0xd0, 0x1d, 0xc1, 0xac, 0x73, 0x7d, 0xa4, 0xed,
-		0x89, 0x98, 0xb1, 0xae, 0x8a, 0x9e, 0xc8, 0xa7,
-		0xfe, 0x55, 0x27, 0xb5, 0xb5, 0xa2, 0xec, 0x7e,
-		0xe3, 0x6b, 0x45, 0x19, 0xfa, 0x20, 0x1c, 0x33,
-		0x83, 0x22, 0x33, 0x97, 0xd2, 0x5a, 0xc4, 0xf8,
-		0x9a, 0x03, 0x13, 0x85, 0xf2, 0x2b, 0x04, 0x59,
-		0x27, 0xd7, 0x0b, 0x42, 0x47, 0x9b, 0x7d, 0x4d,
-		0xb2, 0x1a, 0x85, 0x7f, 0x97, 0xc2, 0xf2, 0x10,
-		0xf0, 0xfa, 0x4e, 0x4b, 0x62, 0x43, 0x3a, 0x09,
-		0x2e, 0xcd, 0x8f, 0xa8, 0xb6, 0x0b, 0x5f, 0x34,
-		0xd7, 0x3b, 0xba, 0xd9, 0xe5, 0x01, 0x2d, 0x35,
-		0xae, 0xc5, 0x4c, 0xab, 0x40, 0x64, 0xc2, 0xc9,
-		0x8c, 0x69, 0x44, 0xf4, 0xb8, 0xb5, 0x3a, 0x05,
-		0x3c, 0x29, 0x19, 0xb4, 0x09, 0x17, 0x03, 0x01,
-		0x00, 0x20, 0xc8, 0xc5, 0xb7, 0xe3, 0xd2, 0x3e,
-		0x27, 0xb5, 0x71, 0x8f, 0x52, 0x0b, 0xce, 0x17,
-		0x64, 0x86, 0xa4, 0x34, 0x16, 0x1b, 0x61, 0x64,
-		0x7c, 0xb3, 0xf2, 0xe5, 0x3e, 0xfd, 0xdd, 0xfb,
-		0x40, 0x78, 0x17, 0x03, 0x01, 0x00, 0x50, 0x8e,
-		0x79, 0xf0, 0x8e, 0x76, 0x5d, 0x34, 0x09, 0xdc,
-		0xec, 0x6d, 0xc3, 0x43, 0x1d, 0xcb, 0x2d, 0xaa,
-		0x08, 0x7a, 0x51, 0x94, 0x4e, 0xc5, 0x26, 0xe4,
-		0x0b, 0x8e, 0x8f, 0x51, 0xf2, 0x9f, 0xeb, 0xc3,
-		0x18, 0x43, 0x95, 0x15, 0xfc, 0x59, 0x18, 0x25,
-		0x47, 0xb6, 0x4a, 0x6e, 0xa3, 0xa4, 0x3b, 0xa3,
-		0x47, 0x34, 0x74, 0x6b, 0xc5, 0x3d, 0x41, 0x14,
-		0x64, 0xd5, 0x69, 0x5f, 0x77, 0xf3, 0x7c, 0x41,
-		0xc6, 0xed, 0x2e, 0xcf, 0xff, 0x40, 0xf2, 0xce,
-		0xbb, 0xa7, 0x4e, 0x73, 0x88, 0x98, 0x10,
+		0x01, 0x00, 0x30, 0x19, 0xd5, 0x92, 0x3b, 0xdc,
+		0x48, 0x9c, 0x09, 0x80, 0x2b, 0x3d, 0x9d, 0xc0,
+		0x7e, 0x2f, 0xb1, 0x80, 0x1e, 0xff, 0x1e, 0xb4,
+		0xc2, 0xfd, 0xbe, 0x69, 0x0d, 0x3f, 0xd5, 0xf2,
+		0x53, 0x12, 0xd1, 0x70, 0x26, 0xe9, 0x8f, 0xfa,
+		0x33, 0xe0, 0xb4, 0xe5, 0xca, 0xb6, 0xd5, 0x13,
+		0x30, 0xa6, 0x0d, 0x17, 0x03, 0x01, 0x00, 0x20,
+		0x29, 0xd5, 0xf2, 0xd3, 0x18, 0x46, 0xaf, 0xd1,
+		0xa7, 0x1e, 0xe1, 0xa6, 0x3a, 0x28, 0x43, 0xf3,
+		0x59, 0xf0, 0x03, 0x92, 0x61, 0xc5, 0xb9, 0xa1,
+		0x12, 0xbb, 0xb3, 0xb4, 0x39, 0xac, 0x0e, 0x29,
+		0x17, 0x03, 0x01, 0x00, 0xe0, 0xae, 0xfa, 0x30,
+		0x36, 0xf4, 0x3a, 0xd9, 0x09, 0x2e, 0x5b, 0x0a,
+		0x14, 0x58, 0x88, 0xae, 0x6a, 0x18, 0xd4, 0xcf,
+		0xd2, 0x36, 0xbb, 0x84, 0x65, 0xae, 0x77, 0x17,
+		0x68, 0xac, 0x7f, 0x9c, 0xd1, 0xee, 0x28, 0x4b,
+		0x25, 0xe6, 0xbc, 0x91, 0xf1, 0x96, 0x6d, 0x57,
+		0xd8, 0x80, 0xb0, 0xb6, 0x83, 0x94, 0x3b, 0x04,
+		0xa4, 0x27, 0x9c, 0x4a, 0x48, 0x53, 0x6e, 0x3a,
+		0x42, 0x5e, 0x9f, 0xc4, 0x94, 0x58, 0x3e, 0xb9,
+		0xd4, 0x6d, 0x34, 0x5d, 0x52, 0x32, 0xb4, 0x4c,
+		0x79, 0x21, 0x15, 0x7d, 0x81, 0xf3, 0x26, 0xfc,
+		0x2d, 0x5a, 0xed, 0x55, 0x91, 0xa1, 0xc4, 0x63,
+		0xd3, 0xb8, 0xeb, 0x53, 0x09, 0x27, 0x99, 0x09,
+		0x18, 0x33, 0xda, 0xe1, 0x2b, 0x58, 0x95, 0xf8,
+		0xad, 0xc5, 0xf6, 0x59, 0xd1, 0xb7, 0x57, 0x94,
+		0x42, 0xbd, 0x4a, 0x86, 0xa6, 0x10, 0x10, 0x4d,
+		0xe7, 0xf7, 0x89, 0x5e, 0x2d, 0xac, 0x31, 0xad,
+		0x3a, 0x21, 0xa2, 0x55, 0x0d, 0x9b, 0xa0, 0xe9,
+		0xe1, 0x8f, 0xb5, 0x5a, 0x84, 0xa0, 0xe4, 0x0a,
+		0x5a, 0xad, 0xbe, 0x22, 0x9c, 0x4c, 0xea, 0x5d,
+		0x1f, 0x48, 0x51, 0x18, 0x8c, 0x91, 0xbc, 0x90,
+		0x8c, 0xc1, 0x04, 0x8e, 0x9f, 0xd0, 0x39, 0xf2,
+		0x24, 0x4a, 0xfa, 0x7c, 0xb4, 0x53, 0x4c, 0x5c,
+		0x39, 0x39, 0x6b, 0x82, 0xb8, 0x01, 0x63, 0x98,
+		0x31, 0x06, 0x02, 0xc7, 0xb7, 0xf9, 0x78, 0x44,
+		0xe5, 0xdb, 0x0e, 0xb9, 0x67, 0xd8, 0xf9, 0x4e,
+		0x99, 0x48, 0x1d, 0xf4, 0xa0, 0xb3, 0x13, 0x7e,
+		0x64, 0xbc, 0x1d, 0x45, 0x19, 0x12, 0x14, 0x42,
+		0x01, 0xda, 0x79, 0x0f, 0xe8, 0x17, 0x03, 0x01,
+		0x00, 0x20, 0x95, 0x87, 0x6d, 0x68, 0x67, 0xc6,
+		0xac, 0x72, 0x1a, 0xe8, 0x0b, 0x67, 0xc4, 0xa0,
+		0xb5, 0x1e, 0xdb, 0x87, 0x13, 0xc1, 0x82, 0x2e,
+		0x25, 0x7d, 0x8f, 0x76, 0x3d, 0x97, 0x87, 0x1d,
+		0xef, 0x9a, 0x17, 0x03, 0x01, 0x00, 0x50, 0x49,
+		0x95, 0x58, 0x42, 0x86, 0x53, 0x35, 0x12, 0xf3,
+		0x58, 0x24, 0x5c, 0xe2, 0x5d, 0x29, 0xbf, 0x97,
+		0x11, 0x51, 0x0f, 0xa1, 0x2d, 0x30, 0x02, 0x2c,
+		0xe9, 0x44, 0x41, 0xbb, 0xd6, 0x37, 0xee, 0xa4,
+		0xf6, 0x29, 0xb3, 0x27, 0x0c, 0xf4, 0x0a, 0xee,
+		0x13, 0x72, 0x8a, 0xf4, 0xca, 0x08, 0xe9, 0x8c,
+		0x10, 0x8a, 0xb3, 0x89, 0x77, 0x42, 0x83, 0x42,
+		0x9c, 0xae, 0x4d, 0x26, 0xbb, 0x98, 0xaf, 0x8e,
+		0x69, 0x80, 0x5d, 0x9b, 0xb0, 0xaa, 0x94, 0x64,
+		0x45, 0xf0, 0xf1, 0x69, 0xe0, 0x5f, 0xe9,
 	},
 	{
-		0x15, 0x03, 0x01, 0x00, 0x20, 0x1a, 0xbc, 0x70,
-		0x24, 0xf8, 0xfb, 0xf2, 0x4a, 0xf9, 0x44, 0x1e,
-		0x58, 0xf8, 0xaa, 0x41, 0x24, 0xe8, 0x80, 0x33,
-		0x45, 0x18, 0xa1, 0x5d, 0xee, 0x16, 0x80, 0xae,
-		0x40, 0x41, 0x8e, 0x41, 0x9b,
+		0x15, 0x03, 0x01, 0x00, 0x20, 0xdb, 0xb5, 0x45,
+		0x45, 0x22, 0x7a, 0x60, 0x48, 0x19, 0xb3, 0x25,
+		0x2e, 0xce, 0x1d, 0xfa, 0x79, 0x83, 0xa9, 0x5d,
+		0x96, 0xcc, 0x0b, 0x0c, 0x31, 0x3d, 0x64, 0x79,
+		0x6e, 0x52, 0x6f, 0x5d, 0x3c,
 	},
 }
 
 var tls11ECDHEAESClientScript = [][]byte{
 	{
-		0x16, 0x03, 0x01, 0x00, 0x4a, 0x01, 0x00, 0x00,
-		0x46, 0x03, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x16, 0x03, 0x01, 0x00, 0x50, 0x01, 0x00, 0x00,
+		0x4c, 0x03, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xc0, 0x13,
-		0x01, 0x00, 0x00, 0x1b, 0x00, 0x05, 0x00, 0x05,
+		0x01, 0x00, 0x00, 0x21, 0x00, 0x05, 0x00, 0x05,
 		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
-		0x08, 0x00, 0x06, 0x00, 0x17, 0x00, 0x18, 0x00,
-		0x19, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00,
+		0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00,
+		0x18, 0x00, 0x19, 0x00, 0x0b, 0x00, 0x02, 0x01,
+		0x00, 0x00, 0x12, 0x00, 0x00,
 	},
 	{
 		0x16, 0x03, 0x02, 0x00, 0x54, 0x02, 0x00, 0x00,
@@ -1162,25 +1639,25 @@ var tls11ECDHEAESClientScript = [][]byte{
 		0xdc, 0x5a, 0x89, 0x14, 0x03, 0x02, 0x00, 0x01,
 		0x01, 0x16, 0x03, 0x02, 0x00, 0x40, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x50,
-		0x32, 0x26, 0x51, 0xbd, 0xbd, 0x3c, 0x4f, 0x72,
-		0xbf, 0xbc, 0x91, 0x70, 0x4b, 0x5d, 0x43, 0x4a,
-		0x65, 0x26, 0x0d, 0xaa, 0xed, 0x00, 0x91, 0xaf,
-		0x4f, 0x47, 0x09, 0xaa, 0x79, 0xc4, 0x47, 0x21,
-		0x71, 0xd8, 0x2b, 0xc1, 0x51, 0xc8, 0xef, 0xed,
-		0x67, 0xde, 0x97, 0xef, 0x18, 0x53,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x64, 0xa3,
+		0xaa, 0xa7, 0xfa, 0xdb, 0x6b, 0xf0, 0x64, 0x8e,
+		0xcd, 0xd1, 0x95, 0x08, 0x2d, 0x1e, 0x1d, 0xa9,
+		0xc3, 0xc9, 0xdc, 0x3d, 0x47, 0xe5, 0x52, 0x6b,
+		0xc7, 0x53, 0x81, 0x8a, 0x10, 0x25, 0xd3, 0x2a,
+		0x93, 0x5d, 0xa4, 0x35, 0xf8, 0xbe, 0xf7, 0x9e,
+		0x75, 0x2e, 0xc0, 0x57, 0x39, 0x14,
 	},
 	{
 		0x14, 0x03, 0x02, 0x00, 0x01, 0x01, 0x16, 0x03,
 		0x02, 0x00, 0x40, 0x72, 0x20, 0xbf, 0xd1, 0xbd,
 		0x83, 0x53, 0x57, 0xb0, 0x4e, 0xac, 0xba, 0x1a,
-		0x2b, 0x2d, 0xeb, 0x8a, 0x48, 0x17, 0xfa, 0x69,
-		0xf9, 0xb5, 0x94, 0x8e, 0x6f, 0x9c, 0xda, 0x59,
-		0xba, 0x6c, 0x7c, 0x82, 0xe2, 0x53, 0xa9, 0x46,
-		0xdc, 0x33, 0xa0, 0x9b, 0xf0, 0x1e, 0xf1, 0x53,
-		0x83, 0x48, 0xbf, 0x5e, 0xef, 0x03, 0x2b, 0x50,
-		0x7a, 0xa6, 0xf8, 0xc3, 0x9e, 0x24, 0x43, 0x3a,
-		0xdf, 0x44, 0x3e,
+		0x2b, 0x2d, 0xeb, 0xb5, 0xf1, 0x22, 0x9d, 0x2c,
+		0x1a, 0x21, 0xaf, 0xf2, 0x93, 0xa1, 0x15, 0x96,
+		0xc7, 0x07, 0x6f, 0x27, 0xed, 0xea, 0x6a, 0xc4,
+		0x22, 0xac, 0xed, 0xe3, 0x50, 0x1a, 0x37, 0x2e,
+		0xbf, 0x96, 0xed, 0x49, 0x3e, 0x95, 0x3e, 0x7a,
+		0x92, 0xad, 0xb8, 0xc9, 0x89, 0x77, 0xd6, 0xac,
+		0x0d, 0xf1, 0x22,
 	},
 	{
 		0x17, 0x03, 0x02, 0x00, 0x30, 0x00, 0x00, 0x00,
@@ -1202,16 +1679,17 @@ var tls11ECDHEAESClientScript = [][]byte{
 
 var clientChainCertificateScript = [][]byte{
 	{
-		0x16, 0x03, 0x01, 0x00, 0x4a, 0x01, 0x00, 0x00,
-		0x46, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x16, 0x03, 0x01, 0x00, 0x50, 0x01, 0x00, 0x00,
+		0x4c, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x05,
-		0x01, 0x00, 0x00, 0x1b, 0x00, 0x05, 0x00, 0x05,
+		0x01, 0x00, 0x00, 0x21, 0x00, 0x05, 0x00, 0x05,
 		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
-		0x08, 0x00, 0x06, 0x00, 0x17, 0x00, 0x18, 0x00,
-		0x19, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00,
+		0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00,
+		0x18, 0x00, 0x19, 0x00, 0x0b, 0x00, 0x02, 0x01,
+		0x00, 0x00, 0x12, 0x00, 0x00,
 	},
 	{
 		0x16, 0x03, 0x01, 0x00, 0x4a, 0x02, 0x00, 0x00,
@@ -1759,53 +2237,53 @@ var clientChainCertificateScript = [][]byte{
 		0xde, 0xc4, 0xe9, 0x22, 0x0a, 0x21, 0xde, 0x45,
 		0x1e, 0x55, 0x12, 0xd9, 0x44, 0xef, 0x4e, 0xaa,
 		0x5e, 0x26, 0x57, 0x16, 0x03, 0x01, 0x01, 0x06,
-		0x0f, 0x00, 0x01, 0x02, 0x01, 0x00, 0x23, 0xde,
-		0xb0, 0x39, 0x60, 0xe9, 0x82, 0xb8, 0xed, 0x17,
-		0x78, 0xd2, 0x37, 0x0e, 0x85, 0x69, 0xda, 0xcc,
-		0x9f, 0x54, 0x4d, 0xda, 0xce, 0xe8, 0x5a, 0xeb,
-		0x3c, 0x61, 0x4c, 0x7a, 0x84, 0x1f, 0x21, 0x03,
-		0xb3, 0x8a, 0x74, 0x3b, 0x6a, 0x9e, 0x4f, 0x44,
-		0xd9, 0x75, 0x0a, 0xd8, 0x7e, 0x56, 0xa3, 0xef,
-		0x5a, 0xfe, 0x8a, 0x35, 0xce, 0x29, 0x18, 0xfe,
-		0xa6, 0x61, 0x8e, 0x8f, 0x00, 0x90, 0x2d, 0x85,
-		0xe3, 0x6c, 0x0e, 0x8d, 0x8c, 0x27, 0x80, 0x8c,
-		0x9f, 0x51, 0xe9, 0xd3, 0xe6, 0x7d, 0x70, 0xe9,
-		0xfb, 0xcb, 0xb8, 0x24, 0x94, 0x30, 0x9b, 0xba,
-		0x01, 0x14, 0x49, 0x9f, 0xaf, 0x09, 0xd8, 0x26,
-		0x1b, 0x23, 0xa4, 0xb8, 0xd9, 0x44, 0x0a, 0xdc,
-		0x4e, 0x27, 0xe7, 0x32, 0xf5, 0x9c, 0xf3, 0x8d,
-		0xa0, 0xc5, 0xc4, 0xbe, 0x92, 0x02, 0x85, 0x4f,
-		0x33, 0x8f, 0xa7, 0xf7, 0x87, 0xa9, 0x44, 0xf3,
-		0x64, 0xbd, 0x32, 0x04, 0xeb, 0xc5, 0xc3, 0x62,
-		0xe9, 0xda, 0x2f, 0x95, 0x5c, 0xf7, 0x58, 0x3e,
-		0xad, 0x35, 0xd7, 0x7e, 0xad, 0xdd, 0x32, 0x8d,
-		0xce, 0x81, 0x08, 0xad, 0x49, 0xf7, 0xdb, 0xf7,
-		0xaf, 0xe3, 0xc6, 0xb2, 0xdd, 0x76, 0x0c, 0xcf,
-		0x0f, 0x87, 0x79, 0x90, 0x10, 0x79, 0xc6, 0xc8,
-		0x7b, 0xe6, 0x23, 0xf2, 0xda, 0x33, 0xca, 0xe1,
-		0xf0, 0x59, 0x42, 0x43, 0x03, 0x56, 0x19, 0xe3,
-		0x8b, 0xe6, 0xa8, 0x70, 0xbc, 0x80, 0xfa, 0x24,
-		0xae, 0x03, 0x13, 0x30, 0x0d, 0x1f, 0xab, 0xb7,
-		0x82, 0xd9, 0x24, 0x90, 0x80, 0xbf, 0x75, 0xe1,
-		0x0d, 0x1c, 0xb2, 0xfe, 0x92, 0x2c, 0x4d, 0x21,
-		0xe9, 0x5d, 0xa1, 0x68, 0xf3, 0x16, 0xd8, 0x3f,
-		0xb2, 0xc3, 0x00, 0x3e, 0xd8, 0x42, 0x25, 0x5c,
-		0x90, 0x11, 0xc0, 0x1b, 0xd4, 0x26, 0x5c, 0x37,
-		0x47, 0xbd, 0xf8, 0x1e, 0x34, 0xa9, 0x14, 0x03,
+		0x0f, 0x00, 0x01, 0x02, 0x01, 0x00, 0x82, 0x2f,
+		0x7d, 0x15, 0xcb, 0x68, 0x7a, 0x44, 0x6d, 0x95,
+		0x85, 0x41, 0xdb, 0xfd, 0x5f, 0xc3, 0x5d, 0x79,
+		0xae, 0x33, 0x14, 0x0b, 0x2e, 0xcd, 0xef, 0x85,
+		0xcb, 0xc0, 0xbc, 0x70, 0x42, 0x20, 0x19, 0x6b,
+		0x22, 0xfb, 0x0e, 0xf3, 0x1c, 0x06, 0x7c, 0x10,
+		0xc6, 0xe8, 0xd5, 0x3d, 0x7a, 0xb2, 0x9e, 0xb4,
+		0xbd, 0xeb, 0x1a, 0x97, 0x0d, 0x9b, 0x16, 0x4e,
+		0xd1, 0x96, 0xd6, 0x79, 0x64, 0x77, 0x9f, 0x59,
+		0x93, 0xf1, 0x71, 0x2d, 0x81, 0x14, 0x18, 0x9d,
+		0x96, 0x4b, 0xf4, 0xc6, 0xb4, 0x25, 0x02, 0xdd,
+		0x1c, 0xe2, 0xd5, 0xa6, 0x86, 0xba, 0x6e, 0x9e,
+		0x28, 0x58, 0xe0, 0x4b, 0x00, 0x0f, 0xf7, 0x98,
+		0x4e, 0xf1, 0xed, 0x57, 0x01, 0xc1, 0x2d, 0xdb,
+		0xc4, 0xc3, 0xe0, 0xce, 0xe2, 0x54, 0x10, 0x0b,
+		0x74, 0x3d, 0x78, 0x94, 0x0e, 0xca, 0xf1, 0x5f,
+		0x0e, 0x40, 0xeb, 0x70, 0xb1, 0xb6, 0xc1, 0x0b,
+		0x39, 0xdc, 0x37, 0x20, 0xc1, 0x63, 0x12, 0x4c,
+		0x72, 0x38, 0x46, 0x63, 0xaa, 0xb2, 0x97, 0xd3,
+		0xdb, 0xd2, 0x61, 0x73, 0x5a, 0x69, 0xc3, 0x9f,
+		0x90, 0x1e, 0x23, 0xde, 0x25, 0x35, 0x8e, 0xda,
+		0x95, 0x9e, 0x69, 0x96, 0x89, 0x22, 0x35, 0x3c,
+		0x41, 0x92, 0xd1, 0x6d, 0x6d, 0xd6, 0xd9, 0x9a,
+		0x5b, 0xba, 0x7c, 0x17, 0xca, 0xff, 0xb3, 0x8e,
+		0x8e, 0x8b, 0xe1, 0xbf, 0x43, 0xa3, 0x35, 0x57,
+		0x9d, 0xe6, 0x28, 0x94, 0x0d, 0x01, 0xd8, 0x6b,
+		0x0e, 0x8b, 0x25, 0x6d, 0x8a, 0x64, 0x9b, 0x2f,
+		0xe5, 0x07, 0x96, 0x45, 0xb6, 0x56, 0x8c, 0x6d,
+		0xa3, 0x12, 0x37, 0x97, 0x3b, 0x37, 0x6e, 0x6c,
+		0x44, 0xa1, 0x78, 0xad, 0x72, 0x7a, 0x21, 0x20,
+		0x83, 0x72, 0x6a, 0xf7, 0x70, 0x1a, 0xcc, 0x9a,
+		0x43, 0xa9, 0x19, 0x72, 0x99, 0xba, 0x2f, 0xc4,
+		0xcd, 0x40, 0xab, 0x17, 0xe7, 0x2c, 0x14, 0x03,
 		0x01, 0x00, 0x01, 0x01, 0x16, 0x03, 0x01, 0x00,
-		0x24, 0x8f, 0x94, 0x7e, 0x01, 0xee, 0xd5, 0x4f,
-		0x83, 0x41, 0x31, 0xc0, 0x36, 0x81, 0x46, 0xc3,
-		0xc0, 0xcc, 0x9c, 0xea, 0x0f, 0x29, 0x04, 0x10,
-		0x43, 0x1e, 0x08, 0x6e, 0x08, 0xce, 0xb2, 0x62,
-		0xa6, 0x0f, 0x68, 0x9f, 0x99,
+		0x24, 0x8f, 0x94, 0x7e, 0x01, 0x0a, 0xce, 0xb8,
+		0x46, 0xf6, 0x70, 0x3d, 0xa7, 0x9a, 0x55, 0xed,
+		0xcc, 0xe6, 0xa7, 0xa0, 0x0a, 0xa7, 0x15, 0xf6,
+		0xec, 0x71, 0x21, 0x4a, 0xf1, 0xc4, 0xa2, 0xdb,
+		0x89, 0x65, 0x69, 0x41, 0x27,
 	},
 	{
 		0x14, 0x03, 0x01, 0x00, 0x01, 0x01, 0x16, 0x03,
-		0x01, 0x00, 0x24, 0xd9, 0x46, 0x5b, 0xbf, 0xfd,
-		0x8a, 0xa1, 0x08, 0xd5, 0xf3, 0x0c, 0x1c, 0xd8,
-		0xa8, 0xb3, 0xe5, 0x89, 0x83, 0x9e, 0x23, 0x47,
-		0x81, 0x66, 0x77, 0x11, 0x98, 0xe5, 0xf4, 0xac,
-		0x06, 0xe9, 0x4c, 0x05, 0x8b, 0xc4, 0x16,
+		0x01, 0x00, 0x24, 0xd9, 0x46, 0x5b, 0xbf, 0xd0,
+		0x73, 0x92, 0x2a, 0x3c, 0xdc, 0x78, 0x70, 0x7a,
+		0x96, 0xac, 0x75, 0x83, 0x44, 0x30, 0xf7, 0xa7,
+		0x98, 0x01, 0xfb, 0xb7, 0xd9, 0x4c, 0xed, 0xce,
+		0x97, 0x7f, 0xa6, 0x75, 0x8c, 0x8d, 0x3a,
 	},
 	{
 		0x17, 0x03, 0x01, 0x00, 0x1a, 0xc5, 0x28, 0xfd,
@@ -1825,18 +2303,19 @@ var clientChainCertificateScript = [][]byte{
 //     -minversion=0x0303 -maxversion=0x0303
 var clientTLS12Script = [][]byte{
 	{
-		0x16, 0x03, 0x01, 0x00, 0x54, 0x01, 0x00, 0x00,
-		0x50, 0x03, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x16, 0x03, 0x01, 0x00, 0x5c, 0x01, 0x00, 0x00,
+		0x58, 0x03, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xc0, 0x13,
-		0x01, 0x00, 0x00, 0x25, 0x00, 0x05, 0x00, 0x05,
+		0x01, 0x00, 0x00, 0x2d, 0x00, 0x05, 0x00, 0x05,
 		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
-		0x08, 0x00, 0x06, 0x00, 0x17, 0x00, 0x18, 0x00,
-		0x19, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00, 0x00,
-		0x0d, 0x00, 0x06, 0x00, 0x04, 0x04, 0x01, 0x04,
-		0x03,
+		0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00,
+		0x18, 0x00, 0x19, 0x00, 0x0b, 0x00, 0x02, 0x01,
+		0x00, 0x00, 0x0d, 0x00, 0x08, 0x00, 0x06, 0x04,
+		0x01, 0x04, 0x03, 0x08, 0x07, 0x00, 0x12, 0x00,
+		0x00,
 	},
 	{
 		0x16, 0x03, 0x03, 0x00, 0x54, 0x02, 0x00, 0x00,
@@ -1956,25 +2435,25 @@ var clientTLS12Script = [][]byte{
 		0xdc, 0x5a, 0x89, 0x14, 0x03, 0x03, 0x00, 0x01,
 		0x01, 0x16, 0x03, 0x03, 0x00, 0x40, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x4e, 0xcb,
-		0x97, 0x41, 0x63, 0x39, 0xeb, 0xda, 0x04, 0x39,
-		0xfb, 0x67, 0x1d, 0x6d, 0xf8, 0x58, 0xd1, 0x22,
-		0x35, 0xe3, 0xc3, 0x9d, 0xfc, 0x4e, 0xcc, 0x71,
-		0x93, 0x78, 0x64, 0x39, 0x04, 0xa0, 0xa9, 0x41,
-		0xcf, 0x4c, 0xd6, 0x34, 0xad, 0x5c, 0xc9, 0x7a,
-		0x44, 0xb1, 0x1d, 0x77, 0x52, 0x01,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0d, 0xbe,
+		0x2d, 0x2c, 0xcc, 0xbe, 0x8a, 0xb1, 0x6d, 0x21,
+		0x66, 0x9a, 0x73, 0x1f, 0xba, 0x4c, 0x80, 0x01,
+		0xac, 0xce, 0x25, 0x81, 0xe4, 0xf4, 0x1e, 0x46,
+		0x26, 0xa1, 0x61, 0x5e, 0xe2, 0x92, 0x94, 0xc0,
+		0xce, 0xe5, 0x81, 0x79, 0x4f, 0xdb, 0x7e, 0x02,
+		0x91, 0xa6, 0xdc, 0x86, 0x34, 0x73,
 	},
 	{
 		0x14, 0x03, 0x03, 0x00, 0x01, 0x01, 0x16, 0x03,
 		0x03, 0x00, 0x40, 0x55, 0x75, 0x8a, 0x57, 0xb4,
 		0x53, 0xa2, 0x8f, 0xbf, 0xd3, 0x79, 0x72, 0x1e,
-		0x94, 0x53, 0xb3, 0x03, 0x9d, 0x4d, 0x8a, 0x1f,
-		0xbd, 0x25, 0x79, 0x69, 0x61, 0x65, 0xd7, 0x26,
-		0xad, 0xa6, 0x0e, 0x89, 0x83, 0xc0, 0xbe, 0x46,
-		0x51, 0xe4, 0x38, 0x7b, 0x65, 0x74, 0x6d, 0x24,
-		0xff, 0xd4, 0x9f, 0xcb, 0xfc, 0xd7, 0x03, 0xaa,
-		0x7b, 0x8e, 0x30, 0x09, 0xdb, 0xaa, 0x9d, 0xad,
-		0xcd, 0x56, 0xcf,
+		0x94, 0x53, 0xb3, 0xf2, 0xcc, 0xf5, 0x5a, 0x14,
+		0x36, 0x70, 0x6b, 0xfb, 0x44, 0x49, 0x2e, 0xb3,
+		0x99, 0x2f, 0x15, 0xe2, 0x64, 0x8c, 0x3a, 0x82,
+		0x7b, 0x1e, 0x57, 0x93, 0xde, 0x4a, 0xe6, 0x6b,
+		0x26, 0x2b, 0xec, 0xe7, 0x6b, 0x17, 0x87, 0xe2,
+		0xd8, 0x18, 0x59, 0x8c, 0xb3, 0x02, 0x3a, 0xc9,
+		0xea, 0xc2, 0x58,
 	},
 	{
 		0x17, 0x03, 0x03, 0x00, 0x30, 0x00, 0x00, 0x00,
@@ -2319,16 +2798,17 @@ var testClientChainCertificate = fromHex(
 // -----END CERTIFICATE-----
 var ecdheECDSAAESClientScript = [][]byte{
 	{
-		0x16, 0x03, 0x01, 0x00, 0x4a, 0x01, 0x00, 0x00,
-		0x46, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x16, 0x03, 0x01, 0x00, 0x50, 0x01, 0x00, 0x00,
+		0x4c, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xc0, 0x09,
-		0x01, 0x00, 0x00, 0x1b, 0x00, 0x05, 0x00, 0x05,
+		0x01, 0x00, 0x00, 0x21, 0x00, 0x05, 0x00, 0x05,
 		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
-		0x08, 0x00, 0x06, 0x00, 0x17, 0x00, 0x18, 0x00,
-		0x19, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00,
+		0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00,
+		0x18, 0x00, 0x19, 0x00, 0x0b, 0x00, 0x02, 0x01,
+		0x00, 0x00, 0x12, 0x00, 0x00,
 	},
 	{
 		0x16, 0x03, 0x01, 0x00, 0x54, 0x02, 0x00, 0x00,
@@ -2449,38 +2929,38 @@ var ecdheECDSAAESClientScript = [][]byte{
 		0xe2, 0x32, 0x42, 0xe9, 0x58, 0xb6, 0xd7, 0x49,
 		0xa6, 0xb5, 0x68, 0x1a, 0x41, 0x03, 0x56, 0x6b,
 		0xdc, 0x5a, 0x89, 0x14, 0x03, 0x01, 0x00, 0x01,
-		0x01, 0x16, 0x03, 0x01, 0x00, 0x30, 0x1a, 0x45,
-		0x92, 0x3b, 0xac, 0x8d, 0x91, 0x89, 0xd3, 0x2c,
-		0xf4, 0x3c, 0x5f, 0x70, 0xf1, 0x79, 0xa5, 0x6a,
-		0xcf, 0x97, 0x8f, 0x3f, 0x73, 0x08, 0xca, 0x3f,
-		0x55, 0xb0, 0x28, 0xd1, 0x6f, 0xcd, 0x9b, 0xca,
-		0xb6, 0xb7, 0xd0, 0xa5, 0x21, 0x5b, 0x08, 0xf8,
-		0x42, 0xe2, 0xdf, 0x25, 0x6a, 0x16,
+		0x01, 0x16, 0x03, 0x01, 0x00, 0x30, 0x05, 0x77,
+		0xa1, 0xe5, 0xaf, 0x83, 0xbc, 0x7f, 0xe8, 0x35,
+		0xa1, 0x05, 0xf2, 0xe3, 0xa0, 0x30, 0x4e, 0x5b,
+		0xfd, 0x97, 0x61, 0xa8, 0x23, 0xe0, 0x9e, 0x79,
+		0x9d, 0xb4, 0x27, 0x64, 0x5d, 0xc8, 0xe6, 0xe6,
+		0xb7, 0x0b, 0x4b, 0x72, 0x7a, 0x69, 0xe4, 0x54,
+		0x31, 0xe1, 0x9c, 0x0d, 0x22, 0x55,
 	},
 	{
 		0x14, 0x03, 0x01, 0x00, 0x01, 0x01, 0x16, 0x03,
-		0x01, 0x00, 0x30, 0x30, 0x83, 0xb6, 0x51, 0x8a,
-		0x85, 0x4a, 0xee, 0xe4, 0xb6, 0xae, 0xf3, 0xc1,
-		0xdc, 0xd2, 0x04, 0xb3, 0xd0, 0x25, 0x47, 0x5f,
-		0xac, 0x83, 0xa3, 0x7d, 0xcf, 0x47, 0x92, 0xed,
-		0x92, 0x6c, 0xd1, 0x6e, 0xfd, 0x63, 0xf5, 0x2d,
-		0x89, 0xd8, 0x04, 0x8c, 0x62, 0x71, 0xae, 0x5e,
-		0x32, 0x48, 0xf8,
+		0x01, 0x00, 0x30, 0x34, 0xdd, 0x5b, 0x65, 0xe0,
+		0xc3, 0x78, 0x14, 0x41, 0xba, 0xd9, 0xa3, 0x65,
+		0x41, 0xa2, 0xd2, 0xe5, 0xc4, 0x5a, 0x3f, 0xdf,
+		0x60, 0xb6, 0xba, 0x11, 0x19, 0x85, 0xc5, 0xfe,
+		0x85, 0x54, 0x16, 0xb0, 0xfd, 0x3f, 0x5f, 0x7d,
+		0x5b, 0x9f, 0x0f, 0xef, 0x40, 0x21, 0x1f, 0xde,
+		0x47, 0xcc, 0x0c,
 	},
 	{
-		0x17, 0x03, 0x01, 0x00, 0x20, 0xcf, 0x5e, 0xba,
-		0xf4, 0x47, 0x32, 0x35, 0x9b, 0x85, 0xdc, 0xb3,
-		0xff, 0x77, 0x90, 0xd9, 0x2b, 0xbd, 0x59, 0x2a,
-		0x33, 0xe4, 0x6e, 0x9b, 0xfc, 0x1c, 0x73, 0x3f,
-		0x5e, 0x1e, 0xe3, 0xa4, 0xc2, 0x17, 0x03, 0x01,
-		0x00, 0x20, 0x05, 0xdf, 0x2d, 0x9b, 0x29, 0x7f,
-		0x97, 0xcd, 0x49, 0x04, 0x53, 0x22, 0x1a, 0xa1,
-		0xa1, 0xe6, 0x38, 0x3a, 0x56, 0x37, 0x1f, 0xd8,
-		0x3a, 0x12, 0x2c, 0xf0, 0xeb, 0x61, 0x35, 0x76,
-		0xe5, 0xf0, 0x15, 0x03, 0x01, 0x00, 0x20, 0xa5,
-		0x56, 0xb5, 0x49, 0x4b, 0xc2, 0xd4, 0x4c, 0xf6,
-		0x95, 0x15, 0x7d, 0x41, 0x1d, 0x5c, 0x00, 0x0e,
-		0x20, 0xb1, 0x0a, 0xbc, 0xc9, 0x2a, 0x09, 0x17,
-		0xb4, 0xaa, 0x1c, 0x79, 0xda, 0x79, 0x27,
+		0x17, 0x03, 0x01, 0x00, 0x20, 0x4a, 0x00, 0x21,
+		0x06, 0xff, 0xf4, 0xa6, 0x4b, 0x39, 0x94, 0x93,
+		0xcf, 0x06, 0xa0, 0x62, 0x4f, 0x32, 0x81, 0x63,
+		0xcd, 0x33, 0x05, 0xac, 0x9d, 0xc7, 0xdd, 0xbc,
+		0xaa, 0x57, 0x6a, 0xd5, 0x14, 0x17, 0x03, 0x01,
+		0x00, 0x20, 0xa8, 0x0c, 0x85, 0x44, 0x21, 0xd5,
+		0xa7, 0x53, 0xc5, 0x10, 0xe6, 0xfe, 0x91, 0x7d,
+		0x56, 0x9a, 0x23, 0xe4, 0x28, 0x35, 0x32, 0x52,
+		0xff, 0x57, 0x4e, 0xc0, 0xa0, 0x38, 0xb6, 0xc6,
+		0x36, 0x3b, 0x15, 0x03, 0x01, 0x00, 0x20, 0xab,
+		0x8d, 0xc4, 0x89, 0xed, 0xcd, 0xf7, 0x63, 0xb0,
+		0x95, 0x57, 0xc5, 0xf2, 0xe8, 0x46, 0x77, 0xd7,
+		0x8c, 0xd1, 0xa5, 0x11, 0x39, 0x39, 0x3b, 0x58,
+		0x79, 0xc0, 0x2d, 0x7f, 0x88, 0xca, 0x9f,
 	},
 }
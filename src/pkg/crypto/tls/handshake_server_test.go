@@ -6,12 +6,14 @@ package tls
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/hex"
 	"encoding/pem"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -234,6 +236,11 @@ func TestHandshakeServerRSAAES(t *testing.T) {
 	testServerScript(t, "RSA-AES", rsaAESServerScript, aesConfig, nil)
 }
 
+// openSSLCurvePreferences makes the server pick the curve that the recorded
+// OpenSSL clients list first among those supported here, as the scripts
+// were recorded when the server followed the client's order.
+var openSSLCurvePreferences = []CurveID{CurveP521, CurveP384, CurveP256}
+
 func TestHandshakeServerECDHEECDSAAES(t *testing.T) {
 	ecdsaConfig := new(Config)
 	*ecdsaConfig = *testConfig
@@ -242,9 +249,63 @@ func TestHandshakeServerECDHEECDSAAES(t *testing.T) {
 	ecdsaConfig.Certificates[0].PrivateKey = testECDSAPrivateKey
 	ecdsaConfig.BuildNameToCertificate()
 	ecdsaConfig.CipherSuites = []uint16{TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA}
+	ecdsaConfig.CurvePreferences = openSSLCurvePreferences
 	testServerScript(t, "ECDHE-ECDSA-AES", ecdheECDSAAESServerScript, ecdsaConfig, nil)
 }
 
+// opaqueRSAKey hides an *rsa.PrivateKey behind the crypto.Signer and
+// crypto.Decrypter interfaces, as a hardware-backed key would.
+type opaqueRSAKey struct {
+	key *rsa.PrivateKey
+}
+
+func (k opaqueRSAKey) Public() crypto.PublicKey {
+	return k.key.Public()
+}
+
+func (k opaqueRSAKey) Sign(rand io.Reader, msg []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return k.key.Sign(rand, msg, opts)
+}
+
+func (k opaqueRSAKey) Decrypt(rand io.Reader, msg []byte, opts crypto.DecrypterOpts) ([]byte, error) {
+	return k.key.Decrypt(rand, msg, opts)
+}
+
+// opaqueSigner hides a private key behind the crypto.Signer interface.
+type opaqueSigner struct {
+	key crypto.Signer
+}
+
+func (s opaqueSigner) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s opaqueSigner) Sign(rand io.Reader, msg []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(rand, msg, opts)
+}
+
+func TestHandshakeServerOpaqueRSAKey(t *testing.T) {
+	config := new(Config)
+	*config = *testConfig
+	config.Certificates = make([]Certificate, 1)
+	config.Certificates[0].Certificate = [][]byte{testRSACertificate}
+	config.Certificates[0].PrivateKey = opaqueRSAKey{testRSAPrivateKey}
+	config.BuildNameToCertificate()
+	testServerScript(t, "RSA-RC4-Opaque", rsaRC4ServerScript, config, nil)
+}
+
+func TestHandshakeServerOpaqueECDSAKey(t *testing.T) {
+	config := new(Config)
+	*config = *testConfig
+	config.Certificates = make([]Certificate, 1)
+	config.Certificates[0].Certificate = [][]byte{testECDSACertificate}
+	config.Certificates[0].PrivateKey = opaqueSigner{testECDSAPrivateKey}
+	config.BuildNameToCertificate()
+	config.CipherSuites = []uint16{TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA}
+	config.CurvePreferences = openSSLCurvePreferences
+	testServerScript(t, "ECDHE-ECDSA-AES-Opaque", ecdheECDSAAESServerScript, config, nil)
+}
+
 func TestHandshakeServerSSLv3(t *testing.T) {
 	testServerScript(t, "SSLv3", sslv3ServerScript, testConfig, nil)
 }
@@ -256,6 +317,80 @@ func TestHandshakeServerSNI(t *testing.T) {
 	testServerScript(t, "SNI", selectCertificateBySNIScript, testConfig, nil)
 }
 
+// TestHandshakeServerSNIGetCertificate is similar to TestHandshakeServerSNI,
+// but the SNI certificate is returned by Config.GetCertificate instead of
+// being found in NameToCertificate.
+func TestHandshakeServerSNIGetCertificate(t *testing.T) {
+	config := *testConfig
+	config.Certificates = config.Certificates[:1]
+	config.NameToCertificate = nil
+
+	var info *ClientHelloInfo
+	config.GetCertificate = func(clientHello *ClientHelloInfo) (*Certificate, error) {
+		info = clientHello
+		if clientHello.ServerName != "snitest.com" {
+			return nil, nil
+		}
+		return &Certificate{
+			Certificate: [][]byte{testSNICertificate},
+			PrivateKey:  testRSAPrivateKey,
+		}, nil
+	}
+	testServerScript(t, "SNI-GetCertificate", selectCertificateBySNIScript, &config, nil)
+
+	if info == nil {
+		t.Fatal("GetCertificate was not called")
+	}
+	if info.ServerName != "snitest.com" {
+		t.Errorf("ServerName = %q, want %q", info.ServerName, "snitest.com")
+	}
+	if len(info.CipherSuites) == 0 {
+		t.Error("ClientHelloInfo has no cipher suites")
+	}
+}
+
+// TestHandshakeServerSNIGetCertificateNotFound checks that the static
+// NameToCertificate map is consulted when GetCertificate returns nil.
+func TestHandshakeServerSNIGetCertificateNotFound(t *testing.T) {
+	config := *testConfig
+	config.GetCertificate = func(clientHello *ClientHelloInfo) (*Certificate, error) {
+		return nil, nil
+	}
+	testServerScript(t, "SNI-GetCertificateNotFound", selectCertificateBySNIScript, &config, nil)
+}
+
+// TestHandshakeServerGetCertificateNoCertificates checks that GetCertificate
+// is used even without SNI when Certificates is empty.
+func TestHandshakeServerGetCertificateNoCertificates(t *testing.T) {
+	config := *testConfig
+	config.Certificates = nil
+	config.NameToCertificate = nil
+	config.GetCertificate = func(clientHello *ClientHelloInfo) (*Certificate, error) {
+		return &testConfig.Certificates[0], nil
+	}
+	testServerScript(t, "RSA-RC4-GetCertificate", rsaRC4ServerScript, &config, nil)
+}
+
+func TestHandshakeServerGetCertificateError(t *testing.T) {
+	errGetCert := errors.New("test error in GetCertificate")
+	config := *testConfig
+	config.GetCertificate = func(clientHello *ClientHelloInfo) (*Certificate, error) {
+		return nil, errGetCert
+	}
+
+	c, s := net.Pipe()
+	go func() {
+		cli := Client(c, &Config{ServerName: "test", InsecureSkipVerify: true})
+		cli.Handshake()
+		c.Close()
+	}()
+	err := Server(s, &config).Handshake()
+	s.Close()
+	if err != errGetCert {
+		t.Errorf("got error %v, want %v", err, errGetCert)
+	}
+}
+
 func TestResumption(t *testing.T) {
 	testServerScript(t, "IssueTicket", issueSessionTicketTest, testConfig, nil)
 	testServerScript(t, "Resume", serverResumeTest, testConfig, nil)
@@ -266,6 +401,7 @@ func TestTLS12ClientCertServer(t *testing.T) {
 	config.MaxVersion = VersionTLS12
 	config.ClientAuth = RequireAnyClientCert
 	config.CipherSuites = []uint16{TLS_ECDHE_RSA_WITH_RC4_128_SHA}
+	config.CurvePreferences = openSSLCurvePreferences
 
 	testServerScript(t, "TLS12", tls12ServerScript, &config, nil)
 }
@@ -297,6 +433,7 @@ func TestClientAuthECDSA(t *testing.T) {
 		cfg.Certificates[0].PrivateKey = testECDSAPrivateKey
 		cfg.BuildNameToCertificate()
 		cfg.CipherSuites = []uint16{TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA}
+		cfg.CurvePreferences = openSSLCurvePreferences
 		cfg.ClientAuth = cat.clientauth
 		testServerScript(t, cat.name, cat.script, cfg, cat.peers)
 	}
@@ -306,6 +443,7 @@ func TestTLS11Server(t *testing.T) {
 	var config = *testConfig
 	config.CipherSuites = []uint16{TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA}
 	config.MaxVersion = VersionTLS11
+	config.CurvePreferences = openSSLCurvePreferences
 	testServerScript(t, "TLS11", tls11ECDHEAESServerScript, &config, nil)
 }
 
@@ -6,12 +6,15 @@ package tls
 
 import (
 	"crypto"
+	"crypto/curve25519"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/md5"
 	"crypto/rsa"
 	"crypto/sha1"
 	"crypto/sha256"
+	"crypto/subtle"
 	"crypto/x509"
 	"encoding/asn1"
 	"errors"
@@ -28,12 +31,6 @@ func (ka rsaKeyAgreement) generateServerKeyExchange(config *Config, cert *Certif
 }
 
 func (ka rsaKeyAgreement) processClientKeyExchange(config *Config, cert *Certificate, ckx *clientKeyExchangeMsg, version uint16) ([]byte, error) {
-	preMasterSecret := make([]byte, 48)
-	_, err := io.ReadFull(config.rand(), preMasterSecret[2:])
-	if err != nil {
-		return nil, err
-	}
-
 	if len(ckx.ciphertext) < 2 {
 		return nil, errors.New("bad ClientKeyExchange")
 	}
@@ -47,7 +44,13 @@ func (ka rsaKeyAgreement) processClientKeyExchange(config *Config, cert *Certifi
 		ciphertext = ckx.ciphertext[2:]
 	}
 
-	err = rsa.DecryptPKCS1v15SessionKey(config.rand(), cert.PrivateKey.(*rsa.PrivateKey), ciphertext, preMasterSecret)
+	priv, ok := cert.PrivateKey.(crypto.Decrypter)
+	if !ok {
+		return nil, errors.New("tls: certificate private key does not implement crypto.Decrypter")
+	}
+	// Perform constant time RSA PKCS#1 v1.5 decryption: on a padding
+	// error a random pre-master secret is used instead.
+	preMasterSecret, err := priv.Decrypt(config.rand(), ciphertext, &rsa.PKCS1v15DecryptOptions{SessionKeyLen: 48})
 	if err != nil {
 		return nil, err
 	}
@@ -128,85 +131,164 @@ func hashForServerKeyExchange(sigType uint8, version uint16, slices ...[]byte) (
 	return md5SHA1Hash(slices), crypto.MD5SHA1
 }
 
-// ecdheRSAKeyAgreement implements a TLS key agreement where the server
-// generates a ephemeral EC public/private key pair and signs it. The
+// ecdheKeyAgreement implements a TLS key agreement where the server
+// generates an ephemeral EC public/private key pair and signs it. The
 // pre-master secret is then calculated using ECDH. The signature may
-// either be ECDSA or RSA.
+// either be ECDSA, Ed25519 or RSA.
 type ecdheKeyAgreement struct {
 	version    uint16
 	sigType    uint8
 	privateKey []byte
-	curve      elliptic.Curve
-	x, y       *big.Int
+	curveid    CurveID
+
+	// publicKey is used to store the peer's public value when X25519 is
+	// being used.
+	publicKey []byte
+	// x and y are used to store the peer's public value when one of the
+	// NIST curves is being used.
+	x, y *big.Int
 }
 
-func (ka *ecdheKeyAgreement) generateServerKeyExchange(config *Config, cert *Certificate, clientHello *clientHelloMsg, hello *serverHelloMsg) (*serverKeyExchangeMsg, error) {
-	var curveid uint16
+// curveForCurveID returns the NIST curve identified by id.
+func curveForCurveID(id CurveID) (elliptic.Curve, bool) {
+	switch id {
+	case CurveP256:
+		return elliptic.P256(), true
+	case CurveP384:
+		return elliptic.P384(), true
+	case CurveP521:
+		return elliptic.P521(), true
+	default:
+		return nil, false
+	}
+}
 
-Curve:
-	for _, c := range clientHello.supportedCurves {
-		switch c {
-		case curveP256:
-			ka.curve = elliptic.P256()
-			curveid = c
-			break Curve
-		case curveP384:
-			ka.curve = elliptic.P384()
-			curveid = c
-			break Curve
-		case curveP521:
-			ka.curve = elliptic.P521()
-			curveid = c
-			break Curve
+// isSupportedCurve reports whether the curve identified by id can be used
+// for ECDHE.
+func isSupportedCurve(id CurveID) bool {
+	if id == X25519 {
+		return true
+	}
+	_, ok := curveForCurveID(id)
+	return ok
+}
+
+// x25519 computes the X25519 shared secret from a private scalar and the
+// peer's public value, rejecting low-order points that would lead to an
+// all-zero secret. See RFC 7748, section 6.1.
+func x25519(privateKey, peerPublic []byte) ([]byte, error) {
+	var scalar, public, sharedKey [32]byte
+	if len(peerPublic) != len(public) {
+		return nil, errors.New("tls: bad X25519 public value")
+	}
+	copy(scalar[:], privateKey)
+	copy(public[:], peerPublic)
+	curve25519.ScalarMult(&sharedKey, &scalar, &public)
+
+	var zero [32]byte
+	if subtle.ConstantTimeCompare(sharedKey[:], zero[:]) == 1 {
+		return nil, errors.New("tls: bad X25519 public value")
+	}
+	return sharedKey[:], nil
+}
+
+// isSupportedSignatureAndHash reports whether sigHash is in sigHashes.
+func isSupportedSignatureAndHash(sigHash signatureAndHash, sigHashes []signatureAndHash) bool {
+	for _, s := range sigHashes {
+		if s == sigHash {
+			return true
 		}
 	}
+	return false
+}
 
-	if curveid == 0 {
+func (ka *ecdheKeyAgreement) generateServerKeyExchange(config *Config, cert *Certificate, clientHello *clientHelloMsg, hello *serverHelloMsg) (*serverKeyExchangeMsg, error) {
+	preferredCurves := config.curvePreferences()
+
+NextCandidate:
+	for _, candidate := range preferredCurves {
+		for _, c := range clientHello.supportedCurves {
+			if candidate == c && isSupportedCurve(c) {
+				ka.curveid = c
+				break NextCandidate
+			}
+		}
+	}
+
+	if ka.curveid == 0 {
 		return nil, errors.New("tls: no supported elliptic curves offered")
 	}
 
-	var x, y *big.Int
-	var err error
-	ka.privateKey, x, y, err = elliptic.GenerateKey(ka.curve, config.rand())
-	if err != nil {
-		return nil, err
+	var ecdhePublic []byte
+
+	if ka.curveid == X25519 {
+		var scalar, public [32]byte
+		if _, err := io.ReadFull(config.rand(), scalar[:]); err != nil {
+			return nil, err
+		}
+		curve25519.ScalarBaseMult(&public, &scalar)
+		ka.privateKey = scalar[:]
+		ecdhePublic = public[:]
+	} else {
+		curve, _ := curveForCurveID(ka.curveid)
+		var x, y *big.Int
+		var err error
+		ka.privateKey, x, y, err = elliptic.GenerateKey(curve, config.rand())
+		if err != nil {
+			return nil, err
+		}
+		ecdhePublic = elliptic.Marshal(curve, x, y)
 	}
-	ecdhePublic := elliptic.Marshal(ka.curve, x, y)
 
 	// http://tools.ietf.org/html/rfc4492#section-5.4
 	serverECDHParams := make([]byte, 1+2+1+len(ecdhePublic))
 	serverECDHParams[0] = 3 // named curve
-	serverECDHParams[1] = byte(curveid >> 8)
-	serverECDHParams[2] = byte(curveid)
+	serverECDHParams[1] = byte(ka.curveid >> 8)
+	serverECDHParams[2] = byte(ka.curveid)
 	serverECDHParams[3] = byte(len(ecdhePublic))
 	copy(serverECDHParams[4:], ecdhePublic)
 
-	digest, hashFunc := hashForServerKeyExchange(ka.sigType, ka.version, clientHello.random, hello.random, serverECDHParams)
-	var sig []byte
+	priv, ok := cert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("tls: certificate private key does not implement crypto.Signer")
+	}
+	sigAndHash := signatureAndHash{hashSHA256, ka.sigType}
 	switch ka.sigType {
 	case signatureECDSA:
-		privKey, ok := cert.PrivateKey.(*ecdsa.PrivateKey)
-		if !ok {
-			return nil, errors.New("ECDHE ECDSA requires an ECDSA server private key")
-		}
-		r, s, err := ecdsa.Sign(config.rand(), privKey, digest)
-		if err != nil {
-			return nil, errors.New("failed to sign ECDHE parameters: " + err.Error())
+		switch priv.Public().(type) {
+		case *ecdsa.PublicKey:
+		case ed25519.PublicKey:
+			// Ed25519 certificates are used with the ECDSA cipher
+			// suites, but only in TLS 1.2 and only if the client
+			// advertised support. See RFC 8422, section 5.10.
+			sigAndHash = signatureAndHash{hashIntrinsic, signatureEd25519}
+			if ka.version < VersionTLS12 || !isSupportedSignatureAndHash(sigAndHash, clientHello.signatureAndHashes) {
+				return nil, errors.New("tls: client doesn't support Ed25519 signatures")
+			}
+		default:
+			return nil, errors.New("ECDHE ECDSA requires an ECDSA or Ed25519 server key")
 		}
-		sig, err = asn1.Marshal(ecdsaSignature{r, s})
 	case signatureRSA:
-		privKey, ok := cert.PrivateKey.(*rsa.PrivateKey)
-		if !ok {
-			return nil, errors.New("ECDHE RSA requires a RSA server private key")
-		}
-		sig, err = rsa.SignPKCS1v15(config.rand(), privKey, hashFunc, digest)
-		if err != nil {
-			return nil, errors.New("failed to sign ECDHE parameters: " + err.Error())
+		if _, ok := priv.Public().(*rsa.PublicKey); !ok {
+			return nil, errors.New("ECDHE RSA requires a RSA server key")
 		}
 	default:
 		return nil, errors.New("unknown ECDHE signature algorithm")
 	}
 
+	var sig []byte
+	var err error
+	if sigAndHash.signature == signatureEd25519 {
+		signed := concat(clientHello.random, hello.random, serverECDHParams)
+		sig, err = priv.Sign(config.rand(), signed, crypto.Hash(0))
+	} else {
+		digest, hashFunc := hashForServerKeyExchange(ka.sigType, ka.version, clientHello.random, hello.random, serverECDHParams)
+		sig, err = priv.Sign(config.rand(), digest, hashFunc)
+	}
+	if err != nil {
+		return nil, errors.New("failed to sign ECDHE parameters: " + err.Error())
+	}
+
 	skx := new(serverKeyExchangeMsg)
 	sigAndHashLen := 0
 	if ka.version >= VersionTLS12 {
@@ -216,8 +298,8 @@ Curve:
 	copy(skx.key, serverECDHParams)
 	k := skx.key[len(serverECDHParams):]
 	if ka.version >= VersionTLS12 {
-		k[0] = hashSHA256
-		k[1] = ka.sigType
+		k[0] = sigAndHash.hash
+		k[1] = sigAndHash.signature
 		k = k[2:]
 	}
 	k[0] = byte(len(sig) >> 8)
@@ -227,16 +309,42 @@ Curve:
 	return skx, nil
 }
 
+// concat returns the concatenation of the given byte slices.
+func concat(slices ...[]byte) []byte {
+	var n int
+	for _, slice := range slices {
+		n += len(slice)
+	}
+	b := make([]byte, 0, n)
+	for _, slice := range slices {
+		b = append(b, slice...)
+	}
+	return b
+}
+
 func (ka *ecdheKeyAgreement) processClientKeyExchange(config *Config, cert *Certificate, ckx *clientKeyExchangeMsg, version uint16) ([]byte, error) {
 	if len(ckx.ciphertext) == 0 || int(ckx.ciphertext[0]) != len(ckx.ciphertext)-1 {
 		return nil, errors.New("bad ClientKeyExchange")
 	}
-	x, y := elliptic.Unmarshal(ka.curve, ckx.ciphertext[1:])
+
+	if ka.curveid == X25519 {
+		preMasterSecret, err := x25519(ka.privateKey, ckx.ciphertext[1:])
+		if err != nil {
+			return nil, errors.New("bad ClientKeyExchange")
+		}
+		return preMasterSecret, nil
+	}
+
+	curve, ok := curveForCurveID(ka.curveid)
+	if !ok {
+		return nil, errors.New("tls: server selected unsupported curve")
+	}
+	x, y := elliptic.Unmarshal(curve, ckx.ciphertext[1:])
 	if x == nil {
 		return nil, errors.New("bad ClientKeyExchange")
 	}
-	x, _ = ka.curve.ScalarMult(x, y, ka.privateKey)
-	preMasterSecret := make([]byte, (ka.curve.Params().BitSize+7)>>3)
+	x, _ = curve.ScalarMult(x, y, ka.privateKey)
+	preMasterSecret := make([]byte, (curve.Params().BitSize+7)>>3)
 	xBytes := x.Bytes()
 	copy(preMasterSecret[len(preMasterSecret)-len(xBytes):], xBytes)
 
@@ -252,16 +360,16 @@ func (ka *ecdheKeyAgreement) processServerKeyExchange(config *Config, clientHell
 	if skx.key[0] != 3 { // named curve
 		return errors.New("server selected unsupported curve")
 	}
-	curveid := uint16(skx.key[1])<<8 | uint16(skx.key[2])
+	ka.curveid = CurveID(skx.key[1])<<8 | CurveID(skx.key[2])
 
-	switch curveid {
-	case curveP256:
-		ka.curve = elliptic.P256()
-	case curveP384:
-		ka.curve = elliptic.P384()
-	case curveP521:
-		ka.curve = elliptic.P521()
-	default:
+	offered := false
+	for _, c := range clientHello.supportedCurves {
+		if c == ka.curveid {
+			offered = true
+			break
+		}
+	}
+	if !offered || !isSupportedCurve(ka.curveid) {
 		return errors.New("server selected unsupported curve")
 	}
 
@@ -269,9 +377,17 @@ func (ka *ecdheKeyAgreement) processServerKeyExchange(config *Config, clientHell
 	if publicLen+4 > len(skx.key) {
 		return errServerKeyExchange
 	}
-	ka.x, ka.y = elliptic.Unmarshal(ka.curve, skx.key[4:4+publicLen])
-	if ka.x == nil {
-		return errServerKeyExchange
+	if ka.curveid == X25519 {
+		if publicLen != 32 {
+			return errServerKeyExchange
+		}
+		ka.publicKey = skx.key[4 : 4+publicLen]
+	} else {
+		curve, _ := curveForCurveID(ka.curveid)
+		ka.x, ka.y = elliptic.Unmarshal(curve, skx.key[4:4+publicLen])
+		if ka.x == nil {
+			return errServerKeyExchange
+		}
 	}
 	serverECDHParams := skx.key[:4+publicLen]
 
@@ -279,8 +395,9 @@ func (ka *ecdheKeyAgreement) processServerKeyExchange(config *Config, clientHell
 	if len(sig) < 2 {
 		return errServerKeyExchange
 	}
+	var sigAndHash signatureAndHash
 	if ka.version >= VersionTLS12 {
-		// ignore SignatureAndHashAlgorithm
+		sigAndHash = signatureAndHash{sig[0], sig[1]}
 		sig = sig[2:]
 		if len(sig) < 2 {
 			return errServerKeyExchange
@@ -292,6 +409,21 @@ func (ka *ecdheKeyAgreement) processServerKeyExchange(config *Config, clientHell
 	}
 	sig = sig[2:]
 
+	if sigAndHash.signature == signatureEd25519 {
+		if ka.sigType != signatureECDSA || sigAndHash.hash != hashIntrinsic {
+			return errServerKeyExchange
+		}
+		pubKey, ok := cert.PublicKey.(ed25519.PublicKey)
+		if !ok {
+			return errors.New("Ed25519 signature requires an Ed25519 server public key")
+		}
+		signed := concat(clientHello.random, serverHello.random, serverECDHParams)
+		if !ed25519.Verify(pubKey, signed, sig) {
+			return errors.New("Ed25519 verification failure")
+		}
+		return nil
+	}
+
 	digest, hashFunc := hashForServerKeyExchange(ka.sigType, ka.version, clientHello.random, serverHello.random, serverECDHParams)
 	switch ka.sigType {
 	case signatureECDSA:
@@ -325,19 +457,39 @@ func (ka *ecdheKeyAgreement) processServerKeyExchange(config *Config, clientHell
 }
 
 func (ka *ecdheKeyAgreement) generateClientKeyExchange(config *Config, clientHello *clientHelloMsg, cert *x509.Certificate) ([]byte, *clientKeyExchangeMsg, error) {
-	if ka.curve == nil {
+	if ka.curveid == 0 {
 		return nil, nil, errors.New("missing ServerKeyExchange message")
 	}
-	priv, mx, my, err := elliptic.GenerateKey(ka.curve, config.rand())
-	if err != nil {
-		return nil, nil, err
-	}
-	x, _ := ka.curve.ScalarMult(ka.x, ka.y, priv)
-	preMasterSecret := make([]byte, (ka.curve.Params().BitSize+7)>>3)
-	xBytes := x.Bytes()
-	copy(preMasterSecret[len(preMasterSecret)-len(xBytes):], xBytes)
 
-	serialized := elliptic.Marshal(ka.curve, mx, my)
+	var serialized, preMasterSecret []byte
+
+	if ka.curveid == X25519 {
+		var scalar, public [32]byte
+		if _, err := io.ReadFull(config.rand(), scalar[:]); err != nil {
+			return nil, nil, err
+		}
+		curve25519.ScalarBaseMult(&public, &scalar)
+		var err error
+		if preMasterSecret, err = x25519(scalar[:], ka.publicKey); err != nil {
+			return nil, nil, err
+		}
+		serialized = public[:]
+	} else {
+		curve, ok := curveForCurveID(ka.curveid)
+		if !ok {
+			return nil, nil, errors.New("tls: server selected unsupported curve")
+		}
+		priv, mx, my, err := elliptic.GenerateKey(curve, config.rand())
+		if err != nil {
+			return nil, nil, err
+		}
+		x, _ := curve.ScalarMult(ka.x, ka.y, priv)
+		preMasterSecret = make([]byte, (curve.Params().BitSize+7)>>3)
+		xBytes := x.Bytes()
+		copy(preMasterSecret[len(preMasterSecret)-len(xBytes):], xBytes)
+
+		serialized = elliptic.Marshal(curve, mx, my)
+	}
 
 	ckx := new(clientKeyExchangeMsg)
 	ckx.ciphertext = make([]byte, 1+len(serialized))
@@ -6,6 +6,9 @@ package x509
 
 import (
 	"encoding/pem"
+	"errors"
+	"fmt"
+	"runtime"
 )
 
 // CertPool is a set of certificates.
@@ -24,6 +27,49 @@ func NewCertPool() *CertPool {
 	}
 }
 
+// copy returns a copy of s that may be modified without affecting s.
+func (s *CertPool) copy() *CertPool {
+	p := &CertPool{
+		bySubjectKeyId: make(map[string][]int, len(s.bySubjectKeyId)),
+		byName:         make(map[string][]int, len(s.byName)),
+		certs:          make([]*Certificate, len(s.certs)),
+	}
+	for k, v := range s.bySubjectKeyId {
+		indexes := make([]int, len(v))
+		copy(indexes, v)
+		p.bySubjectKeyId[k] = indexes
+	}
+	for k, v := range s.byName {
+		indexes := make([]int, len(v))
+		copy(indexes, v)
+		p.byName[k] = indexes
+	}
+	copy(p.certs, s.certs)
+	return p
+}
+
+// SystemCertPool returns a copy of the system cert pool, loading it if
+// needed. Any mutations to the returned pool are not written to disk and
+// do not affect the pool used when VerifyOptions.Roots is nil, so callers
+// may add their own roots to it.
+//
+// On Windows, verification against the system roots is done by the
+// operating system and SystemCertPool returns an error.
+func SystemCertPool() (*CertPool, error) {
+	if runtime.GOOS == "windows" {
+		return nil, errors.New("crypto/x509: system root pool is not available on Windows")
+	}
+
+	roots := systemRootsPool()
+	if roots == nil {
+		if systemRootsErr != nil {
+			return nil, systemRootsErr
+		}
+		return nil, SystemRootsError{}
+	}
+	return roots.copy(), nil
+}
+
 // findVerifiedParents attempts to find certificates in s which have signed the
 // given certificate. If any candidates were rejected then errCert will be set
 // to one of them, arbitrarily, and err will contain the reason that it was
@@ -83,23 +129,43 @@ func (s *CertPool) AddCert(cert *Certificate) {
 // On many Linux systems, /etc/ssl/cert.pem will contain the system wide set
 // of root CAs in a format suitable for this function.
 func (s *CertPool) AppendCertsFromPEM(pemCerts []byte) (ok bool) {
-	for len(pemCerts) > 0 {
+	n, _ := s.AddCertsFromPEM(pemCerts)
+	return n > 0
+}
+
+// AddCertsFromPEM is like AppendCertsFromPEM but reports problems. It
+// appends every certificate that can be parsed to s and returns the number
+// added. If any CERTIFICATE block couldn't be parsed, it returns an error
+// describing the first such block. Blocks of other types are ignored.
+func (s *CertPool) AddCertsFromPEM(pemCerts []byte) (added int, err error) {
+	for i := 0; len(pemCerts) > 0; {
 		var block *pem.Block
 		block, pemCerts = pem.Decode(pemCerts)
 		if block == nil {
 			break
 		}
-		if block.Type != "CERTIFICATE" || len(block.Headers) != 0 {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		i++
+
+		if len(block.Headers) != 0 {
+			if err == nil {
+				err = fmt.Errorf("x509: certificate #%d in PEM data has unexpected headers", i)
+			}
 			continue
 		}
 
-		cert, err := ParseCertificate(block.Bytes)
-		if err != nil {
+		cert, parseErr := ParseCertificate(block.Bytes)
+		if parseErr != nil {
+			if err == nil {
+				err = fmt.Errorf("x509: failed to parse certificate #%d in PEM data: %v", i, parseErr)
+			}
 			continue
 		}
 
 		s.AddCert(cert)
-		ok = true
+		added++
 	}
 
 	return
@@ -114,3 +180,17 @@ func (s *CertPool) Subjects() (res [][]byte) {
 	}
 	return
 }
+
+// Certificates returns the certificates in the pool, in the order in which
+// they were added. The slice is a copy but the certificates are shared and
+// must not be modified.
+func (s *CertPool) Certificates() []*Certificate {
+	res := make([]*Certificate, len(s.certs))
+	copy(res, s.certs)
+	return res
+}
+
+// Len returns the number of certificates in the pool.
+func (s *CertPool) Len() int {
+	return len(s.certs)
+}
@@ -0,0 +1,68 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"encoding/pem"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestAddCertsFromPEM(t *testing.T) {
+	block, _ := pem.Decode([]byte(verisignRoot))
+	block.Bytes = block.Bytes[:len(block.Bytes)-10]
+	truncated := string(pem.EncodeToMemory(block))
+
+	pool := NewCertPool()
+	n, err := pool.AddCertsFromPEM([]byte(thawteIntermediate + truncated + startComRoot))
+	if n != 2 {
+		t.Errorf("added %d certificates, want 2", n)
+	}
+	if err == nil || !strings.Contains(err.Error(), "certificate #2") {
+		t.Errorf("error %v doesn't name the second certificate", err)
+	}
+	if pool.Len() != 2 {
+		t.Errorf("pool holds %d certificates, want 2", pool.Len())
+	}
+	if subjects := pool.Subjects(); len(subjects) != 2 {
+		t.Errorf("pool has %d subjects, want 2", len(subjects))
+	}
+
+	if n, err := pool.AddCertsFromPEM([]byte(startComRoot)); n != 1 || err != nil {
+		t.Errorf("adding a duplicate certificate returned %d, %v", n, err)
+	}
+	if pool.Len() != 2 {
+		t.Errorf("duplicate certificate was added to the pool")
+	}
+}
+
+func TestSystemCertPool(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		if _, err := SystemCertPool(); err == nil {
+			t.Error("SystemCertPool returned a pool on Windows")
+		}
+		return
+	}
+
+	pool, err := SystemCertPool()
+	if err != nil {
+		t.Skipf("no system roots available: %s", err)
+	}
+	n := systemRootsPool().Len()
+
+	cert, err := certificateFromPEM(startComRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool.AddCert(cert)
+
+	if systemRootsPool().Len() != n {
+		t.Errorf("adding to the SystemCertPool copy modified the system roots")
+	}
+	if other, _ := SystemCertPool(); other.Len() != n {
+		t.Errorf("a second SystemCertPool has %d certificates, want %d", other.Len(), n)
+	}
+}
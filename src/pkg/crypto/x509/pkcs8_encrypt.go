@@ -0,0 +1,299 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+// PKCS#8 private keys may be encrypted using the PBES2 scheme from PKCS#5
+// v2.0, described in RFC 2898 and RFC 5208, section 6. Only PBKDF2 key
+// derivation with AES in CBC mode is supported, which is what OpenSSL
+// produces by default.
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+var (
+	oidPBES2  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHMACWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHMACWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+
+	oidAES128CBC = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+const (
+	// pbkdf2Iterations is the iteration count used when encrypting. It
+	// matches the default of the OpenSSL pkcs8 command.
+	pbkdf2Iterations = 2048
+	pbkdf2SaltSize   = 16
+)
+
+// encryptedPrivateKeyInfo reflects an ASN.1, PKCS#8 EncryptedPrivateKeyInfo.
+// See RFC 5208, section 6.
+type encryptedPrivateKeyInfo struct {
+	Algo          pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// RFC 2898, appendix A.4
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+// RFC 2898, appendix A.2
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// pbes2KeySize returns the key size in bytes for the PBES2 encryption scheme
+// with the given OID, or zero if it isn't supported.
+func pbes2KeySize(oid asn1.ObjectIdentifier) int {
+	switch {
+	case oid.Equal(oidAES128CBC):
+		return 16
+	case oid.Equal(oidAES192CBC):
+		return 24
+	case oid.Equal(oidAES256CBC):
+		return 32
+	}
+	return 0
+}
+
+// pbkdf2PRF returns the hash function for the PBKDF2 pseudorandom function
+// with the given OID, or nil if it isn't supported.
+func pbkdf2PRF(oid asn1.ObjectIdentifier) func() hash.Hash {
+	switch {
+	case oid.Equal(oidHMACWithSHA1):
+		return sha1.New
+	case oid.Equal(oidHMACWithSHA256):
+		return sha256.New
+	case oid.Equal(oidHMACWithSHA384):
+		return sha512.New384
+	case oid.Equal(oidHMACWithSHA512):
+		return sha512.New
+	}
+	return nil
+}
+
+// pbkdf2 derives a key of keyLen bytes from password and salt, as described
+// in RFC 2898, section 5.2, using HMAC with h as the pseudorandom function.
+func pbkdf2(password, salt []byte, iter, keyLen int, h func() hash.Hash) []byte {
+	prf := hmac.New(h, password)
+	hashLen := prf.Size()
+	numBlocks := (keyLen + hashLen - 1) / hashLen
+
+	var buf [4]byte
+	dk := make([]byte, 0, numBlocks*hashLen)
+	u := make([]byte, hashLen)
+	for block := 1; block <= numBlocks; block++ {
+		// U_1 = PRF(password, salt || INT(block))
+		prf.Reset()
+		prf.Write(salt)
+		buf[0] = byte(block >> 24)
+		buf[1] = byte(block >> 16)
+		buf[2] = byte(block >> 8)
+		buf[3] = byte(block)
+		prf.Write(buf[:4])
+		dk = prf.Sum(dk)
+		t := dk[len(dk)-hashLen:]
+		copy(u, t)
+
+		// U_n = PRF(password, U_(n-1)), T = U_1 ^ U_2 ^ ... ^ U_iter
+		for n := 2; n <= iter; n++ {
+			prf.Reset()
+			prf.Write(u)
+			u = u[:0]
+			u = prf.Sum(u)
+			for i := range u {
+				t[i] ^= u[i]
+			}
+		}
+	}
+	return dk[:keyLen]
+}
+
+// ParseEncryptedPKCS8PrivateKey decrypts a PKCS#8 private key that was
+// encrypted with PBES2 using the given password, and parses the result as
+// ParsePKCS8PrivateKey does. If an incorrect password is detected an
+// IncorrectPasswordError is returned.
+func ParseEncryptedPKCS8PrivateKey(der, password []byte) (key interface{}, err error) {
+	var info encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, err
+	}
+	if !info.Algo.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("x509: PKCS#8 encrypted with unsupported algorithm: %v", info.Algo.Algorithm)
+	}
+
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(info.Algo.Parameters.FullBytes, &params); err != nil {
+		return nil, errors.New("x509: failed to parse PBES2 parameters: " + err.Error())
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("x509: PKCS#8 uses unsupported key derivation function: %v", params.KeyDerivationFunc.Algorithm)
+	}
+
+	keySize := pbes2KeySize(params.EncryptionScheme.Algorithm)
+	if keySize == 0 {
+		return nil, fmt.Errorf("x509: PKCS#8 uses unsupported encryption scheme: %v", params.EncryptionScheme.Algorithm)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, errors.New("x509: failed to parse PBES2 initialization vector: " + err.Error())
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.New("x509: incorrect IV size")
+	}
+
+	var kdf pbkdf2Params
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, errors.New("x509: failed to parse PBKDF2 parameters: " + err.Error())
+	}
+	if kdf.IterationCount < 1 {
+		return nil, errors.New("x509: invalid PBKDF2 iteration count")
+	}
+	if kdf.KeyLength != 0 && kdf.KeyLength != keySize {
+		return nil, errors.New("x509: PBKDF2 key length doesn't match the encryption scheme")
+	}
+	h := sha1.New
+	if len(kdf.PRF.Algorithm) > 0 {
+		if h = pbkdf2PRF(kdf.PRF.Algorithm); h == nil {
+			return nil, fmt.Errorf("x509: PKCS#8 uses unsupported PBKDF2 pseudorandom function: %v", kdf.PRF.Algorithm)
+		}
+	}
+
+	data := info.EncryptedData
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, errors.New("x509: invalid padding")
+	}
+	block, err := aes.NewCipher(pbkdf2(password, kdf.Salt, kdf.IterationCount, keySize, h))
+	if err != nil {
+		return nil, err
+	}
+	decrypted := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, data)
+
+	// The padding is as described in RFC 1423, and, as in
+	// DecryptPEMBlock, bad padding is assumed to be an invalid password.
+	last := int(decrypted[len(decrypted)-1])
+	if last == 0 || last > aes.BlockSize {
+		return nil, IncorrectPasswordError
+	}
+	for _, val := range decrypted[len(decrypted)-last:] {
+		if int(val) != last {
+			return nil, IncorrectPasswordError
+		}
+	}
+
+	// The padding may happen to be valid for a wrong password, in which
+	// case the result won't be a PKCS#8 structure.
+	decrypted = decrypted[:len(decrypted)-last]
+	var privKey pkcs8
+	if rest, err := asn1.Unmarshal(decrypted, &privKey); err != nil || len(rest) > 0 {
+		return nil, IncorrectPasswordError
+	}
+	return ParsePKCS8PrivateKey(decrypted)
+}
+
+// MarshalEncryptedPKCS8PrivateKey converts a private key to PKCS#8, as
+// MarshalPKCS8PrivateKey does, and encrypts it with the given password
+// using PBES2 with PBKDF2 and HMAC-SHA256. The alg argument must be one of
+// PEMCipherAES128, PEMCipherAES192 or PEMCipherAES256. The result is
+// suitable for a PEM block of type "ENCRYPTED PRIVATE KEY".
+func MarshalEncryptedPKCS8PrivateKey(rand io.Reader, key interface{}, password []byte, alg PEMCipher) ([]byte, error) {
+	var scheme asn1.ObjectIdentifier
+	switch alg {
+	case PEMCipherAES128:
+		scheme = oidAES128CBC
+	case PEMCipherAES192:
+		scheme = oidAES192CBC
+	case PEMCipherAES256:
+		scheme = oidAES256CBC
+	default:
+		return nil, errors.New("x509: unsupported encryption mode for PKCS#8")
+	}
+
+	data, err := MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, pbkdf2SaltSize)
+	if _, err := io.ReadFull(rand, salt); err != nil {
+		return nil, errors.New("x509: cannot generate salt: " + err.Error())
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand, iv); err != nil {
+		return nil, errors.New("x509: cannot generate IV: " + err.Error())
+	}
+
+	block, err := aes.NewCipher(pbkdf2(password, salt, pbkdf2Iterations, pbes2KeySize(scheme), sha256.New))
+	if err != nil {
+		return nil, err
+	}
+	pad := aes.BlockSize - len(data)%aes.BlockSize
+	encrypted := make([]byte, len(data), len(data)+pad)
+	copy(encrypted, data)
+	for i := 0; i < pad; i++ {
+		encrypted = append(encrypted, byte(pad))
+	}
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	kdfBytes, err := asn1.Marshal(pbkdf2Params{
+		Salt:           salt,
+		IterationCount: pbkdf2Iterations,
+		PRF: pkix.AlgorithmIdentifier{
+			Algorithm:  oidHMACWithSHA256,
+			Parameters: asn1.RawValue{Tag: 5}, // NULL
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ivBytes, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	paramBytes, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPBKDF2,
+			Parameters: asn1.RawValue{FullBytes: kdfBytes},
+		},
+		EncryptionScheme: pkix.AlgorithmIdentifier{
+			Algorithm:  scheme,
+			Parameters: asn1.RawValue{FullBytes: ivBytes},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		Algo: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPBES2,
+			Parameters: asn1.RawValue{FullBytes: paramBytes},
+		},
+		EncryptedData: encrypted,
+	})
+}
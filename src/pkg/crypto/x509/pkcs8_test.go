@@ -5,7 +5,14 @@
 package x509
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
 	"encoding/hex"
+	"reflect"
 	"testing"
 )
 
@@ -26,3 +33,110 @@ func TestPKCS8(t *testing.T) {
 		t.Errorf("failed to decode PKCS8 with EC private key: %s", err)
 	}
 }
+
+// Generated using:
+//   openssl ecparam -genkey -name prime256v1 -noout | openssl pkcs8 -topk8 -nocrypt
+var pkcs8P256PrivateKeyHex = `308187020100301306072a8648ce3d020106082a8648ce3d030107046d306b0201010420d98b0ffd75821a426adf37a69a487d0d69fa8b96b3e900fae67565e2e883c312a144034200042a94cc342441a2380875dde563d961e935dae394fe221d041571dfe7df1f7095a9a516c4753090e00c2303fd48f4e66a371af321e7deae0adeb1948cf8084d03`
+
+// The key above, encrypted with the password "gopher" using:
+//   openssl pkcs8 -topk8
+// which uses AES-256-CBC and PBKDF2 with HMAC-SHA256.
+var pkcs8P256EncryptedAES256Hex = `3081ec305706092a864886f70d01050d304a302906092a864886f70d01050c301c040878042218fe5f00c402020800300c06082a864886f70d02090500301d060960864801650304012a0410e9869c30fe416543aa22f599a4dce3ae04819019c88f4abb394727f771dde777aa105f94be5cd3e9c2735d3b4eb29e088a856637883abc3c24b5955cb249f947bbd39bf01f3846cb2fe6b748801576b69c5d0750fea875ca47db737c3daf1d74cd30b870d8b35b023e916bc41fbd8bb2ac7e31c23cceb7352b281c8cc0ca63592a7c69144cecc265396e0c032ae8d8ba3f049b91dafdaa2b36dd95f6c9de29db388c32`
+
+// The key above, encrypted with the password "gopher" using:
+//   openssl pkcs8 -topk8 -v2 aes-128-cbc -v2prf hmacWithSHA1
+var pkcs8P256EncryptedAES128Hex = `3081de304906092a864886f70d01050d303c301b06092a864886f70d01050c300e04084378a7a8561c305302020800301d060960864801650304010204109ed78cd60593d67d7f73cc7d8cdee871048190aad64130fac0673c50af2516575f9aa915790ed9954da6f6660570f6358af89e773ff01166092a3a2a6c2d602f5d4c04f7f763fc8a9dcb2a9703f343ad0537a0d66157c259ca83d4061e44e86e77d5e72206e35ff321ffb0cc099b74192107d7c231a5f2d36623b4567a8fcdb37c84ee69b531e9e73ea99f425a7cfe0b2b8a3012c8c47a979f8dabccb5cdad47cab709`
+
+func TestMarshalPKCS8PrivateKey(t *testing.T) {
+	for _, keyHex := range []string{pkcs8RSAPrivateKeyHex, pkcs8P256PrivateKeyHex} {
+		derBytes, _ := hex.DecodeString(keyHex)
+		key, err := ParsePKCS8PrivateKey(derBytes)
+		if err != nil {
+			t.Errorf("failed to decode PKCS8 key: %s", err)
+			continue
+		}
+		reserialised, err := MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Errorf("failed to marshal %T: %s", key, err)
+			continue
+		}
+		if !bytes.Equal(derBytes, reserialised) {
+			t.Errorf("marshaled %T differs from the original\ngot:  %x\nwant: %x", key, reserialised, derBytes)
+		}
+	}
+
+	_, ed25519Priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %s", err)
+	}
+	derBytes, err := MarshalPKCS8PrivateKey(ed25519Priv)
+	if err != nil {
+		t.Fatalf("failed to marshal Ed25519 key: %s", err)
+	}
+	key, err := ParsePKCS8PrivateKey(derBytes)
+	if err != nil {
+		t.Fatalf("failed to decode PKCS8 with Ed25519 private key: %s", err)
+	}
+	if !bytes.Equal(key.(ed25519.PrivateKey), ed25519Priv) {
+		t.Errorf("Ed25519 key did not round-trip")
+	}
+
+	if _, err := MarshalPKCS8PrivateKey(struct{}{}); err == nil {
+		t.Errorf("unknown key type was marshaled")
+	}
+}
+
+func TestParseEncryptedPKCS8PrivateKey(t *testing.T) {
+	derBytes, _ := hex.DecodeString(pkcs8P256PrivateKeyHex)
+	want, _ := ParsePKCS8PrivateKey(derBytes)
+
+	for _, encryptedHex := range []string{pkcs8P256EncryptedAES256Hex, pkcs8P256EncryptedAES128Hex} {
+		derBytes, _ := hex.DecodeString(encryptedHex)
+		key, err := ParseEncryptedPKCS8PrivateKey(derBytes, []byte("gopher"))
+		if err != nil {
+			t.Errorf("failed to decrypt PKCS8 key: %s", err)
+			continue
+		}
+		if got, ok := key.(*ecdsa.PrivateKey); !ok || got.D.Cmp(want.(*ecdsa.PrivateKey).D) != 0 {
+			t.Errorf("decrypted key differs from the original")
+		}
+
+		if _, err := ParseEncryptedPKCS8PrivateKey(derBytes, []byte("badger")); err != IncorrectPasswordError {
+			t.Errorf("decrypting with the wrong password returned %v, want IncorrectPasswordError", err)
+		}
+	}
+}
+
+func TestMarshalEncryptedPKCS8PrivateKey(t *testing.T) {
+	derBytes, _ := hex.DecodeString(pkcs8RSAPrivateKeyHex)
+	rsaPriv, _ := ParsePKCS8PrivateKey(derBytes)
+
+	for _, alg := range []PEMCipher{PEMCipherAES128, PEMCipherAES192, PEMCipherAES256} {
+		encrypted, err := MarshalEncryptedPKCS8PrivateKey(rand.Reader, rsaPriv, []byte("gopher"), alg)
+		if err != nil {
+			t.Errorf("cipher %d: failed to encrypt key: %s", alg, err)
+			continue
+		}
+		key, err := ParseEncryptedPKCS8PrivateKey(encrypted, []byte("gopher"))
+		if err != nil {
+			t.Errorf("cipher %d: failed to decrypt key: %s", alg, err)
+			continue
+		}
+		if !reflect.DeepEqual(key.(*rsa.PrivateKey).D, rsaPriv.(*rsa.PrivateKey).D) {
+			t.Errorf("cipher %d: key did not round-trip", alg)
+		}
+	}
+
+	if _, err := MarshalEncryptedPKCS8PrivateKey(rand.Reader, rsaPriv, []byte("gopher"), PEMCipherDES); err == nil {
+		t.Errorf("PKCS8 key was encrypted with DES")
+	}
+}
+
+// Test vector from RFC 6070.
+func TestPBKDF2(t *testing.T) {
+	got := pbkdf2([]byte("passwordPASSWORDpassword"), []byte("saltSALTsaltSALTsaltSALTsaltSALTsalt"), 4096, 25, sha1.New)
+	want, _ := hex.DecodeString("3d2eec4fe41c849b80c8d83662c0e44a8b291a964cf2f07038")
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
@@ -7,11 +7,18 @@ package x509
 import "sync"
 
 var (
-	once        sync.Once
-	systemRoots *CertPool
+	once           sync.Once
+	systemRoots    *CertPool
+	systemRootsErr error
 )
 
+// systemRootsPool returns the system root certificates, loading them on
+// first use. The returned pool is shared and must not be modified.
 func systemRootsPool() *CertPool {
 	once.Do(initSystemRoots)
 	return systemRoots
 }
+
+func initSystemRoots() {
+	systemRoots, systemRootsErr = loadSystemRoots()
+}
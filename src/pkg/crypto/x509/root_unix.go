@@ -6,7 +6,12 @@
 
 package x509
 
-import "io/ioutil"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
 
 // Possible certificate files; stop after finding one.
 var certFiles = []string{
@@ -17,21 +22,81 @@ var certFiles = []string{
 	"/usr/local/share/certs/ca-root-nss.crt", // FreeBSD
 }
 
+// Possible directories with certificate files; all will be read if none of
+// certFiles could be loaded.
+var certDirectories = []string{
+	"/etc/ssl/certs",               // SLES10/SLES11
+	"/system/etc/security/cacerts", // Android
+}
+
+const (
+	// certFileEnv is the environment variable which names a certificate
+	// file to load in place of certFiles.
+	certFileEnv = "SSL_CERT_FILE"
+
+	// certDirEnv is the environment variable which holds a colon-separated
+	// list of directories to read certificates from, in addition to the
+	// certificate file.
+	certDirEnv = "SSL_CERT_DIR"
+)
+
 func (c *Certificate) systemVerify(opts *VerifyOptions) (chains [][]*Certificate, err error) {
 	return nil, nil
 }
 
-func initSystemRoots() {
+func loadSystemRoots() (*CertPool, error) {
 	roots := NewCertPool()
-	for _, file := range certFiles {
+
+	files := certFiles
+	if f := os.Getenv(certFileEnv); f != "" {
+		files = []string{f}
+	}
+
+	var firstErr error
+	foundFile := false
+	for _, file := range files {
 		data, err := ioutil.ReadFile(file)
 		if err == nil {
 			roots.AppendCertsFromPEM(data)
-			systemRoots = roots
-			return
+			foundFile = true
+			break
+		}
+		if firstErr == nil && !os.IsNotExist(err) {
+			firstErr = err
 		}
 	}
 
-	// All of the files failed to load. systemRoots will be nil which will
-	// trigger a specific error at verification time.
+	var dirs []string
+	if d := os.Getenv(certDirEnv); d != "" {
+		dirs = strings.Split(d, ":")
+	} else if !foundFile {
+		dirs = certDirectories
+	}
+
+	for _, directory := range dirs {
+		fis, err := ioutil.ReadDir(directory)
+		if err != nil {
+			if firstErr == nil && !os.IsNotExist(err) {
+				firstErr = err
+			}
+			continue
+		}
+		for _, fi := range fis {
+			if fi.IsDir() {
+				continue
+			}
+			data, err := ioutil.ReadFile(filepath.Join(directory, fi.Name()))
+			if err == nil {
+				roots.AppendCertsFromPEM(data)
+			}
+		}
+	}
+
+	if foundFile || len(roots.certs) > 0 {
+		return roots, nil
+	}
+
+	// Nothing could be loaded. The nil pool will trigger a specific error
+	// at verification time.
+	return nil, firstErr
 }
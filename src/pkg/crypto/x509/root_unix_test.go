@@ -0,0 +1,84 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build freebsd linux openbsd netbsd
+
+package x509
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func setenv(t *testing.T, key, value string) func() {
+	old := os.Getenv(key)
+	if err := os.Setenv(key, value); err != nil {
+		t.Fatalf("failed to set %s: %s", key, err)
+	}
+	return func() { os.Setenv(key, old) }
+}
+
+func TestEnvVars(t *testing.T) {
+	dir, err := ioutil.TempDir("", "x509-roots")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "roots.pem")
+	if err := ioutil.WriteFile(file, []byte(verisignRoot), 0644); err != nil {
+		t.Fatal(err)
+	}
+	certDir := filepath.Join(dir, "certs")
+	if err := os.Mkdir(certDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(certDir, "startcom.pem"), []byte(startComRoot), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		file, dir string
+		want      []string
+	}{
+		{file, "", []string{"VeriSign"}},
+		{file, certDir, []string{"VeriSign", "StartCom"}},
+		{filepath.Join(dir, "missing.pem"), certDir, []string{"StartCom"}},
+	}
+
+	for i, test := range tests {
+		restoreFile := setenv(t, certFileEnv, test.file)
+		restoreDir := setenv(t, certDirEnv, test.dir)
+		roots, err := loadSystemRoots()
+		restoreFile()
+		restoreDir()
+
+		if err != nil {
+			t.Errorf("#%d: failed to load roots: %s", i, err)
+			continue
+		}
+		certs := roots.Certificates()
+		if len(certs) != len(test.want) {
+			t.Errorf("#%d: got %d roots, want %d", i, len(certs), len(test.want))
+			continue
+		}
+		for j, cert := range certs {
+			if got := nameToKey(&cert.Subject); !strings.Contains(got, test.want[j]) {
+				t.Errorf("#%d: root %d is %q, want %q", i, j, got, test.want[j])
+			}
+		}
+	}
+
+	restoreFile := setenv(t, certFileEnv, filepath.Join(dir, "missing.pem"))
+	restoreDir := setenv(t, certDirEnv, filepath.Join(dir, "missing"))
+	roots, _ := loadSystemRoots()
+	restoreFile()
+	restoreDir()
+	if roots != nil {
+		t.Errorf("loaded %d roots from missing files", roots.Len())
+	}
+}
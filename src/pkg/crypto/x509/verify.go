@@ -5,8 +5,13 @@
 package x509
 
 import (
+	"bytes"
+	"crypto/x509/pkix"
+	"encoding/asn1"
 	"fmt"
 	"net"
+	"net/url"
+	"reflect"
 	"runtime"
 	"strings"
 	"time"
@@ -23,8 +28,8 @@ const (
 	// given in the VerifyOptions.
 	Expired
 	// CANotAuthorizedForThisName results when an intermediate or root
-	// certificate has a name constraint which doesn't include the name
-	// being checked.
+	// certificate has a name constraint which doesn't permit, or which
+	// excludes, a name in the leaf certificate.
 	CANotAuthorizedForThisName
 	// TooManyIntermediates results when a path length constraint is
 	// violated.
@@ -32,6 +37,9 @@ const (
 	// IncompatibleUsage results when the certificate's key usage indicates
 	// that it may only be used for a different purpose.
 	IncompatibleUsage
+	// NoAcceptablePolicy results when no chain asserts any of the
+	// certificate policies required by the VerifyOptions.
+	NoAcceptablePolicy
 )
 
 // CertificateInvalidError results when an odd error occurs. Users of this
@@ -39,9 +47,20 @@ const (
 type CertificateInvalidError struct {
 	Cert   *Certificate
 	Reason InvalidReason
+	// Detail, if not empty, names the element of the chain that failed
+	// and the rule that it broke.
+	Detail string
 }
 
 func (e CertificateInvalidError) Error() string {
+	s := e.reasonString()
+	if len(e.Detail) > 0 {
+		s += ": " + e.Detail
+	}
+	return s
+}
+
+func (e CertificateInvalidError) reasonString() string {
 	switch e.Reason {
 	case NotAuthorizedToSign:
 		return "x509: certificate is not authorized to sign other certificates"
@@ -53,6 +72,8 @@ func (e CertificateInvalidError) Error() string {
 		return "x509: too many intermediates for path length constraint"
 	case IncompatibleUsage:
 		return "x509: certificate specifies an incompatible key usage"
+	case NoAcceptablePolicy:
+		return "x509: certificate chain does not assert an acceptable policy"
 	}
 	return "x509: unknown error"
 }
@@ -135,6 +156,11 @@ type VerifyOptions struct {
 	// constraint down the chain which mirrors Windows CryptoAPI behaviour,
 	// but not the spec. To accept any key usage, include ExtKeyUsageAny.
 	KeyUsages []ExtKeyUsage
+	// RequiredPolicies, if not empty, lists certificate policies of which
+	// at least one must be valid for a chain, as described in RFC 5280,
+	// section 6.1, to be returned. Policy mappings and policy constraints
+	// are not supported.
+	RequiredPolicies []asn1.ObjectIdentifier
 }
 
 const (
@@ -143,26 +169,52 @@ const (
 	rootCertificate
 )
 
+// describeChainElement returns a short description of c, which is to be
+// placed at position index of a chain, for use in error details.
+func describeChainElement(c *Certificate, certType, index int) string {
+	name := c.Subject.CommonName
+	if len(name) == 0 {
+		name = "serial:" + c.SerialNumber.String()
+	}
+	switch certType {
+	case leafCertificate:
+		return fmt.Sprintf("leaf certificate %q", name)
+	case rootCertificate:
+		return fmt.Sprintf("root certificate %q", name)
+	}
+	return fmt.Sprintf("intermediate certificate %d %q", index, name)
+}
+
 // isValid performs validity checks on the c.
 func (c *Certificate) isValid(certType int, currentChain []*Certificate, opts *VerifyOptions) error {
+	element := describeChainElement(c, certType, len(currentChain))
+
 	now := opts.CurrentTime
 	if now.IsZero() {
 		now = time.Now()
 	}
-	if now.Before(c.NotBefore) || now.After(c.NotAfter) {
-		return CertificateInvalidError{c, Expired}
+	if now.Before(c.NotBefore) {
+		return CertificateInvalidError{c, Expired, fmt.Sprintf("%s is not valid until %s", element, c.NotBefore.Format(time.RFC3339))}
+	}
+	if now.After(c.NotAfter) {
+		return CertificateInvalidError{c, Expired, fmt.Sprintf("%s expired at %s", element, c.NotAfter.Format(time.RFC3339))}
 	}
 
-	if len(c.PermittedDNSDomains) > 0 {
-		for _, domain := range c.PermittedDNSDomains {
-			if opts.DNSName == domain ||
-				(strings.HasSuffix(opts.DNSName, domain) &&
-					len(opts.DNSName) >= 1+len(domain) &&
-					opts.DNSName[len(opts.DNSName)-len(domain)-1] == '.') {
+	if certType != leafCertificate && c.hasNameConstraints() {
+		if c.unhandledNameConstraints {
+			return CertificateInvalidError{c, CANotAuthorizedForThisName, element + " has name constraints of an unsupported type"}
+		}
+		for i, cert := range currentChain {
+			// Self-issued intermediates are exempt. RFC 5280, 6.1.3 (b).
+			if i > 0 && bytes.Equal(cert.RawSubject, cert.RawIssuer) {
 				continue
 			}
-
-			return CertificateInvalidError{c, CANotAuthorizedForThisName}
+			if violation := c.checkNameConstraints(cert, i == 0, opts); len(violation) > 0 {
+				if i > 0 {
+					violation += " in " + describeChainElement(cert, intermediateCertificate, i)
+				}
+				return CertificateInvalidError{c, CANotAuthorizedForThisName, element + " " + violation}
+			}
 		}
 	}
 
@@ -184,13 +236,13 @@ func (c *Certificate) isValid(certType int, currentChain []*Certificate, opts *V
 	// encryption key could only be used for Diffie-Hellman key agreement.
 
 	if certType == intermediateCertificate && (!c.BasicConstraintsValid || !c.IsCA) {
-		return CertificateInvalidError{c, NotAuthorizedToSign}
+		return CertificateInvalidError{c, NotAuthorizedToSign, element + " is not marked as a CA certificate"}
 	}
 
 	if c.BasicConstraintsValid && c.MaxPathLen >= 0 {
 		numIntermediates := len(currentChain) - 1
 		if numIntermediates > c.MaxPathLen {
-			return CertificateInvalidError{c, TooManyIntermediates}
+			return CertificateInvalidError{c, TooManyIntermediates, fmt.Sprintf("%s allows %d intermediates below it but %d were found", element, c.MaxPathLen, numIntermediates)}
 		}
 	}
 
@@ -202,6 +254,11 @@ func (c *Certificate) isValid(certType int, currentChain []*Certificate, opts *V
 // needed. If successful, it returns one or more chains where the first
 // element of the chain is c and the last element is from opts.Roots.
 //
+// Name constraints in intermediate and root certificates are enforced
+// against the subjects and alternative names of c and of the intermediates
+// below them, as described in RFC 5280, section 4.2.1.10. A chain through a
+// certificate that constrains an unsupported type of name is rejected.
+//
 // WARNING: this doesn't do any revocation checking.
 func (c *Certificate) Verify(opts VerifyOptions) (chains [][]*Certificate, err error) {
 	// Use Windows's own verification and chain building.
@@ -238,22 +295,39 @@ func (c *Certificate) Verify(opts VerifyOptions) (chains [][]*Certificate, err e
 		keyUsages = []ExtKeyUsage{ExtKeyUsageServerAuth}
 	}
 
-	// If any key usage is acceptable then we're done.
+	anyKeyUsage := false
 	for _, usage := range keyUsages {
 		if usage == ExtKeyUsageAny {
-			chains = candidateChains
-			return
+			anyKeyUsage = true
+			break
 		}
 	}
 
-	for _, candidate := range candidateChains {
-		if checkChainForKeyUsage(candidate, keyUsages) {
-			chains = append(chains, candidate)
+	if anyKeyUsage {
+		chains = candidateChains
+	} else {
+		for _, candidate := range candidateChains {
+			if checkChainForKeyUsage(candidate, keyUsages) {
+				chains = append(chains, candidate)
+			}
 		}
 	}
 
 	if len(chains) == 0 {
-		err = CertificateInvalidError{c, IncompatibleUsage}
+		return nil, CertificateInvalidError{c, IncompatibleUsage, fmt.Sprintf("no chain permits the requested extended key usages %v", keyUsages)}
+	}
+
+	if len(opts.RequiredPolicies) > 0 {
+		var acceptable [][]*Certificate
+		for _, candidate := range chains {
+			if checkChainForPolicies(candidate, opts.RequiredPolicies) {
+				acceptable = append(acceptable, candidate)
+			}
+		}
+		if len(acceptable) == 0 {
+			return nil, CertificateInvalidError{c, NoAcceptablePolicy, fmt.Sprintf("no chain asserts any of the policies %v", opts.RequiredPolicies)}
+		}
+		chains = acceptable
 	}
 
 	return
@@ -467,3 +541,321 @@ func checkChainForKeyUsage(chain []*Certificate, keyUsages []ExtKeyUsage) bool {
 
 	return true
 }
+
+// oidAnyPolicy is the special anyPolicy certificate policy from RFC 5280,
+// section 4.2.1.4.
+var oidAnyPolicy = asn1.ObjectIdentifier{2, 5, 29, 32, 0}
+
+// checkChainForPolicies returns whether at least one of policies is valid
+// for chain. The certificate policies of each element, other than the
+// root, are processed as in RFC 5280, section 6.1.3, without policy
+// mappings.
+func checkChainForPolicies(chain []*Certificate, policies []asn1.ObjectIdentifier) bool {
+	// valid holds the set of policies that are valid for the chain so
+	// far, unless anyPolicy is true, in which case every policy is.
+	anyPolicy := true
+	var valid []asn1.ObjectIdentifier
+
+	for i := len(chain) - 2; i >= 0; i-- {
+		cert := chain[i]
+		if len(cert.PolicyIdentifiers) == 0 {
+			return false
+		}
+
+		certAnyPolicy := false
+		for _, policy := range cert.PolicyIdentifiers {
+			if policy.Equal(oidAnyPolicy) {
+				certAnyPolicy = true
+				break
+			}
+		}
+
+		switch {
+		case certAnyPolicy:
+			// The certificate doesn't restrict the valid policies.
+		case anyPolicy:
+			valid = cert.PolicyIdentifiers
+			anyPolicy = false
+		default:
+			var intersection []asn1.ObjectIdentifier
+			for _, policy := range valid {
+				if policyInSet(policy, cert.PolicyIdentifiers) {
+					intersection = append(intersection, policy)
+				}
+			}
+			if len(intersection) == 0 {
+				return false
+			}
+			valid = intersection
+		}
+	}
+
+	if anyPolicy {
+		return true
+	}
+	for _, policy := range policies {
+		if policyInSet(policy, valid) {
+			return true
+		}
+	}
+	return false
+}
+
+func policyInSet(policy asn1.ObjectIdentifier, set []asn1.ObjectIdentifier) bool {
+	for _, p := range set {
+		if p.Equal(policy) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkNameConstraints checks the names in cert, a certificate below c in
+// the chain, against the name constraints of c. If a name isn't permitted,
+// or is excluded, it returns a description of the violation, otherwise it
+// returns the empty string.
+func (c *Certificate) checkNameConstraints(cert *Certificate, isLeaf bool, opts *VerifyOptions) string {
+	if violation := c.checkDirectoryNameConstraints(cert); len(violation) > 0 {
+		return violation
+	}
+
+	dnsNames := cert.DNSNames
+	if isLeaf && len(dnsNames) == 0 && len(opts.DNSName) > 0 && net.ParseIP(opts.DNSName) == nil {
+		// The name was matched against the common name of the leaf
+		// and so must also satisfy the constraints.
+		dnsNames = []string{opts.DNSName}
+	}
+
+	for _, name := range dnsNames {
+		if violation := checkNameConstraint("DNS name", name, c.PermittedDNSDomains, c.ExcludedDNSDomains, matchDNSConstraint); len(violation) > 0 {
+			return violation
+		}
+	}
+
+	for _, email := range cert.EmailAddresses {
+		if violation := checkNameConstraint("email address", email, c.PermittedEmailAddresses, c.ExcludedEmailAddresses, matchEmailConstraint); len(violation) > 0 {
+			return violation
+		}
+	}
+
+	for _, uri := range cert.URIs {
+		if violation := checkNameConstraint("URI", uri.String(), c.PermittedURIDomains, c.ExcludedURIDomains, matchURIConstraint); len(violation) > 0 {
+			return violation
+		}
+	}
+
+	for _, ip := range cert.IPAddresses {
+		for _, ipNet := range c.ExcludedIPRanges {
+			if ipNet.Contains(ip) {
+				return fmt.Sprintf("excludes IP address %s by constraint %s", ip, ipNet)
+			}
+		}
+		if len(c.PermittedIPRanges) == 0 {
+			continue
+		}
+		permitted := false
+		for _, ipNet := range c.PermittedIPRanges {
+			if ipNet.Contains(ip) {
+				permitted = true
+				break
+			}
+		}
+		if !permitted {
+			return fmt.Sprintf("does not permit IP address %s", ip)
+		}
+	}
+
+	return ""
+}
+
+// checkDirectoryNameConstraints checks the subject of cert against the
+// directory name constraints of c. An empty subject is not constrained.
+func (c *Certificate) checkDirectoryNameConstraints(cert *Certificate) string {
+	if len(c.PermittedDirectoryNames) == 0 && len(c.ExcludedDirectoryNames) == 0 {
+		return ""
+	}
+	var subject pkix.RDNSequence
+	if rest, err := asn1.Unmarshal(cert.RawSubject, &subject); err != nil || len(rest) > 0 {
+		return "does not permit an unparsable subject"
+	}
+	if len(subject) == 0 {
+		return ""
+	}
+
+	for _, constraint := range c.ExcludedDirectoryNames {
+		if matchDirectoryConstraint(subject, constraint) {
+			return fmt.Sprintf("excludes subject %q by constraint %q", rdnSequenceString(subject), rdnSequenceString(constraint))
+		}
+	}
+	if len(c.PermittedDirectoryNames) == 0 {
+		return ""
+	}
+	for _, constraint := range c.PermittedDirectoryNames {
+		if matchDirectoryConstraint(subject, constraint) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("does not permit subject %q", rdnSequenceString(subject))
+}
+
+// matchDirectoryConstraint returns whether name is within the subtree named
+// by constraint, that is whether name starts with the RDNs of constraint.
+// String attribute values are compared ignoring ASCII case and runs of
+// white space.
+func matchDirectoryConstraint(name, constraint pkix.RDNSequence) bool {
+	if len(constraint) > len(name) {
+		return false
+	}
+	for i, rdn := range constraint {
+		if !matchRDN(name[i], rdn) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchRDN(a, b pkix.RelativeDistinguishedNameSET) bool {
+	if len(a) != len(b) {
+		return false
+	}
+outer:
+	for _, atvA := range a {
+		for _, atvB := range b {
+			if atvA.Type.Equal(atvB.Type) && matchAttributeValue(atvA.Value, atvB.Value) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func matchAttributeValue(a, b interface{}) bool {
+	sa, okA := a.(string)
+	sb, okB := b.(string)
+	if okA && okB {
+		normalize := func(s string) string {
+			return toLowerCaseASCII(strings.Join(strings.Fields(s), " "))
+		}
+		return normalize(sa) == normalize(sb)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+var attributeTypeNames = []struct {
+	oid  asn1.ObjectIdentifier
+	name string
+}{
+	{asn1.ObjectIdentifier{2, 5, 4, 3}, "CN"},
+	{asn1.ObjectIdentifier{2, 5, 4, 5}, "SERIALNUMBER"},
+	{asn1.ObjectIdentifier{2, 5, 4, 6}, "C"},
+	{asn1.ObjectIdentifier{2, 5, 4, 7}, "L"},
+	{asn1.ObjectIdentifier{2, 5, 4, 8}, "ST"},
+	{asn1.ObjectIdentifier{2, 5, 4, 10}, "O"},
+	{asn1.ObjectIdentifier{2, 5, 4, 11}, "OU"},
+}
+
+// rdnSequenceString formats name for error messages, such as
+// "C=US,O=Example,CN=www.example.com".
+func rdnSequenceString(name pkix.RDNSequence) string {
+	var parts []string
+	for _, rdn := range name {
+		var atvs []string
+		for _, atv := range rdn {
+			var typ string
+			for _, n := range attributeTypeNames {
+				if atv.Type.Equal(n.oid) {
+					typ = n.name
+					break
+				}
+			}
+			if len(typ) == 0 {
+				arcs := make([]string, len(atv.Type))
+				for i, arc := range atv.Type {
+					arcs[i] = fmt.Sprint(arc)
+				}
+				typ = strings.Join(arcs, ".")
+			}
+			atvs = append(atvs, fmt.Sprintf("%s=%v", typ, atv.Value))
+		}
+		parts = append(parts, strings.Join(atvs, "+"))
+	}
+	return strings.Join(parts, ",")
+}
+
+// checkNameConstraint checks name, of the given kind, against the permitted
+// and excluded constraints for that kind of name and returns a description
+// of any violation.
+func checkNameConstraint(kind, name string, permitted, excluded []string, match func(name, constraint string) bool) string {
+	for _, constraint := range excluded {
+		if match(name, constraint) {
+			return fmt.Sprintf("excludes %s %q by constraint %q", kind, name, constraint)
+		}
+	}
+
+	if len(permitted) == 0 {
+		return ""
+	}
+	for _, constraint := range permitted {
+		if match(name, constraint) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("does not permit %s %q", kind, name)
+}
+
+// matchDomainConstraint returns whether domain is within the subtree named
+// by constraint. A constraint with a leading period matches only
+// subdomains. Otherwise it matches the domain itself and, if subdomains is
+// true, any of its subdomains.
+func matchDomainConstraint(domain, constraint string, subdomains bool) bool {
+	if len(constraint) == 0 {
+		return true
+	}
+	domain = toLowerCaseASCII(domain)
+	constraint = toLowerCaseASCII(constraint)
+
+	if constraint[0] == '.' {
+		return len(domain) > len(constraint) && strings.HasSuffix(domain, constraint)
+	}
+	if domain == constraint {
+		return true
+	}
+	return subdomains && strings.HasSuffix(domain, "."+constraint)
+}
+
+func matchDNSConstraint(name, constraint string) bool {
+	return matchDomainConstraint(name, constraint, true)
+}
+
+func matchEmailConstraint(email, constraint string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	local, host := email[:at], email[at+1:]
+
+	// A constraint containing an "@" names a single mailbox, of which
+	// only the host part is case-insensitive.
+	if i := strings.LastIndex(constraint, "@"); i >= 0 {
+		return local == constraint[:i] && toLowerCaseASCII(host) == toLowerCaseASCII(constraint[i+1:])
+	}
+	return matchDomainConstraint(host, constraint, false)
+}
+
+func matchURIConstraint(uri, constraint string) bool {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	host := u.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	// URI constraints only apply to domain names, so a URI without a
+	// host, or with an IP address in place of one, can't match.
+	if len(host) == 0 || net.ParseIP(strings.Trim(host, "[]")) != nil {
+		return false
+	}
+	return matchDomainConstraint(host, constraint, false)
+}
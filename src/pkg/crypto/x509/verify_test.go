@@ -5,9 +5,16 @@
 package x509
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/x509/pkix"
+	"encoding/asn1"
 	"encoding/pem"
 	"errors"
+	"math/big"
+	"net"
+	"net/url"
 	"runtime"
 	"strings"
 	"testing"
@@ -352,6 +359,429 @@ func TestSystemVerify(t *testing.T) {
 	testVerify(t, true)
 }
 
+// constrainedChain creates a root, an intermediate and a leaf certificate.
+// The intermediate and leaf templates are filled in from the given
+// functions before being issued.
+func constrainedChain(t *testing.T, intermediate, leaf func(*Certificate)) (leafCert *Certificate, opts VerifyOptions) {
+	return constrainedChainWithRoot(t, func(*Certificate) {}, intermediate, leaf)
+}
+
+// constrainedChainWithRoot is like constrainedChain, but also lets the
+// caller modify the root template.
+func constrainedChainWithRoot(t *testing.T, root, intermediate, leaf func(*Certificate)) (leafCert *Certificate, opts VerifyOptions) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	issue := func(template, parent *Certificate) *Certificate {
+		der, err := CreateCertificate(rand.Reader, template, parent, &key.PublicKey, key)
+		if err != nil {
+			t.Fatalf("failed to create %q: %s", template.Subject.CommonName, err)
+		}
+		cert, err := ParseCertificate(der)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %s", template.Subject.CommonName, err)
+		}
+		return cert
+	}
+
+	caTemplate := func(serial int64, name string) *Certificate {
+		return &Certificate{
+			SerialNumber:          big.NewInt(serial),
+			Subject:               pkix.Name{CommonName: name},
+			NotBefore:             time.Unix(1000, 0),
+			NotAfter:              time.Unix(100000, 0),
+			KeyUsage:              KeyUsageCertSign,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+			MaxPathLen:            -1,
+		}
+	}
+
+	rootTemplate := caTemplate(1, "Root")
+	root(rootTemplate)
+	rootCert := issue(rootTemplate, rootTemplate)
+
+	intermediateTemplate := caTemplate(2, "Intermediate")
+	intermediate(intermediateTemplate)
+	intermediateCert := issue(intermediateTemplate, rootCert)
+
+	leafTemplate := &Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "Leaf"},
+		NotBefore:    time.Unix(1000, 0),
+		NotAfter:     time.Unix(100000, 0),
+	}
+	leaf(leafTemplate)
+	leafCert = issue(leafTemplate, intermediateCert)
+
+	opts = VerifyOptions{
+		Roots:         NewCertPool(),
+		Intermediates: NewCertPool(),
+		CurrentTime:   time.Unix(2000, 0),
+	}
+	opts.Roots.AddCert(rootCert)
+	opts.Intermediates.AddCert(intermediateCert)
+	return
+}
+
+func directoryNames(names ...pkix.Name) (ret []pkix.RDNSequence) {
+	for _, name := range names {
+		ret = append(ret, name.ToRDNSequence())
+	}
+	return
+}
+
+func mustParseURL(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, ipNet, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return ipNet
+}
+
+var nameConstraintTests = []struct {
+	constraints func(*Certificate)
+	names       func(*Certificate)
+	ok          bool
+}{
+	{
+		func(c *Certificate) { c.PermittedDNSDomains = []string{"example.com"} },
+		func(c *Certificate) { c.DNSNames = []string{"example.com", "www.example.com"} },
+		true,
+	},
+	{
+		func(c *Certificate) { c.PermittedDNSDomains = []string{".example.com"} },
+		func(c *Certificate) { c.DNSNames = []string{"example.com"} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.PermittedDNSDomains = []string{"example.com"} },
+		func(c *Certificate) { c.DNSNames = []string{"www.example.com", "www.example.org"} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.PermittedDNSDomains = []string{"example.com"} },
+		func(c *Certificate) { c.DNSNames = []string{"wwwexample.com"} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.ExcludedDNSDomains = []string{"bad.example.com"} },
+		func(c *Certificate) { c.DNSNames = []string{"www.example.com"} },
+		true,
+	},
+	{
+		func(c *Certificate) { c.ExcludedDNSDomains = []string{"bad.example.com"} },
+		func(c *Certificate) { c.DNSNames = []string{"www.BAD.example.com"} },
+		false,
+	},
+	{
+		func(c *Certificate) {
+			c.PermittedDNSDomains = []string{"example.com"}
+			c.ExcludedDNSDomains = []string{"bad.example.com"}
+		},
+		func(c *Certificate) { c.DNSNames = []string{"bad.example.com"} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.PermittedIPRanges = []*net.IPNet{mustParseCIDR("10.0.0.0/8")} },
+		func(c *Certificate) { c.IPAddresses = []net.IP{net.ParseIP("10.1.2.3")} },
+		true,
+	},
+	{
+		func(c *Certificate) { c.PermittedIPRanges = []*net.IPNet{mustParseCIDR("10.0.0.0/8")} },
+		func(c *Certificate) { c.IPAddresses = []net.IP{net.ParseIP("192.168.1.1")} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.ExcludedIPRanges = []*net.IPNet{mustParseCIDR("2001:db8::/32")} },
+		func(c *Certificate) { c.IPAddresses = []net.IP{net.ParseIP("2001:db8::1")} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.PermittedEmailAddresses = []string{"example.com"} },
+		func(c *Certificate) { c.EmailAddresses = []string{"gopher@Example.com"} },
+		true,
+	},
+	{
+		func(c *Certificate) { c.PermittedEmailAddresses = []string{"example.com"} },
+		func(c *Certificate) { c.EmailAddresses = []string{"gopher@mail.example.com"} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.PermittedEmailAddresses = []string{".example.com"} },
+		func(c *Certificate) { c.EmailAddresses = []string{"gopher@mail.example.com"} },
+		true,
+	},
+	{
+		func(c *Certificate) { c.ExcludedEmailAddresses = []string{"root@example.com"} },
+		func(c *Certificate) { c.EmailAddresses = []string{"root@example.com"} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.ExcludedEmailAddresses = []string{"root@example.com"} },
+		func(c *Certificate) { c.EmailAddresses = []string{"Root@example.com"} },
+		true,
+	},
+	{
+		func(c *Certificate) { c.PermittedURIDomains = []string{".example.com"} },
+		func(c *Certificate) { c.URIs = []*url.URL{mustParseURL("https://www.example.com:8443/path")} },
+		true,
+	},
+	{
+		func(c *Certificate) { c.PermittedURIDomains = []string{".example.com"} },
+		func(c *Certificate) { c.URIs = []*url.URL{mustParseURL("https://example.com/")} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.PermittedURIDomains = []string{"example.com"} },
+		func(c *Certificate) { c.URIs = []*url.URL{mustParseURL("urn:example:1")} },
+		false,
+	},
+	{
+		func(c *Certificate) { c.ExcludedURIDomains = []string{"example.com"} },
+		func(c *Certificate) { c.URIs = []*url.URL{mustParseURL("spiffe://example.com/service")} },
+		false,
+	},
+	{
+		func(c *Certificate) {
+			c.PermittedDirectoryNames = directoryNames(pkix.Name{Organization: []string{"Example"}})
+		},
+		func(c *Certificate) { c.Subject.Organization = []string{"example"} },
+		true,
+	},
+	{
+		func(c *Certificate) {
+			c.PermittedDirectoryNames = directoryNames(pkix.Name{Organization: []string{"Example"}})
+		},
+		func(c *Certificate) { c.Subject.Organization = []string{"Other"} },
+		false,
+	},
+	{
+		func(c *Certificate) {
+			c.PermittedDirectoryNames = directoryNames(pkix.Name{Organization: []string{"Example"}})
+		},
+		func(c *Certificate) {},
+		false,
+	},
+	{
+		func(c *Certificate) {
+			c.ExcludedDirectoryNames = directoryNames(pkix.Name{Country: []string{"US"}, Organization: []string{"Evil"}})
+		},
+		func(c *Certificate) {
+			c.Subject.Country = []string{"US"}
+			c.Subject.Organization = []string{"Evil"}
+			c.Subject.OrganizationalUnit = []string{"Sales"}
+		},
+		false,
+	},
+	{
+		func(c *Certificate) {
+			c.ExcludedDirectoryNames = directoryNames(pkix.Name{Country: []string{"US"}, Organization: []string{"Evil"}})
+		},
+		func(c *Certificate) {
+			c.Subject.Country = []string{"US"}
+			c.Subject.Organization = []string{"Good"}
+		},
+		true,
+	},
+	{
+		// Constraints on one type of name don't restrict other types.
+		func(c *Certificate) { c.PermittedDNSDomains = []string{"example.com"} },
+		func(c *Certificate) {
+			c.DNSNames = []string{"example.com"}
+			c.EmailAddresses = []string{"gopher@golang.org"}
+		},
+		true,
+	},
+}
+
+func TestNameConstraints(t *testing.T) {
+	for i, test := range nameConstraintTests {
+		leaf, opts := constrainedChain(t, test.constraints, test.names)
+		opts.KeyUsages = []ExtKeyUsage{ExtKeyUsageAny}
+
+		_, err := leaf.Verify(opts)
+		if test.ok {
+			if err != nil {
+				t.Errorf("#%d: unexpected error: %s", i, err)
+			}
+			continue
+		}
+
+		inval, ok := err.(CertificateInvalidError)
+		if !ok || inval.Reason != CANotAuthorizedForThisName {
+			t.Errorf("#%d: error was not CANotAuthorizedForThisName: %v", i, err)
+			continue
+		}
+		if inval.Cert.Subject.CommonName != "Intermediate" {
+			t.Errorf("#%d: error blamed %q rather than the intermediate", i, inval.Cert.Subject.CommonName)
+		}
+		if !strings.Contains(inval.Detail, `intermediate certificate 1 "Intermediate"`) {
+			t.Errorf("#%d: error detail doesn't name the chain element: %q", i, inval.Detail)
+		}
+	}
+}
+
+func TestNameConstraintsDNSNameOption(t *testing.T) {
+	leaf, opts := constrainedChain(t,
+		func(c *Certificate) { c.PermittedDNSDomains = []string{"example.com"} },
+		func(c *Certificate) { c.Subject.CommonName = "www.example.org" })
+	opts.DNSName = "www.example.org"
+
+	if _, err := leaf.Verify(opts); err == nil {
+		t.Errorf("common name outside of the permitted domains was accepted")
+	}
+}
+
+func TestNameConstraintsOnIntermediates(t *testing.T) {
+	permitExample := func(c *Certificate) { c.PermittedDNSDomains = []string{"example.com"} }
+	leafOK := func(c *Certificate) {
+		c.Subject.Organization = []string{"Example"}
+		c.DNSNames = []string{"www.example.com"}
+	}
+
+	tests := []struct {
+		root, intermediate func(*Certificate)
+		ok                 bool
+	}{
+		{permitExample, func(c *Certificate) { c.DNSNames = []string{"ca.example.com"} }, true},
+		{permitExample, func(c *Certificate) { c.DNSNames = []string{"ca.example.org"} }, false},
+		{
+			func(c *Certificate) {
+				c.PermittedDirectoryNames = directoryNames(pkix.Name{Organization: []string{"Example"}})
+			},
+			func(c *Certificate) { c.Subject.Organization = []string{"Other"} },
+			false,
+		},
+	}
+	for i, test := range tests {
+		leaf, opts := constrainedChainWithRoot(t, test.root, test.intermediate, leafOK)
+		opts.KeyUsages = []ExtKeyUsage{ExtKeyUsageAny}
+
+		_, err := leaf.Verify(opts)
+		if test.ok {
+			if err != nil {
+				t.Errorf("#%d: unexpected error: %s", i, err)
+			}
+			continue
+		}
+		inval, ok := err.(CertificateInvalidError)
+		if !ok || inval.Reason != CANotAuthorizedForThisName {
+			t.Errorf("#%d: error was not CANotAuthorizedForThisName: %v", i, err)
+			continue
+		}
+		if inval.Cert.Subject.CommonName != "Root" {
+			t.Errorf("#%d: error blamed %q rather than the root", i, inval.Cert.Subject.CommonName)
+		}
+		if !strings.Contains(inval.Detail, `in intermediate certificate 1 "Intermediate"`) {
+			t.Errorf("#%d: error detail doesn't name the constrained intermediate: %q", i, inval.Detail)
+		}
+	}
+}
+
+func TestUnhandledNameConstraints(t *testing.T) {
+	// Permits only the registeredID 1.2.3, a type of name this package
+	// doesn't support.
+	der, err := asn1.Marshal(nameConstraints{
+		Permitted: []generalSubtree{{Name: asn1.RawValue{Tag: 8, Class: 2, Bytes: []byte{0x2a, 0x03}}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := &Certificate{
+		NotBefore:             time.Unix(1000, 0),
+		NotAfter:              time.Unix(100000, 0),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            -1,
+	}
+	unhandled, err := parseNameConstraintsExtension(ca, der)
+	if err != nil || !unhandled {
+		t.Fatalf("parseNameConstraintsExtension = %t, %v; want true, nil", unhandled, err)
+	}
+
+	// Even without the critical flag, the CA can't verify anything.
+	leaf := &Certificate{DNSNames: []string{"www.example.com"}}
+	err = ca.isValid(rootCertificate, []*Certificate{leaf}, &VerifyOptions{CurrentTime: time.Unix(2000, 0)})
+	if inval, ok := err.(CertificateInvalidError); !ok || inval.Reason != CANotAuthorizedForThisName {
+		t.Errorf("error was not CANotAuthorizedForThisName: %v", err)
+	}
+}
+
+var (
+	testPolicy1 = asn1.ObjectIdentifier{1, 2, 3, 1}
+	testPolicy2 = asn1.ObjectIdentifier{1, 2, 3, 2}
+)
+
+var policyTests = []struct {
+	intermediate, leaf []asn1.ObjectIdentifier
+	required           []asn1.ObjectIdentifier
+	ok                 bool
+}{
+	{nil, nil, nil, true},
+	{nil, []asn1.ObjectIdentifier{testPolicy1}, []asn1.ObjectIdentifier{testPolicy1}, false},
+	{[]asn1.ObjectIdentifier{testPolicy1}, []asn1.ObjectIdentifier{testPolicy1}, []asn1.ObjectIdentifier{testPolicy1}, true},
+	{[]asn1.ObjectIdentifier{testPolicy1}, []asn1.ObjectIdentifier{testPolicy1}, []asn1.ObjectIdentifier{testPolicy2}, false},
+	{[]asn1.ObjectIdentifier{testPolicy1, testPolicy2}, []asn1.ObjectIdentifier{testPolicy2}, []asn1.ObjectIdentifier{testPolicy1, testPolicy2}, true},
+	{[]asn1.ObjectIdentifier{testPolicy1}, []asn1.ObjectIdentifier{testPolicy2}, []asn1.ObjectIdentifier{testPolicy2}, false},
+	{[]asn1.ObjectIdentifier{oidAnyPolicy}, []asn1.ObjectIdentifier{testPolicy2}, []asn1.ObjectIdentifier{testPolicy2}, true},
+	{[]asn1.ObjectIdentifier{testPolicy1}, []asn1.ObjectIdentifier{oidAnyPolicy}, []asn1.ObjectIdentifier{testPolicy1}, true},
+	{[]asn1.ObjectIdentifier{oidAnyPolicy}, []asn1.ObjectIdentifier{oidAnyPolicy}, []asn1.ObjectIdentifier{testPolicy1}, true},
+}
+
+func TestRequiredPolicies(t *testing.T) {
+	for i, test := range policyTests {
+		leaf, opts := constrainedChain(t,
+			func(c *Certificate) { c.PolicyIdentifiers = test.intermediate },
+			func(c *Certificate) { c.PolicyIdentifiers = test.leaf })
+		opts.KeyUsages = []ExtKeyUsage{ExtKeyUsageAny}
+		opts.RequiredPolicies = test.required
+
+		chains, err := leaf.Verify(opts)
+		if test.ok {
+			if err != nil {
+				t.Errorf("#%d: unexpected error: %s", i, err)
+			} else if len(chains) != 1 {
+				t.Errorf("#%d: got %d chains, want 1", i, len(chains))
+			}
+			continue
+		}
+		if inval, ok := err.(CertificateInvalidError); !ok || inval.Reason != NoAcceptablePolicy {
+			t.Errorf("#%d: error was not NoAcceptablePolicy: %v", i, err)
+		}
+	}
+}
+
+func TestVerifyErrorDetail(t *testing.T) {
+	leaf, opts := constrainedChain(t, func(c *Certificate) { c.NotAfter = time.Unix(1500, 0) }, func(*Certificate) {})
+	opts.KeyUsages = []ExtKeyUsage{ExtKeyUsageAny}
+
+	_, err := leaf.Verify(opts)
+	if err == nil {
+		t.Fatal("chain with an expired intermediate was accepted")
+	}
+	inval, ok := err.(CertificateInvalidError)
+	if !ok || inval.Reason != Expired {
+		t.Fatalf("error was not Expired: %s", err)
+	}
+	if inval.Cert.Subject.CommonName != "Intermediate" {
+		t.Errorf("error blamed %q rather than the intermediate", inval.Cert.Subject.CommonName)
+	}
+	if want := `intermediate certificate 1 "Intermediate" expired at`; !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q does not contain %q", err, want)
+	}
+}
+
 func chainToDebugString(chain []*Certificate) string {
 	var chainStr string
 	for _, cert := range chain {
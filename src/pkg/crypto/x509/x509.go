@@ -10,9 +10,10 @@ import (
 	"crypto"
 	"crypto/dsa"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rsa"
-	"crypto/sha1"
+	_ "crypto/sha1"
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/pem"
@@ -20,6 +21,7 @@ import (
 	"io"
 	"math/big"
 	"net"
+	"net/url"
 	"strconv"
 	"time"
 )
@@ -48,6 +50,7 @@ func ParsePKIXPublicKey(derBytes []byte) (pub interface{}, err error) {
 // MarshalPKIXPublicKey serialises a public key to DER-encoded PKIX format.
 func MarshalPKIXPublicKey(pub interface{}) ([]byte, error) {
 	var pubBytes []byte
+	var algo pkix.AlgorithmIdentifier
 
 	switch pub := pub.(type) {
 	case *rsa.PublicKey:
@@ -55,20 +58,25 @@ func MarshalPKIXPublicKey(pub interface{}) ([]byte, error) {
 			N: pub.N,
 			E: pub.E,
 		})
-	default:
-		return nil, errors.New("x509: unknown public key type")
-	}
-
-	pkix := pkixPublicKey{
-		Algo: pkix.AlgorithmIdentifier{
-			Algorithm: []int{1, 2, 840, 113549, 1, 1, 1},
+		algo = pkix.AlgorithmIdentifier{
+			Algorithm: oidPublicKeyRSA,
 			// This is a NULL parameters value which is technically
 			// superfluous, but most other code includes it and, by
 			// doing this, we match their public key hashes.
 			Parameters: asn1.RawValue{
 				Tag: 5,
 			},
-		},
+		}
+	case ed25519.PublicKey:
+		pubBytes = pub
+		// RFC 8410, section 3: the parameters must be absent.
+		algo.Algorithm = oidPublicKeyEd25519
+	default:
+		return nil, errors.New("x509: unknown public key type")
+	}
+
+	pkix := pkixPublicKey{
+		Algo: algo,
 		BitString: asn1.BitString{
 			Bytes:     pubBytes,
 			BitLength: 8 * len(pubBytes),
@@ -143,6 +151,7 @@ const (
 	ECDSAWithSHA256
 	ECDSAWithSHA384
 	ECDSAWithSHA512
+	PureEd25519
 )
 
 type PublicKeyAlgorithm int
@@ -152,6 +161,7 @@ const (
 	RSA
 	DSA
 	ECDSA
+	Ed25519
 )
 
 // OIDs for signature algorithms
@@ -203,6 +213,11 @@ const (
 //
 // ecdsa-with-SHA512 OBJECT IDENTIFIER ::= { iso(1) member-body(2)
 //    us(840) ansi-X9-62(10045) signatures(4) ecdsa-with-SHA2(3) 4 }
+//
+//
+// RFC 8410 3 Curve25519 and Curve448 Algorithm Identifiers
+//
+// id-Ed25519 OBJECT IDENTIFIER ::= { 1 3 101 112 }
 
 var (
 	oidSignatureMD2WithRSA      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 2}
@@ -217,6 +232,7 @@ var (
 	oidSignatureECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
 	oidSignatureECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
 	oidSignatureECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+	oidSignatureEd25519         = asn1.ObjectIdentifier{1, 3, 101, 112}
 )
 
 func getSignatureAlgorithmFromOID(oid asn1.ObjectIdentifier) SignatureAlgorithm {
@@ -245,6 +261,8 @@ func getSignatureAlgorithmFromOID(oid asn1.ObjectIdentifier) SignatureAlgorithm
 		return ECDSAWithSHA384
 	case oid.Equal(oidSignatureECDSAWithSHA512):
 		return ECDSAWithSHA512
+	case oid.Equal(oidSignatureEd25519):
+		return PureEd25519
 	}
 	return UnknownSignatureAlgorithm
 }
@@ -263,10 +281,15 @@ func getSignatureAlgorithmFromOID(oid asn1.ObjectIdentifier) SignatureAlgorithm
 //
 // id-ecPublicKey OBJECT IDENTIFIER ::= {
 //       iso(1) member-body(2) us(840) ansi-X9-62(10045) keyType(2) 1 }
+//
+// RFC 8410, 3 Curve25519 and Curve448 Algorithm Identifiers
+//
+// id-Ed25519 OBJECT IDENTIFIER ::= { 1 3 101 112 }
 var (
-	oidPublicKeyRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
-	oidPublicKeyDSA   = asn1.ObjectIdentifier{1, 2, 840, 10040, 4, 1}
-	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidPublicKeyRSA     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidPublicKeyDSA     = asn1.ObjectIdentifier{1, 2, 840, 10040, 4, 1}
+	oidPublicKeyECDSA   = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidPublicKeyEd25519 = oidSignatureEd25519
 )
 
 func getPublicKeyAlgorithmFromOID(oid asn1.ObjectIdentifier) PublicKeyAlgorithm {
@@ -277,6 +300,8 @@ func getPublicKeyAlgorithmFromOID(oid asn1.ObjectIdentifier) PublicKeyAlgorithm
 		return DSA
 	case oid.Equal(oidPublicKeyECDSA):
 		return ECDSA
+	case oid.Equal(oidPublicKeyEd25519):
+		return Ed25519
 	}
 	return UnknownPublicKeyAlgorithm
 }
@@ -471,10 +496,30 @@ type Certificate struct {
 	DNSNames       []string
 	EmailAddresses []string
 	IPAddresses    []net.IP
-
-	// Name constraints
+	URIs           []*url.URL
+
+	// Name constraints. A domain constraint with a leading period, such as
+	// ".example.com", matches only subdomains. Otherwise DNS constraints
+	// match the domain and all of its subdomains, while email and URI
+	// constraints match only that host. An email constraint containing an
+	// "@" matches only that mailbox. A directory name constraint matches
+	// the subjects that start with its relative distinguished names.
 	PermittedDNSDomainsCritical bool // if true then the name constraints are marked critical.
 	PermittedDNSDomains         []string
+	ExcludedDNSDomains          []string
+	PermittedIPRanges           []*net.IPNet
+	ExcludedIPRanges            []*net.IPNet
+	PermittedEmailAddresses     []string
+	ExcludedEmailAddresses      []string
+	PermittedURIDomains         []string
+	ExcludedURIDomains          []string
+	PermittedDirectoryNames     []pkix.RDNSequence
+	ExcludedDirectoryNames      []pkix.RDNSequence
+
+	// unhandledNameConstraints is set if the certificate constrains a type
+	// of name that this package doesn't support. Such a certificate
+	// can't be used to verify a chain.
+	unhandledNameConstraints bool
 
 	// CRL Distribution Points
 	CRLDistributionPoints []string
@@ -499,6 +544,17 @@ func (c *Certificate) Equal(other *Certificate) bool {
 	return bytes.Equal(c.Raw, other.Raw)
 }
 
+// hasNameConstraints returns whether c restricts the names that may appear
+// in the certificates it issues.
+func (c *Certificate) hasNameConstraints() bool {
+	return len(c.PermittedDNSDomains) > 0 || len(c.ExcludedDNSDomains) > 0 ||
+		len(c.PermittedIPRanges) > 0 || len(c.ExcludedIPRanges) > 0 ||
+		len(c.PermittedEmailAddresses) > 0 || len(c.ExcludedEmailAddresses) > 0 ||
+		len(c.PermittedURIDomains) > 0 || len(c.ExcludedURIDomains) > 0 ||
+		len(c.PermittedDirectoryNames) > 0 || len(c.ExcludedDirectoryNames) > 0 ||
+		c.unhandledNameConstraints
+}
+
 // Entrust have a broken root certificate (CN=Entrust.net Certification
 // Authority (2048)) which isn't marked as a CA certificate and is thus invalid
 // according to PKIX.
@@ -579,9 +635,25 @@ func (c *Certificate) CheckSignatureFrom(parent *Certificate) (err error) {
 // CheckSignature verifies that signature is a valid signature over signed from
 // c's public key.
 func (c *Certificate) CheckSignature(algo SignatureAlgorithm, signed, signature []byte) (err error) {
+	return checkSignature(algo, signed, signature, c.PublicKey)
+}
+
+// checkSignature verifies that signature is a valid signature over signed from
+// a crypto.PublicKey.
+func checkSignature(algo SignatureAlgorithm, signed, signature []byte, publicKey crypto.PublicKey) (err error) {
 	var hashType crypto.Hash
 
 	switch algo {
+	case PureEd25519:
+		// Ed25519 signs the message itself rather than a digest of it.
+		pub, ok := publicKey.(ed25519.PublicKey)
+		if !ok {
+			return ErrUnsupportedAlgorithm
+		}
+		if !ed25519.Verify(pub, signed, signature) {
+			return errors.New("x509: Ed25519 verification failure")
+		}
+		return nil
 	case SHA1WithRSA, DSAWithSHA1, ECDSAWithSHA1:
 		hashType = crypto.SHA1
 	case SHA256WithRSA, DSAWithSHA256, ECDSAWithSHA256:
@@ -602,7 +674,7 @@ func (c *Certificate) CheckSignature(algo SignatureAlgorithm, signed, signature
 	h.Write(signed)
 	digest := h.Sum(nil)
 
-	switch pub := c.PublicKey.(type) {
+	switch pub := publicKey.(type) {
 	case *rsa.PublicKey:
 		return rsa.VerifyPKCS1v15(pub, hashType, digest, signature)
 	case *dsa.PublicKey:
@@ -663,7 +735,8 @@ type nameConstraints struct {
 }
 
 type generalSubtree struct {
-	Name string `asn1:"tag:2,optional,ia5"`
+	Name asn1.RawValue
+	// minimum and maximum omitted: RFC 5280 requires the defaults.
 }
 
 // RFC 5280, 4.2.2.1
@@ -751,11 +824,157 @@ func parsePublicKey(algo PublicKeyAlgorithm, keyData *publicKeyInfo) (interface{
 			Y:     y,
 		}
 		return pub, nil
+	case Ed25519:
+		// RFC 8410, section 3: the parameters must be absent.
+		if len(keyData.Algorithm.Parameters.FullBytes) != 0 {
+			return nil, errors.New("x509: Ed25519 key encoded with illegal parameters")
+		}
+		if len(asn1Data) != ed25519.PublicKeySize {
+			return nil, errors.New("x509: wrong Ed25519 public key size")
+		}
+		pub := make(ed25519.PublicKey, ed25519.PublicKeySize)
+		copy(pub, asn1Data)
+		return pub, nil
 	default:
 		return nil, nil
 	}
 }
 
+func parseSANExtension(value []byte) (dnsNames, emailAddresses []string, ipAddresses []net.IP, uris []*url.URL, err error) {
+	// RFC 5280, 4.2.1.6
+
+	// SubjectAltName ::= GeneralNames
+	//
+	// GeneralNames ::= SEQUENCE SIZE (1..MAX) OF GeneralName
+	//
+	// GeneralName ::= CHOICE {
+	//      otherName                       [0]     OtherName,
+	//      rfc822Name                      [1]     IA5String,
+	//      dNSName                         [2]     IA5String,
+	//      x400Address                     [3]     ORAddress,
+	//      directoryName                   [4]     Name,
+	//      ediPartyName                    [5]     EDIPartyName,
+	//      uniformResourceIdentifier       [6]     IA5String,
+	//      iPAddress                       [7]     OCTET STRING,
+	//      registeredID                    [8]     OBJECT IDENTIFIER }
+	var seq asn1.RawValue
+	if _, err = asn1.Unmarshal(value, &seq); err != nil {
+		return
+	}
+	if !seq.IsCompound || seq.Tag != 16 || seq.Class != 0 {
+		err = asn1.StructuralError{Msg: "bad SAN sequence"}
+		return
+	}
+
+	rest := seq.Bytes
+	for len(rest) > 0 {
+		var v asn1.RawValue
+		rest, err = asn1.Unmarshal(rest, &v)
+		if err != nil {
+			return
+		}
+		switch v.Tag {
+		case 1:
+			emailAddresses = append(emailAddresses, string(v.Bytes))
+		case 2:
+			dnsNames = append(dnsNames, string(v.Bytes))
+		case 6:
+			var uri *url.URL
+			uri, err = url.Parse(string(v.Bytes))
+			if err != nil {
+				err = errors.New("x509: cannot parse URI " + strconv.Quote(string(v.Bytes)) + ": " + err.Error())
+				return
+			}
+			uris = append(uris, uri)
+		case 7:
+			switch len(v.Bytes) {
+			case net.IPv4len, net.IPv6len:
+				ipAddresses = append(ipAddresses, v.Bytes)
+			default:
+				err = errors.New("x509: certificate contained IP address of length " + strconv.Itoa(len(v.Bytes)))
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// parseNameConstraintsExtension fills in the name constraints of out from
+// the given NameConstraints extension. It returns unhandled as true if the
+// extension contains constraints on a type of name that this package
+// doesn't support, in which case verification through out always fails.
+func parseNameConstraintsExtension(out *Certificate, value []byte) (unhandled bool, err error) {
+	// RFC 5280, 4.2.1.10
+
+	// NameConstraints ::= SEQUENCE {
+	//      permittedSubtrees       [0]     GeneralSubtrees OPTIONAL,
+	//      excludedSubtrees        [1]     GeneralSubtrees OPTIONAL }
+	//
+	// GeneralSubtrees ::= SEQUENCE SIZE (1..MAX) OF GeneralSubtree
+	//
+	// GeneralSubtree ::= SEQUENCE {
+	//      base                    GeneralName,
+	//      minimum         [0]     BaseDistance DEFAULT 0,
+	//      maximum         [1]     BaseDistance OPTIONAL }
+	//
+	// BaseDistance ::= INTEGER (0..MAX)
+
+	var constraints nameConstraints
+	if _, err = asn1.Unmarshal(value, &constraints); err != nil {
+		return
+	}
+
+	parse := func(subtrees []generalSubtree) (dnsNames, emails, uriDomains []string, ipRanges []*net.IPNet, dirNames []pkix.RDNSequence, err error) {
+		for _, subtree := range subtrees {
+			name := subtree.Name
+			if name.Class != 2 {
+				unhandled = true
+				continue
+			}
+			switch name.Tag {
+			case 1:
+				emails = append(emails, string(name.Bytes))
+			case 2:
+				dnsNames = append(dnsNames, string(name.Bytes))
+			case 4:
+				var dirName pkix.RDNSequence
+				if rest, err2 := asn1.Unmarshal(name.Bytes, &dirName); err2 != nil || len(rest) > 0 {
+					err = errors.New("x509: certificate contained invalid directory name constraint")
+					return
+				}
+				dirNames = append(dirNames, dirName)
+			case 6:
+				uriDomains = append(uriDomains, string(name.Bytes))
+			case 7:
+				// An IP constraint is an address followed by a
+				// mask of the same length.
+				l := len(name.Bytes)
+				if l != 2*net.IPv4len && l != 2*net.IPv6len {
+					err = errors.New("x509: certificate contained IP constraint of length " + strconv.Itoa(l))
+					return
+				}
+				ip, mask := net.IP(name.Bytes[:l/2]), net.IPMask(name.Bytes[l/2:])
+				if ones, bits := mask.Size(); ones == 0 && bits == 0 {
+					err = errors.New("x509: certificate contained IP constraint with a non-canonical mask")
+					return
+				}
+				ipRanges = append(ipRanges, &net.IPNet{IP: ip, Mask: mask})
+			default:
+				unhandled = true
+			}
+		}
+		return
+	}
+
+	if out.PermittedDNSDomains, out.PermittedEmailAddresses, out.PermittedURIDomains, out.PermittedIPRanges, out.PermittedDirectoryNames, err = parse(constraints.Permitted); err != nil {
+		return
+	}
+	out.ExcludedDNSDomains, out.ExcludedEmailAddresses, out.ExcludedURIDomains, out.ExcludedIPRanges, out.ExcludedDirectoryNames, err = parse(constraints.Excluded)
+	out.unhandledNameConstraints = unhandled
+	return
+}
+
 func parseCertificate(in *certificate) (*Certificate, error) {
 	out := new(Certificate)
 	out.Raw = in.Raw
@@ -827,98 +1046,25 @@ func parseCertificate(in *certificate) (*Certificate, error) {
 					continue
 				}
 			case 17:
-				// RFC 5280, 4.2.1.6
-
-				// SubjectAltName ::= GeneralNames
-				//
-				// GeneralNames ::= SEQUENCE SIZE (1..MAX) OF GeneralName
-				//
-				// GeneralName ::= CHOICE {
-				//      otherName                       [0]     OtherName,
-				//      rfc822Name                      [1]     IA5String,
-				//      dNSName                         [2]     IA5String,
-				//      x400Address                     [3]     ORAddress,
-				//      directoryName                   [4]     Name,
-				//      ediPartyName                    [5]     EDIPartyName,
-				//      uniformResourceIdentifier       [6]     IA5String,
-				//      iPAddress                       [7]     OCTET STRING,
-				//      registeredID                    [8]     OBJECT IDENTIFIER }
-				var seq asn1.RawValue
-				_, err := asn1.Unmarshal(e.Value, &seq)
+				out.DNSNames, out.EmailAddresses, out.IPAddresses, out.URIs, err = parseSANExtension(e.Value)
 				if err != nil {
 					return nil, err
 				}
-				if !seq.IsCompound || seq.Tag != 16 || seq.Class != 0 {
-					return nil, asn1.StructuralError{Msg: "bad SAN sequence"}
-				}
 
-				parsedName := false
-
-				rest := seq.Bytes
-				for len(rest) > 0 {
-					var v asn1.RawValue
-					rest, err = asn1.Unmarshal(rest, &v)
-					if err != nil {
-						return nil, err
-					}
-					switch v.Tag {
-					case 1:
-						out.EmailAddresses = append(out.EmailAddresses, string(v.Bytes))
-						parsedName = true
-					case 2:
-						out.DNSNames = append(out.DNSNames, string(v.Bytes))
-						parsedName = true
-					case 7:
-						switch len(v.Bytes) {
-						case net.IPv4len, net.IPv6len:
-							out.IPAddresses = append(out.IPAddresses, v.Bytes)
-						default:
-							return nil, errors.New("x509: certificate contained IP address of length " + strconv.Itoa(len(v.Bytes)))
-						}
-					}
-				}
-
-				if parsedName {
+				if len(out.DNSNames) > 0 || len(out.EmailAddresses) > 0 || len(out.IPAddresses) > 0 || len(out.URIs) > 0 {
 					continue
 				}
 				// If we didn't parse any of the names then we
 				// fall through to the critical check below.
 
 			case 30:
-				// RFC 5280, 4.2.1.10
-
-				// NameConstraints ::= SEQUENCE {
-				//      permittedSubtrees       [0]     GeneralSubtrees OPTIONAL,
-				//      excludedSubtrees        [1]     GeneralSubtrees OPTIONAL }
-				//
-				// GeneralSubtrees ::= SEQUENCE SIZE (1..MAX) OF GeneralSubtree
-				//
-				// GeneralSubtree ::= SEQUENCE {
-				//      base                    GeneralName,
-				//      minimum         [0]     BaseDistance DEFAULT 0,
-				//      maximum         [1]     BaseDistance OPTIONAL }
-				//
-				// BaseDistance ::= INTEGER (0..MAX)
-
-				var constraints nameConstraints
-				_, err := asn1.Unmarshal(e.Value, &constraints)
+				unhandled, err := parseNameConstraintsExtension(out, e.Value)
 				if err != nil {
 					return nil, err
 				}
-
-				if len(constraints.Excluded) > 0 && e.Critical {
+				if unhandled && e.Critical {
 					return out, UnhandledCriticalExtension{}
 				}
-
-				for _, subtree := range constraints.Permitted {
-					if len(subtree.Name) == 0 {
-						if e.Critical {
-							return out, UnhandledCriticalExtension{}
-						}
-						continue
-					}
-					out.PermittedDNSDomains = append(out.PermittedDNSDomains, subtree.Name)
-				}
 				continue
 
 			case 31:
@@ -1104,6 +1250,84 @@ var (
 	oidAuthorityInfoAccessIssuers = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 2}
 )
 
+// marshalSANs marshals a list of addresses into the contents of an X.509
+// SubjectAlternativeName extension.
+func marshalSANs(dnsNames, emailAddresses []string, ipAddresses []net.IP, uris []*url.URL) (derBytes []byte, err error) {
+	var rawValues []asn1.RawValue
+	for _, name := range dnsNames {
+		rawValues = append(rawValues, asn1.RawValue{Tag: 2, Class: 2, Bytes: []byte(name)})
+	}
+	for _, email := range emailAddresses {
+		rawValues = append(rawValues, asn1.RawValue{Tag: 1, Class: 2, Bytes: []byte(email)})
+	}
+	for _, rawIP := range ipAddresses {
+		// If possible, we always want to encode IPv4 addresses in 4 bytes.
+		ip := rawIP.To4()
+		if ip == nil {
+			ip = rawIP
+		}
+		rawValues = append(rawValues, asn1.RawValue{Tag: 7, Class: 2, Bytes: ip})
+	}
+	for _, uri := range uris {
+		rawValues = append(rawValues, asn1.RawValue{Tag: 6, Class: 2, Bytes: []byte(uri.String())})
+	}
+	return asn1.Marshal(rawValues)
+}
+
+// marshalNameConstraints marshals the name constraints of template into the
+// contents of an X.509 NameConstraints extension.
+func marshalNameConstraints(template *Certificate) (derBytes []byte, err error) {
+	ipSubtrees := func(ipRanges []*net.IPNet) (subtrees []generalSubtree, err error) {
+		for _, ipNet := range ipRanges {
+			ip := ipNet.IP.To16()
+			if len(ipNet.Mask) == net.IPv4len {
+				ip = ipNet.IP.To4()
+			}
+			if ip == nil || len(ip) != len(ipNet.Mask) {
+				return nil, errors.New("x509: invalid IP range " + ipNet.String() + " in name constraints")
+			}
+			b := make([]byte, 0, 2*len(ip))
+			b = append(b, ip...)
+			b = append(b, ipNet.Mask...)
+			subtrees = append(subtrees, generalSubtree{Name: asn1.RawValue{Tag: 7, Class: 2, Bytes: b}})
+		}
+		return
+	}
+	subtrees := func(dnsNames, emails, uriDomains []string, ipRanges []*net.IPNet, dirNames []pkix.RDNSequence) ([]generalSubtree, error) {
+		var ret []generalSubtree
+		for _, name := range dnsNames {
+			ret = append(ret, generalSubtree{Name: asn1.RawValue{Tag: 2, Class: 2, Bytes: []byte(name)}})
+		}
+		for _, email := range emails {
+			ret = append(ret, generalSubtree{Name: asn1.RawValue{Tag: 1, Class: 2, Bytes: []byte(email)}})
+		}
+		for _, dirName := range dirNames {
+			b, err := asn1.Marshal(dirName)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, generalSubtree{Name: asn1.RawValue{Tag: 4, Class: 2, IsCompound: true, Bytes: b}})
+		}
+		for _, domain := range uriDomains {
+			ret = append(ret, generalSubtree{Name: asn1.RawValue{Tag: 6, Class: 2, Bytes: []byte(domain)}})
+		}
+		ips, err := ipSubtrees(ipRanges)
+		if err != nil {
+			return nil, err
+		}
+		return append(ret, ips...), nil
+	}
+
+	var out nameConstraints
+	if out.Permitted, err = subtrees(template.PermittedDNSDomains, template.PermittedEmailAddresses, template.PermittedURIDomains, template.PermittedIPRanges, template.PermittedDirectoryNames); err != nil {
+		return
+	}
+	if out.Excluded, err = subtrees(template.ExcludedDNSDomains, template.ExcludedEmailAddresses, template.ExcludedURIDomains, template.ExcludedIPRanges, template.ExcludedDirectoryNames); err != nil {
+		return
+	}
+	return asn1.Marshal(out)
+}
+
 func buildExtensions(template *Certificate) (ret []pkix.Extension, err error) {
 	ret = make([]pkix.Extension, 10 /* maximum number of elements. */)
 	n := 0
@@ -1199,24 +1423,9 @@ func buildExtensions(template *Certificate) (ret []pkix.Extension, err error) {
 		n++
 	}
 
-	if len(template.DNSNames) > 0 || len(template.EmailAddresses) > 0 || len(template.IPAddresses) > 0 {
+	if len(template.DNSNames) > 0 || len(template.EmailAddresses) > 0 || len(template.IPAddresses) > 0 || len(template.URIs) > 0 {
 		ret[n].Id = oidExtensionSubjectAltName
-		var rawValues []asn1.RawValue
-		for _, name := range template.DNSNames {
-			rawValues = append(rawValues, asn1.RawValue{Tag: 2, Class: 2, Bytes: []byte(name)})
-		}
-		for _, email := range template.EmailAddresses {
-			rawValues = append(rawValues, asn1.RawValue{Tag: 1, Class: 2, Bytes: []byte(email)})
-		}
-		for _, rawIP := range template.IPAddresses {
-			// If possible, we always want to encode IPv4 addresses in 4 bytes.
-			ip := rawIP.To4()
-			if ip == nil {
-				ip = rawIP
-			}
-			rawValues = append(rawValues, asn1.RawValue{Tag: 7, Class: 2, Bytes: ip})
-		}
-		ret[n].Value, err = asn1.Marshal(rawValues)
+		ret[n].Value, err = marshalSANs(template.DNSNames, template.EmailAddresses, template.IPAddresses, template.URIs)
 		if err != nil {
 			return
 		}
@@ -1236,16 +1445,10 @@ func buildExtensions(template *Certificate) (ret []pkix.Extension, err error) {
 		n++
 	}
 
-	if len(template.PermittedDNSDomains) > 0 {
+	if template.hasNameConstraints() {
 		ret[n].Id = oidExtensionNameConstraints
 		ret[n].Critical = template.PermittedDNSDomainsCritical
-
-		var out nameConstraints
-		out.Permitted = make([]generalSubtree, len(template.PermittedDNSDomains))
-		for i, permitted := range template.PermittedDNSDomains {
-			out.Permitted[i] = generalSubtree{Name: permitted}
-		}
-		ret[n].Value, err = asn1.Marshal(out)
+		ret[n].Value, err = marshalNameConstraints(template)
 		if err != nil {
 			return
 		}
@@ -1288,24 +1491,9 @@ func subjectBytes(cert *Certificate) ([]byte, error) {
 	return asn1.Marshal(cert.Subject.ToRDNSequence())
 }
 
-// CreateCertificate creates a new certificate based on a template. The
-// following members of template are used: SerialNumber, Subject, NotBefore,
-// NotAfter, KeyUsage, ExtKeyUsage, UnknownExtKeyUsage, BasicConstraintsValid,
-// IsCA, MaxPathLen, SubjectKeyId, DNSNames, PermittedDNSDomainsCritical,
-// PermittedDNSDomains.
-//
-// The certificate is signed by parent. If parent is equal to template then the
-// certificate is self-signed. The parameter pub is the public key of the
-// signee and priv is the private key of the signer.
-//
-// The returned slice is the certificate in DER encoding.
-//
-// The only supported key types are RSA and ECDSA (*rsa.PublicKey or
-// *ecdsa.PublicKey for pub, *rsa.PrivateKey or *ecdsa.PublicKey for priv).
-func CreateCertificate(rand io.Reader, template, parent *Certificate, pub interface{}, priv interface{}) (cert []byte, err error) {
-	var publicKeyBytes []byte
-	var publicKeyAlgorithm pkix.AlgorithmIdentifier
-
+// marshalPublicKey returns the SubjectPublicKeyInfo contents and algorithm
+// identifier for pub.
+func marshalPublicKey(pub interface{}) (publicKeyBytes []byte, publicKeyAlgorithm pkix.AlgorithmIdentifier, err error) {
 	switch pub := pub.(type) {
 	case *rsa.PublicKey:
 		publicKeyBytes, err = asn1.Marshal(rsaPublicKey{
@@ -1316,7 +1504,7 @@ func CreateCertificate(rand io.Reader, template, parent *Certificate, pub interf
 	case *ecdsa.PublicKey:
 		oid, ok := oidFromNamedCurve(pub.Curve)
 		if !ok {
-			return nil, errors.New("x509: unknown elliptic curve")
+			return nil, pkix.AlgorithmIdentifier{}, errors.New("x509: unknown elliptic curve")
 		}
 		publicKeyAlgorithm.Algorithm = oidPublicKeyECDSA
 		var paramBytes []byte
@@ -1326,37 +1514,89 @@ func CreateCertificate(rand io.Reader, template, parent *Certificate, pub interf
 		}
 		publicKeyAlgorithm.Parameters.FullBytes = paramBytes
 		publicKeyBytes = elliptic.Marshal(pub.Curve, pub.X, pub.Y)
+	case ed25519.PublicKey:
+		publicKeyBytes = pub
+		publicKeyAlgorithm.Algorithm = oidPublicKeyEd25519
 	default:
-		return nil, errors.New("x509: only RSA and ECDSA public keys supported")
+		return nil, pkix.AlgorithmIdentifier{}, errors.New("x509: only RSA, ECDSA and Ed25519 public keys supported")
 	}
 
-	var signatureAlgorithm pkix.AlgorithmIdentifier
-	var hashFunc crypto.Hash
+	return
+}
 
-	switch priv := priv.(type) {
-	case *rsa.PrivateKey:
-		signatureAlgorithm.Algorithm = oidSignatureSHA1WithRSA
+// signingParamsForPublicKey returns the signature algorithm and hash
+// function to use when signing with the private key that corresponds
+// to pub. A zero hash function means that the message is signed directly.
+func signingParamsForPublicKey(pub interface{}) (sigAlgo pkix.AlgorithmIdentifier, hashFunc crypto.Hash, err error) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		sigAlgo.Algorithm = oidSignatureSHA1WithRSA
 		hashFunc = crypto.SHA1
-	case *ecdsa.PrivateKey:
-		switch priv.Curve {
+	case *ecdsa.PublicKey:
+		switch pub.Curve {
 		case elliptic.P224(), elliptic.P256():
 			hashFunc = crypto.SHA256
-			signatureAlgorithm.Algorithm = oidSignatureECDSAWithSHA256
+			sigAlgo.Algorithm = oidSignatureECDSAWithSHA256
 		case elliptic.P384():
 			hashFunc = crypto.SHA384
-			signatureAlgorithm.Algorithm = oidSignatureECDSAWithSHA384
+			sigAlgo.Algorithm = oidSignatureECDSAWithSHA384
 		case elliptic.P521():
 			hashFunc = crypto.SHA512
-			signatureAlgorithm.Algorithm = oidSignatureECDSAWithSHA512
+			sigAlgo.Algorithm = oidSignatureECDSAWithSHA512
 		default:
-			return nil, errors.New("x509: unknown elliptic curve")
+			err = errors.New("x509: unknown elliptic curve")
 		}
+	case ed25519.PublicKey:
+		sigAlgo.Algorithm = oidSignatureEd25519
 	default:
-		return nil, errors.New("x509: only RSA and ECDSA private keys supported")
+		err = errors.New("x509: only RSA, ECDSA and Ed25519 keys supported")
 	}
+	return
+}
 
+// signMessage signs message with key, hashing it first with hashFunc unless
+// hashFunc is zero.
+func signMessage(rand io.Reader, key crypto.Signer, message []byte, hashFunc crypto.Hash) ([]byte, error) {
+	digest := message
+	if hashFunc != 0 {
+		h := hashFunc.New()
+		h.Write(message)
+		digest = h.Sum(nil)
+	}
+	return key.Sign(rand, digest, hashFunc)
+}
+
+// CreateCertificate creates a new certificate based on a template. The
+// following members of template are used: SerialNumber, Subject, NotBefore,
+// NotAfter, KeyUsage, ExtKeyUsage, UnknownExtKeyUsage, BasicConstraintsValid,
+// IsCA, MaxPathLen, SubjectKeyId, DNSNames, EmailAddresses, IPAddresses, URIs,
+// PolicyIdentifiers, PermittedDNSDomainsCritical and the permitted and excluded
+// DNS domains, IP ranges, email addresses, URI domains and directory names.
+//
+// The certificate is signed by parent. If parent is equal to template then the
+// certificate is self-signed. The parameter pub is the public key of the
+// signee and priv is the private key of the signer.
+//
+// The returned slice is the certificate in DER encoding.
+//
+// The only supported public key types are RSA, ECDSA and Ed25519
+// (*rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey for pub). The
+// private key, priv, must implement crypto.Signer with one of those public
+// keys; *rsa.PrivateKey, *ecdsa.PrivateKey and ed25519.PrivateKey do, as may
+// keys held in a hardware module.
+func CreateCertificate(rand io.Reader, template, parent *Certificate, pub interface{}, priv interface{}) (cert []byte, err error) {
+	publicKeyBytes, publicKeyAlgorithm, err := marshalPublicKey(pub)
 	if err != nil {
-		return
+		return nil, err
+	}
+
+	key, ok := priv.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("x509: certificate private key does not implement crypto.Signer")
+	}
+	signatureAlgorithm, hashFunc, err := signingParamsForPublicKey(key.Public())
+	if err != nil {
+		return nil, err
 	}
 
 	if len(parent.SubjectKeyId) > 0 {
@@ -1397,24 +1637,7 @@ func CreateCertificate(rand io.Reader, template, parent *Certificate, pub interf
 
 	c.Raw = tbsCertContents
 
-	h := hashFunc.New()
-	h.Write(tbsCertContents)
-	digest := h.Sum(nil)
-
-	var signature []byte
-
-	switch priv := priv.(type) {
-	case *rsa.PrivateKey:
-		signature, err = rsa.SignPKCS1v15(rand, priv, hashFunc, digest)
-	case *ecdsa.PrivateKey:
-		var r, s *big.Int
-		if r, s, err = ecdsa.Sign(rand, priv, digest); err == nil {
-			signature, err = asn1.Marshal(ecdsaSignature{r, s})
-		}
-	default:
-		panic("internal error")
-	}
-
+	signature, err := signMessage(rand, key, tbsCertContents, hashFunc)
 	if err != nil {
 		return
 	}
@@ -1462,17 +1685,21 @@ func ParseDERCRL(derBytes []byte) (certList *pkix.CertificateList, err error) {
 // CreateCRL returns a DER encoded CRL, signed by this Certificate, that
 // contains the given list of revoked certificates.
 //
-// The only supported key type is RSA (*rsa.PrivateKey for priv).
+// The private key, priv, must implement crypto.Signer with an RSA, ECDSA or
+// Ed25519 public key.
 func (c *Certificate) CreateCRL(rand io.Reader, priv interface{}, revokedCerts []pkix.RevokedCertificate, now, expiry time.Time) (crlBytes []byte, err error) {
-	rsaPriv, ok := priv.(*rsa.PrivateKey)
+	key, ok := priv.(crypto.Signer)
 	if !ok {
-		return nil, errors.New("x509: non-RSA private keys not supported")
+		return nil, errors.New("x509: certificate private key does not implement crypto.Signer")
+	}
+	signatureAlgorithm, hashFunc, err := signingParamsForPublicKey(key.Public())
+	if err != nil {
+		return nil, err
 	}
+
 	tbsCertList := pkix.TBSCertificateList{
-		Version: 2,
-		Signature: pkix.AlgorithmIdentifier{
-			Algorithm: oidSignatureSHA1WithRSA,
-		},
+		Version:             2,
+		Signature:           signatureAlgorithm,
 		Issuer:              c.Subject.ToRDNSequence(),
 		ThisUpdate:          now.UTC(),
 		NextUpdate:          expiry.UTC(),
@@ -1484,20 +1711,264 @@ func (c *Certificate) CreateCRL(rand io.Reader, priv interface{}, revokedCerts [
 		return
 	}
 
-	h := sha1.New()
-	h.Write(tbsCertListContents)
-	digest := h.Sum(nil)
-
-	signature, err := rsa.SignPKCS1v15(rand, rsaPriv, crypto.SHA1, digest)
+	signature, err := signMessage(rand, key, tbsCertListContents, hashFunc)
 	if err != nil {
 		return
 	}
 
 	return asn1.Marshal(pkix.CertificateList{
-		TBSCertList: tbsCertList,
-		SignatureAlgorithm: pkix.AlgorithmIdentifier{
-			Algorithm: oidSignatureSHA1WithRSA,
+		TBSCertList:        tbsCertList,
+		SignatureAlgorithm: signatureAlgorithm,
+		SignatureValue:     asn1.BitString{Bytes: signature, BitLength: len(signature) * 8},
+	})
+}
+
+// CertificateRequest represents a PKCS #10, certificate signature request.
+type CertificateRequest struct {
+	Raw                      []byte // Complete ASN.1 DER content (CSR, signature algorithm and signature).
+	RawTBSCertificateRequest []byte // Certificate request info part of raw ASN.1 DER content.
+	RawSubjectPublicKeyInfo  []byte // DER encoded SubjectPublicKeyInfo.
+	RawSubject               []byte // DER encoded Subject.
+
+	Version            int
+	Signature          []byte
+	SignatureAlgorithm SignatureAlgorithm
+
+	PublicKeyAlgorithm PublicKeyAlgorithm
+	PublicKey          interface{}
+
+	Subject pkix.Name
+
+	// Extensions contains the extensions requested in the CSR, as found in
+	// its extensionRequest attribute. When parsing CSRs, this can be used to
+	// extract extensions that are not parsed by this package.
+	Extensions []pkix.Extension
+
+	// ExtraExtensions contains extensions to be copied, raw, into any
+	// marshaled CSR. Values override any extensions that would otherwise be
+	// produced based on the other fields.
+	ExtraExtensions []pkix.Extension
+
+	// Subject Alternate Name values.
+	DNSNames       []string
+	EmailAddresses []string
+	IPAddresses    []net.IP
+	URIs           []*url.URL
+}
+
+// These structures reflect the ASN.1 structure of X.509 certificate
+// signature requests (see RFC 2986):
+
+type tbsCertificateRequest struct {
+	Raw           asn1.RawContent
+	Version       int
+	Subject       asn1.RawValue
+	PublicKey     publicKeyInfo
+	RawAttributes []asn1.RawValue `asn1:"tag:0"`
+}
+
+type certificateRequest struct {
+	Raw                asn1.RawContent
+	TBSCSR             tbsCertificateRequest
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	SignatureValue     asn1.BitString
+}
+
+// pkcs10Attribute is a PKCS #10 attribute, which is a type with a set of
+// values.
+type pkcs10Attribute struct {
+	Id     asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// oidExtensionRequest is a PKCS#9 OBJECT IDENTIFIER that indicates requested
+// extensions in a CSR.
+var oidExtensionRequest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 14}
+
+// oidInExtensions returns whether an extension with the given oid exists in
+// extensions.
+func oidInExtensions(oid asn1.ObjectIdentifier, extensions []pkix.Extension) bool {
+	for _, e := range extensions {
+		if e.Id.Equal(oid) {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateCertificateRequest creates a new certificate request based on a
+// template. The following members of template are used: Subject, DNSNames,
+// EmailAddresses, IPAddresses, URIs and ExtraExtensions.
+//
+// The returned slice is the certificate request in DER encoding.
+//
+// The private key, priv, must implement crypto.Signer with an RSA, ECDSA or
+// Ed25519 public key; that public key is the one placed in the request.
+func CreateCertificateRequest(rand io.Reader, template *CertificateRequest, priv interface{}) (csr []byte, err error) {
+	key, ok := priv.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("x509: certificate private key does not implement crypto.Signer")
+	}
+	signatureAlgorithm, hashFunc, err := signingParamsForPublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyBytes, publicKeyAlgorithm, err := marshalPublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	var extensions []pkix.Extension
+
+	if (len(template.DNSNames) > 0 || len(template.EmailAddresses) > 0 || len(template.IPAddresses) > 0 || len(template.URIs) > 0) &&
+		!oidInExtensions(oidExtensionSubjectAltName, template.ExtraExtensions) {
+		sanBytes, err := marshalSANs(template.DNSNames, template.EmailAddresses, template.IPAddresses, template.URIs)
+		if err != nil {
+			return nil, err
+		}
+
+		extensions = append(extensions, pkix.Extension{
+			Id:    oidExtensionSubjectAltName,
+			Value: sanBytes,
+		})
+	}
+
+	extensions = append(extensions, template.ExtraExtensions...)
+
+	var rawAttributes []asn1.RawValue
+	if len(extensions) > 0 {
+		extensionsBytes, err := asn1.Marshal(extensions)
+		if err != nil {
+			return nil, err
+		}
+
+		attributeBytes, err := asn1.Marshal(pkcs10Attribute{
+			Id:     oidExtensionRequest,
+			Values: []asn1.RawValue{{FullBytes: extensionsBytes}},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		rawAttributes = append(rawAttributes, asn1.RawValue{FullBytes: attributeBytes})
+	}
+
+	asn1Subject := template.RawSubject
+	if len(asn1Subject) == 0 {
+		asn1Subject, err = asn1.Marshal(template.Subject.ToRDNSequence())
+		if err != nil {
+			return
+		}
+	}
+
+	tbsCSR := tbsCertificateRequest{
+		Version: 0, // PKCS #10, RFC 2986
+		Subject: asn1.RawValue{FullBytes: asn1Subject},
+		PublicKey: publicKeyInfo{
+			Algorithm: publicKeyAlgorithm,
+			PublicKey: asn1.BitString{
+				Bytes:     publicKeyBytes,
+				BitLength: len(publicKeyBytes) * 8,
+			},
 		},
-		SignatureValue: asn1.BitString{Bytes: signature, BitLength: len(signature) * 8},
+		RawAttributes: rawAttributes,
+	}
+
+	tbsCSRContents, err := asn1.Marshal(tbsCSR)
+	if err != nil {
+		return
+	}
+	tbsCSR.Raw = tbsCSRContents
+
+	signature, err := signMessage(rand, key, tbsCSRContents, hashFunc)
+	if err != nil {
+		return
+	}
+
+	return asn1.Marshal(certificateRequest{
+		TBSCSR:             tbsCSR,
+		SignatureAlgorithm: signatureAlgorithm,
+		SignatureValue:     asn1.BitString{Bytes: signature, BitLength: len(signature) * 8},
 	})
 }
+
+// ParseCertificateRequest parses a single certificate request from the
+// given ASN.1 DER data.
+func ParseCertificateRequest(asn1Data []byte) (*CertificateRequest, error) {
+	var csr certificateRequest
+
+	rest, err := asn1.Unmarshal(asn1Data, &csr)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, asn1.SyntaxError{Msg: "trailing data"}
+	}
+
+	return parseCertificateRequest(&csr)
+}
+
+func parseCertificateRequest(in *certificateRequest) (*CertificateRequest, error) {
+	out := &CertificateRequest{
+		Raw:                      in.Raw,
+		RawTBSCertificateRequest: in.TBSCSR.Raw,
+		RawSubjectPublicKeyInfo:  in.TBSCSR.PublicKey.Raw,
+		RawSubject:               in.TBSCSR.Subject.FullBytes,
+
+		Signature:          in.SignatureValue.RightAlign(),
+		SignatureAlgorithm: getSignatureAlgorithmFromOID(in.SignatureAlgorithm.Algorithm),
+
+		PublicKeyAlgorithm: getPublicKeyAlgorithmFromOID(in.TBSCSR.PublicKey.Algorithm.Algorithm),
+
+		Version: in.TBSCSR.Version,
+	}
+
+	var err error
+	out.PublicKey, err = parsePublicKey(out.PublicKeyAlgorithm, &in.TBSCSR.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var subject pkix.RDNSequence
+	if _, err := asn1.Unmarshal(in.TBSCSR.Subject.FullBytes, &subject); err != nil {
+		return nil, err
+	}
+	out.Subject.FillFromRDNSequence(&subject)
+
+	for _, rawAttribute := range in.TBSCSR.RawAttributes {
+		var attribute pkcs10Attribute
+		if _, err := asn1.Unmarshal(rawAttribute.FullBytes, &attribute); err != nil {
+			return nil, err
+		}
+		if !attribute.Id.Equal(oidExtensionRequest) {
+			continue
+		}
+		if len(attribute.Values) != 1 {
+			return nil, errors.New("x509: extensionRequest attribute must have a single value")
+		}
+
+		var extensions []pkix.Extension
+		if _, err := asn1.Unmarshal(attribute.Values[0].FullBytes, &extensions); err != nil {
+			return nil, err
+		}
+		out.Extensions = append(out.Extensions, extensions...)
+	}
+
+	for _, e := range out.Extensions {
+		if e.Id.Equal(oidExtensionSubjectAltName) {
+			out.DNSNames, out.EmailAddresses, out.IPAddresses, out.URIs, err = parseSANExtension(e.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// CheckSignature verifies that the signature on c is a valid signature from
+// the public key contained in the request.
+func (c *CertificateRequest) CheckSignature() error {
+	return checkSignature(c.SignatureAlgorithm, c.RawTBSCertificateRequest, c.Signature, c.PublicKey)
+}
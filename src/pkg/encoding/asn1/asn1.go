@@ -579,6 +579,9 @@ func parseField(v reflect.Value, bytes []byte, initOffset int, params fieldParam
 		err = StructuralError{fmt.Sprintf("unknown Go type: %v", fieldType)}
 		return
 	}
+	if params.set {
+		universalTag = tagSet
+	}
 
 	t, offset, err := parseTagAndLength(bytes, offset)
 	if err != nil {
@@ -855,6 +858,7 @@ func setDefaultValue(v reflect.Value, params fieldParameters) (ok bool) {
 //	optional		marks the field as ASN.1 OPTIONAL
 //	[explicit] tag:x	specifies the ASN.1 tag number; implies ASN.1 CONTEXT SPECIFIC
 //	default:x		sets the default value for optional integer fields
+//	set			causes a SET, rather than a SEQUENCE type to be expected
 //
 // If the type of the first field of a structure is RawContent then the raw
 // ASN1 contents of the struct will be stored in it.
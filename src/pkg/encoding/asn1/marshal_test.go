@@ -58,6 +58,14 @@ type omitEmptyTest struct {
 	A []string `asn1:"omitempty"`
 }
 
+type generalizedTimeTest struct {
+	A time.Time `asn1:"generalized"`
+}
+
+type flagTest struct {
+	A Flag `asn1:"tag:0,optional"`
+}
+
 type testSET []int
 
 var PST = time.FixedZone("PST", -8*60*60)
@@ -83,6 +91,10 @@ var marshalTests = []marshalTest{
 	{time.Unix(0, 0).UTC(), "170d3730303130313030303030305a"},
 	{time.Unix(1258325776, 0).UTC(), "170d3039313131353232353631365a"},
 	{time.Unix(1258325776, 0).In(PST), "17113039313131353134353631362d30383030"},
+	{generalizedTimeTest{time.Unix(1258325776, 0).UTC()}, "3011180f32303039313131353232353631365a"},
+	{generalizedTimeTest{time.Date(2080, 1, 2, 3, 4, 5, 0, time.UTC)}, "3011180f32303830303130323033303430355a"},
+	{flagTest{true}, "30028000"},
+	{flagTest{false}, "3000"},
 	{BitString{[]byte{0x80}, 1}, "03020780"},
 	{BitString{[]byte{0x81, 0xf0}, 12}, "03030481f0"},
 	{ObjectIdentifier([]int{1, 2, 3, 4}), "06032a0304"},
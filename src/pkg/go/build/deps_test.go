@@ -213,6 +213,7 @@ var pkgDeps = map[string][]string{
 	"net/url":             {"L4"},
 	"text/scanner":        {"L4", "OS"},
 	"text/template/parse": {"L4"},
+	"time/tzdata":         {"L4", "OS", "archive/zip"},
 
 	"html/template": {
 		"L4", "OS", "encoding/json", "html", "text/template",
@@ -279,10 +280,15 @@ var pkgDeps = map[string][]string{
 	// Mathematical crypto: dependencies on fmt (L4) and math/big.
 	// We could avoid some of the fmt, but math/big imports fmt anyway.
 	"crypto/dsa":      {"L4", "CRYPTO", "math/big"},
-	"crypto/ecdsa":    {"L4", "CRYPTO", "crypto/elliptic", "math/big"},
+	"crypto/ecdsa":    {"L4", "CRYPTO", "crypto/elliptic", "encoding/asn1", "math/big"},
 	"crypto/elliptic": {"L4", "CRYPTO", "math/big"},
 	"crypto/rsa":      {"L4", "CRYPTO", "crypto/rand", "math/big"},
 
+	// Curve25519-based crypto: constant-time, with no use of math/big.
+	"crypto/internal/field25519": {},
+	"crypto/curve25519":          {"crypto/internal/field25519"},
+	"crypto/ed25519":             {"L3", "crypto/internal/field25519", "crypto/rand", "crypto/sha512"},
+
 	"CRYPTO-MATH": {
 		"CRYPTO",
 		"crypto/dsa",
@@ -290,6 +296,8 @@ var pkgDeps = map[string][]string{
 		"crypto/elliptic",
 		"crypto/rand",
 		"crypto/rsa",
+		"crypto/curve25519",
+		"crypto/ed25519",
 		"encoding/asn1",
 		"math/big",
 	},
@@ -297,13 +305,14 @@ var pkgDeps = map[string][]string{
 	// SSL/TLS.
 	"crypto/tls": {
 		"L4", "CRYPTO-MATH", "CGO", "OS",
-		"crypto/x509", "encoding/pem", "net", "syscall",
+		"container/list", "crypto/x509", "encoding/pem", "net", "syscall",
 	},
 	"crypto/x509": {
 		"L4", "CRYPTO-MATH", "OS", "CGO",
-		"crypto/x509/pkix", "encoding/pem", "encoding/hex", "net", "syscall",
+		"crypto/x509/pkix", "encoding/pem", "encoding/hex", "net", "net/url", "syscall",
 	},
 	"crypto/x509/pkix": {"L4", "CRYPTO-MATH"},
+	"crypto/ocsp":      {"L4", "CRYPTO-MATH", "crypto/x509", "crypto/x509/pkix"},
 
 	// Simple net+crypto-aware packages.
 	"mime/multipart": {"L4", "OS", "mime", "crypto/rand", "net/textproto"},
@@ -312,18 +321,21 @@ var pkgDeps = map[string][]string{
 	// HTTP, kingpin of dependencies.
 	"net/http": {
 		"L4", "NET", "OS",
-		"compress/gzip", "crypto/tls", "mime/multipart", "runtime/debug",
+		"archive/zip", "compress/flate", "compress/gzip", "compress/zlib", "crypto/tls", "mime/multipart", "net/http/httptrace", "runtime/debug",
 	},
+	"net/http/httptrace": {"L4", "NET", "crypto/tls"},
 
 	// HTTP-using packages.
 	"expvar":            {"L4", "OS", "encoding/json", "net/http"},
-	"net/http/cgi":      {"L4", "NET", "OS", "crypto/tls", "net/http", "regexp"},
-	"net/http/fcgi":     {"L4", "NET", "OS", "net/http", "net/http/cgi"},
-	"net/http/httptest": {"L4", "NET", "OS", "crypto/tls", "flag", "net/http"},
-	"net/http/httputil": {"L4", "NET", "OS", "net/http"},
-	"net/http/pprof":    {"L4", "OS", "html/template", "net/http", "runtime/pprof"},
-	"net/rpc":           {"L4", "NET", "encoding/gob", "net/http", "text/template"},
-	"net/rpc/jsonrpc":   {"L4", "NET", "encoding/json", "net/rpc"},
+	"net/http/cgi":                    {"L4", "NET", "OS", "crypto/tls", "net/http", "regexp"},
+	"net/http/cookiejar":              {"L4", "NET", "encoding/json", "net/http"},
+	"net/http/cookiejar/publicsuffix": {"L4", "net/http/cookiejar"},
+	"net/http/fcgi":                   {"L4", "NET", "OS", "net/http", "net/http/cgi"},
+	"net/http/httptest":               {"L4", "NET", "OS", "crypto/tls", "flag", "net/http"},
+	"net/http/httputil":               {"L4", "NET", "OS", "net/http"},
+	"net/http/pprof":                  {"L4", "OS", "html/template", "net/http", "runtime/pprof"},
+	"net/rpc":                         {"L4", "NET", "encoding/gob", "net/http", "text/template"},
+	"net/rpc/jsonrpc":                 {"L4", "NET", "encoding/json", "net/rpc"},
 }
 
 // isMacro reports whether p is a package dependency macro
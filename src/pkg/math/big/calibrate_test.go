@@ -2,11 +2,11 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// This file prints execution times for the Mul benchmark
-// given different Karatsuba thresholds. The result may be
-// used to manually fine-tune the threshold constant. The
-// results are somewhat fragile; use repeated runs to get
-// a clear picture.
+// This file prints execution times for the Mul and Div benchmarks
+// given different Karatsuba, Toom-3, and recursive division thresholds.
+// The results may be used to manually fine-tune the threshold constants.
+// The results are somewhat fragile; use repeated runs to get a clear
+// picture.
 
 // Usage: go test -run=TestCalibrate -calibrate
 
@@ -25,32 +25,43 @@ func karatsubaLoad(b *testing.B) {
 	BenchmarkMul(b)
 }
 
-// measureKaratsuba returns the time to run a Karatsuba-relevant benchmark
-// given Karatsuba threshold th.
-func measureKaratsuba(th int) time.Duration {
-	th, karatsubaThreshold = karatsubaThreshold, th
-	res := testing.Benchmark(karatsubaLoad)
-	karatsubaThreshold = th
+func toom3Load(b *testing.B) {
+	BenchmarkMul(b)
+}
+
+func divLoad(b *testing.B) {
+	BenchmarkDiv(b)
+}
+
+// measure returns the time to run the benchmark load
+// given the threshold value th for *threshold.
+func measure(load func(*testing.B), threshold *int, th int) time.Duration {
+	th, *threshold = *threshold, th
+	res := testing.Benchmark(load)
+	*threshold = th
 	return time.Duration(res.NsPerOp())
 }
 
-func computeThresholds() {
-	fmt.Printf("Multiplication times for varying Karatsuba thresholds\n")
+// computeThresholds prints the execution times of load for thresholds
+// from th to max in increments of step, and marks the break-even point
+// and the point of diminishing return relative to the execution time
+// with the algorithm disabled.
+func computeThresholds(name string, load func(*testing.B), threshold *int, th, max, step int) {
+	fmt.Printf("Execution times for varying %s thresholds\n", name)
 	fmt.Printf("(run repeatedly for good results)\n")
 
-	// determine Tk, the work load execution time using basic multiplication
-	Tb := measureKaratsuba(1e9) // th == 1e9 => Karatsuba multiplication disabled
+	// determine Tb, the work load execution time with the algorithm disabled
+	Tb := measure(load, threshold, 1e9) // th == 1e9 => algorithm disabled
 	fmt.Printf("Tb = %10s\n", Tb)
 
 	// thresholds
-	th := 4
 	th1 := -1
 	th2 := -1
 
 	var deltaOld time.Duration
-	for count := -1; count != 0 && th < 128; count-- {
-		// determine Tk, the work load execution time using Karatsuba multiplication
-		Tk := measureKaratsuba(th)
+	for count := -1; count != 0 && th < max; count-- {
+		// determine Tk, the work load execution time using the algorithm
+		Tk := measure(load, threshold, th)
 
 		// improvement over Tb
 		delta := (Tb - Tk) * 100 / Tb
@@ -77,12 +88,20 @@ func computeThresholds() {
 			count = 10 // this many extra measurements after we got both thresholds
 		}
 
-		th++
+		th += step
 	}
 }
 
 func TestCalibrate(t *testing.T) {
 	if *calibrate {
-		computeThresholds()
+		// Toom-3 multiplication must be disabled when calibrating
+		// Karatsuba multiplication, and vice versa the Toom-3
+		// threshold is relative to the Karatsuba threshold.
+		th := toom3Threshold
+		toom3Threshold = 1e9
+		computeThresholds("Karatsuba", karatsubaLoad, &karatsubaThreshold, 4, 128, 1)
+		toom3Threshold = th
+		computeThresholds("Toom-3", toom3Load, &toom3Threshold, karatsubaThreshold, 1000, 10)
+		computeThresholds("recursive division", divLoad, &divRecursiveThreshold, 4, 200, 4)
 	}
 }
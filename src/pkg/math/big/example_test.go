@@ -49,3 +49,27 @@ func ExampleInt_Scan() {
 	}
 	// Output: 18446744073709551617
 }
+
+func ExampleFloat_Add() {
+	// Operate on numbers of different precision.
+	var x, y, z big.Float
+	x.SetInt64(1000)          // x is automatically set to 64bit precision
+	y.SetFloat64(2.718281828) // y is automatically set to 53bit precision
+	z.SetPrec(32)
+	z.Add(&x, &y)
+	fmt.Printf("x = %.10g (%s, prec = %d, acc = %s)\n", &x, x.Text('p', 0), x.Prec(), x.Acc())
+	fmt.Printf("y = %.10g (%s, prec = %d, acc = %s)\n", &y, y.Text('p', 0), y.Prec(), y.Acc())
+	fmt.Printf("z = %.10g (%s, prec = %d, acc = %s)\n", &z, z.Text('p', 0), z.Prec(), z.Acc())
+	// Output:
+	// x = 1000 (0x.fap+10, prec = 64, acc = Exact)
+	// y = 2.718281828 (0x.adf85458248cd8p+2, prec = 53, acc = Exact)
+	// z = 1002.718282 (0x.faadf854p+10, prec = 32, acc = Below)
+}
+
+func ExampleFloat_Sqrt() {
+	// Compute √2 to 200 bits of precision.
+	two := new(big.Float).SetPrec(200).SetInt64(2)
+	sqrt2 := new(big.Float).SetPrec(200).Sqrt(two)
+	fmt.Println(sqrt2.Text('f', 50))
+	// Output: 1.41421356237309504880168872420969807856967187537695
+}
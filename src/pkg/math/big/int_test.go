@@ -300,6 +300,44 @@ func TestGetString(t *testing.T) {
 	}
 }
 
+func TestIntText(t *testing.T) {
+	z := new(Int)
+	for i, test := range stringTests {
+		if !test.ok || test.base == 0 {
+			continue
+		}
+		z.SetInt64(test.val)
+
+		if s := z.Text(test.base); s != test.out {
+			t.Errorf("#%da got %s; want %s", i, s, test.out)
+		}
+
+		if s := string(z.Append([]byte("x"), test.base)); s != "x"+test.out {
+			t.Errorf("#%db got %s; want x%s", i, s, test.out)
+		}
+	}
+
+	// large values exercise the recursive conversion
+	for _, n := range []int{10, 100, 500} {
+		x := &Int{abs: rndNat(n)}
+		for base := 2; base <= MaxBase; base++ {
+			want := toString(x.abs, lowercaseDigits[0:base])
+			if s := x.Text(base); s != want {
+				t.Errorf("%d words, base %d: got %s; want %s", n, base, s, want)
+			}
+			x.neg = true
+			if s := x.Text(base); s != "-"+want {
+				t.Errorf("%d words, base %d: got %s; want -%s", n, base, s, want)
+			}
+			x.neg = false
+		}
+	}
+
+	if s := (*Int)(nil).Text(10); s != "<nil>" {
+		t.Errorf("got %s; want <nil>", s)
+	}
+}
+
 func TestSetString(t *testing.T) {
 	tmp := new(Int)
 	for i, test := range stringTests {
@@ -1448,6 +1486,197 @@ func TestModInverse(t *testing.T) {
 	}
 }
 
+func TestIntSqrt(t *testing.T) {
+	var root, sq Int
+	check := func(x *Int) {
+		root.Sqrt(x)
+		// root² <= x < (root+1)²
+		sq.Mul(&root, &root)
+		if sq.Cmp(x) > 0 {
+			t.Errorf("Sqrt(%s) = %s is too large", x, &root)
+			return
+		}
+		sq.Add(&root, intOne)
+		sq.Mul(&sq, &sq)
+		if sq.Cmp(x) <= 0 {
+			t.Errorf("Sqrt(%s) = %s is too small", x, &root)
+		}
+	}
+
+	for i := int64(0); i < 1000; i++ {
+		check(NewInt(i))
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 10, 100, 1000} {
+		for i := 0; i < 10; i++ {
+			x := &Int{abs: nat(nil).random(r, nat(nil).shl(natOne, uint(n*_W)), n*_W+1)}
+			check(x)
+			// perfect squares and their neighbors
+			sq := new(Int).Mul(x, x)
+			check(sq)
+			check(new(Int).Sub(sq, intOne))
+			check(new(Int).Add(sq, intOne))
+		}
+	}
+
+	// aliasing
+	x := NewInt(1 << 40)
+	if x.Sqrt(x).Cmp(NewInt(1<<20)) != 0 {
+		t.Errorf("Sqrt aliasing: got %s; want %d", x, 1<<20)
+	}
+
+	// negative operand
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Sqrt(-1) did not panic")
+		}
+	}()
+	new(Int).Sqrt(NewInt(-1))
+}
+
+func TestJacobi(t *testing.T) {
+	testCases := []struct {
+		x, y   int64
+		result int
+	}{
+		{0, 1, 1},
+		{0, -1, 1},
+		{1, 1, 1},
+		{1, -1, 1},
+		{0, 5, 0},
+		{1, 5, 1},
+		{2, 5, -1},
+		{-2, 5, -1},
+		{2, -5, -1},
+		{-2, -5, 1},
+		{3, 5, -1},
+		{5, 5, 0},
+		{-5, 5, 0},
+		{6, 5, 1},
+		{6, -5, 1},
+		{-6, 5, 1},
+		{-6, -5, -1},
+	}
+
+	var x, y Int
+
+	for i, test := range testCases {
+		x.SetInt64(test.x)
+		y.SetInt64(test.y)
+		expected := test.result
+		actual := Jacobi(&x, &y)
+		if actual != expected {
+			t.Errorf("#%d: Jacobi(%d, %d) = %d, but expected %d", i, test.x, test.y, actual, expected)
+		}
+	}
+}
+
+func TestJacobiPanic(t *testing.T) {
+	const failureMsg = "test failure"
+	defer func() {
+		msg := recover()
+		if msg == nil || msg == failureMsg {
+			panic(msg)
+		}
+		t.Log(msg)
+	}()
+	x := NewInt(1)
+	y := NewInt(2)
+	// Jacobi should panic when the second argument is even.
+	Jacobi(x, y)
+	panic(failureMsg)
+}
+
+func testModSqrt(t *testing.T, elt, mod, sq, sqrt *Int) bool {
+	var sqChk, sqrtChk, sqrtsq Int
+	sq.Mul(elt, elt)
+	sq.Mod(sq, mod)
+	z := sqrt.ModSqrt(sq, mod)
+	if z != sqrt {
+		t.Errorf("ModSqrt returned wrong value %s", z)
+	}
+
+	// test ModSqrt arguments outside the range [0,mod)
+	sqChk.Add(sq, mod)
+	z = sqrtChk.ModSqrt(&sqChk, mod)
+	if z != &sqrtChk || z.Cmp(sqrt) != 0 {
+		t.Errorf("ModSqrt returned inconsistent value %s", z)
+	}
+	sqChk.Sub(sq, mod)
+	z = sqrtChk.ModSqrt(&sqChk, mod)
+	if z != &sqrtChk || z.Cmp(sqrt) != 0 {
+		t.Errorf("ModSqrt returned inconsistent value %s", z)
+	}
+
+	// test x aliasing z
+	z = sqrtChk.ModSqrt(sqrtChk.Set(sq), mod)
+	if z != &sqrtChk || z.Cmp(sqrt) != 0 {
+		t.Errorf("ModSqrt returned inconsistent value %s", z)
+	}
+
+	// make sure we actually got a square root
+	if sqrt.Cmp(elt) == 0 {
+		return true // we found the "desired" square root
+	}
+	sqrtsq.Mul(sqrt, sqrt) // make sure we found the "other" one
+	sqrtsq.Mod(&sqrtsq, mod)
+	return sq.Cmp(&sqrtsq) == 0
+}
+
+func TestModSqrt(t *testing.T) {
+	var elt, mod, modx4, sq, sqrt Int
+	r := rand.New(rand.NewSource(9))
+	for i, s := range primes[1:] { // skip 2, use only odd primes
+		mod.SetString(s, 10)
+		modx4.Lsh(&mod, 2)
+
+		// test a few random elements per prime
+		for x := 1; x < 5; x++ {
+			elt.Rand(r, &modx4)
+			elt.Sub(&elt, &mod) // test range [-mod, 3*mod)
+			if !testModSqrt(t, &elt, &mod, &sq, &sqrt) {
+				t.Errorf("#%d: failed (sqrt(e) = %s)", i, &sqrt)
+			}
+		}
+
+		if testing.Short() && i > 2 {
+			break
+		}
+	}
+
+	if testing.Short() {
+		return
+	}
+
+	// exhaustive test for small values
+	for n := 3; n < 500; n++ {
+		mod.SetInt64(int64(n))
+		if !mod.ProbablyPrime(10) {
+			continue
+		}
+		isSquare := make([]bool, n)
+
+		// test all the squares
+		for x := 1; x < n; x++ {
+			elt.SetInt64(int64(x))
+			if !testModSqrt(t, &elt, &mod, &sq, &sqrt) {
+				t.Errorf("#%d: failed (sqrt(%d,%d) = %s)", x, &elt, &mod, &sqrt)
+			}
+			isSquare[sq.Uint64()] = true
+		}
+
+		// test all non-squares
+		for x := 1; x < n; x++ {
+			sq.SetInt64(int64(x))
+			z := sqrt.ModSqrt(&sq, &mod)
+			if !isSquare[x] && z != nil {
+				t.Errorf("#%d: failed (sqrt(%d,%d) = nil)", x, &sqrt, &mod)
+			}
+		}
+	}
+}
+
 var encodingTests = []string{
 	"-539345864568634858364538753846587364875430589374589",
 	"-678645873",
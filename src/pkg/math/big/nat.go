@@ -7,16 +7,21 @@
 //
 //	- Int	signed integers
 //	- Rat	rational numbers
+//	- Float	floating-point numbers
 //
 // Methods are typically of the form:
 //
-//	func (z *Int) Op(x, y *Int) *Int	(similar for *Rat)
+//	func (z *Int) Op(x, y *Int) *Int	(similar for *Rat and *Float)
 //
 // and implement operations z = x Op y with the result as receiver; if it
 // is one of the operands it may be overwritten (and its memory reused).
 // To enable chaining of operations, the result is also returned. Methods
-// returning a result other than *Int or *Rat take one of the operands as
-// the receiver.
+// returning a result other than *Int, *Rat, or *Float take one of the
+// operands as the receiver.
+//
+// Float values have a configurable mantissa precision and rounding mode;
+// the result of an operation is rounded according to the precision and
+// rounding mode of the result operand.
 //
 package big
 
@@ -337,6 +342,94 @@ func karatsuba(z, x, y nat) {
 	}
 }
 
+// Operands that are at least toom3Threshold words long (and of
+// comparable length) are multiplied using the Toom-Cook 3-way algorithm.
+var toom3Threshold int = 100 // computed by calibrate_test.go
+
+// toom3 returns the product of x and y using Toom-3 multiplication.
+// It requires len(x) >= len(y) and 2*len(y) > len(x). The result
+// uses z as storage if possible; z must not alias x or y.
+func (z nat) toom3(x, y nat) nat {
+	// Toom-3 multiplication splits x and y into 3 "digits" each,
+	//
+	//   x = x2*b*b + x1*b + x0
+	//   y = y2*b*b + y1*b + y0
+	//
+	// and views them as polynomials x(t), y(t) of degree 2 in t = b.
+	// Their product r(t) = x(t)*y(t) has degree 4 and is determined
+	// by its values at the 5 points 0, 1, -1, -2, and ∞, each of which
+	// requires only one (recursive) product of numbers of about 1/3 of
+	// the original length. The evaluation and interpolation sequences
+	// follow M. Bodrato, "Towards Optimal Toom-Cook Multiplication for
+	// Univariate and Multivariate Polynomials in Characteristic 2 and 0".
+	k := (len(x) + 2) / 3
+	xv := toom3Eval(x, k)
+	yv := toom3Eval(y, k)
+
+	var r [5]Int
+	for i := range r {
+		r[i].Mul(&xv[i], &yv[i])
+	}
+	// r = [r(0), r(1), r(-1), r(-2), r(∞)]
+
+	// interpolate
+	var r1, r2, r3, t Int
+	r3.Sub(&r[3], &r[1])
+	r3.abs, _ = r3.abs.divW(r3.abs, 3) // exact division
+	r1.Sub(&r[1], &r[2])
+	r1.Rsh(&r1, 1) // exact division
+	r2.Sub(&r[2], &r[0])
+	r3.Sub(&r2, &r3)
+	r3.Rsh(&r3, 1) // exact division
+	r3.Add(&r3, t.Lsh(&r[4], 1))
+	r2.Add(&r2, &r1)
+	r2.Sub(&r2, &r[4])
+	r1.Sub(&r1, &r3)
+
+	// The coefficients r(0), r1, r2, r3, r(∞) of the product are all
+	// non-negative; add them up at their respective word offsets.
+	z = z.make(len(x) + len(y))
+	z.clear()
+	addAt(z, r[0].abs, 0)
+	addAt(z, r1.abs, k)
+	addAt(z, r2.abs, 2*k)
+	addAt(z, r3.abs, 3*k)
+	addAt(z, r[4].abs, 4*k)
+	return z.norm()
+}
+
+// toom3Eval splits x into the 3 digits x0, x1, x2 of k words each
+// (the last one may be shorter or empty) and returns the values of
+// x(t) = x2*t*t + x1*t + x0 for t = 0, 1, -1, -2, and ∞.
+func toom3Eval(x nat, k int) (v [5]Int) {
+	x0 := &Int{abs: wordRange(x, 0, k)}
+	x1 := &Int{abs: wordRange(x, k, 2*k)}
+	x2 := &Int{abs: wordRange(x, 2*k, len(x))}
+
+	var p Int
+	p.Add(x0, x2)       // p = x0 + x2
+	v[0].Set(x0)        // x(0) = x0
+	v[1].Add(&p, x1)    // x(1) = x0 + x1 + x2
+	v[2].Sub(&p, x1)    // x(-1) = x0 - x1 + x2
+	v[3].Add(&v[2], x2) // x(-2) = 2*(x(-1) + x2) - x0
+	v[3].Lsh(&v[3], 1)
+	v[3].Sub(&v[3], x0)
+	v[4].Set(x2) // x(∞) = x2
+	return
+}
+
+// wordRange returns x[i:j] normalized; j is clipped to len(x).
+// If i >= j, the result is empty.
+func wordRange(x nat, i, j int) nat {
+	if j > len(x) {
+		j = len(x)
+	}
+	if i >= j {
+		return nil
+	}
+	return x[i:j].norm()
+}
+
 // alias returns true if x and y share the same base array.
 func alias(x, y nat) bool {
 	return cap(x) > 0 && cap(y) > 0 && &x[0:cap(x)][cap(x)-1] == &y[0:cap(y)][cap(y)-1]
@@ -403,6 +496,23 @@ func (z nat) mul(x, y nat) nat {
 	}
 	// m >= n && n >= karatsubaThreshold && n >= 2
 
+	// use Toom-3 multiplication if the numbers are large
+	if n >= toom3Threshold {
+		if 2*n > m {
+			return z.toom3(x, y)
+		}
+		// x is much longer than y: multiply y with
+		// n-word chunks of x and add up the products
+		z = z.make(m + n)
+		z.clear()
+		var t nat
+		for i := 0; i < m; i += n {
+			t = t.mul(wordRange(x, i, i+n), y)
+			addAt(z, t, i)
+		}
+		return z.norm()
+	}
+
 	// determine Karatsuba length k such that
 	//
 	//   x = xh*b + x0  (0 <= x0 < b)
@@ -516,6 +626,11 @@ func (z nat) div(z2, u, v nat) (q, r nat) {
 		return
 	}
 
+	if len(v) >= divRecursiveThreshold && len(u)-len(v) >= divRecursiveThreshold {
+		q, r = z.divRecursive(z2, u, v)
+		return
+	}
+
 	q, r = z.divLarge(z2, u, v)
 	return
 }
@@ -598,6 +713,119 @@ func (z nat) divLarge(u, uIn, v nat) (q, r nat) {
 	return q, r
 }
 
+// Divisions where both the divisor and the quotient are at least
+// divRecursiveThreshold words long use recursive division; otherwise
+// the "schoolbook" Algorithm D is used.
+var divRecursiveThreshold int = 40 // computed by calibrate_test.go
+
+// q = (u-r)/v, with 0 <= r < v
+// Uses z as storage for q, and z2 as storage for r if possible.
+// divRecursive implements the recursive division algorithm described in
+// C. Burnikel and J. Ziegler, "Fast Recursive Division", MPI-I-98-1-022,
+// which runs in time O(M(n) log n) where M(n) is the time to multiply
+// two n-word numbers.
+// Preconditions:
+//    len(v) >= 2
+//    u >= v
+func (z nat) divRecursive(z2, u, v nat) (q, r nat) {
+	// D1: normalize v such that its most significant bit is set,
+	// and shift u by the same amount
+	shift := leadingZeros(v[len(v)-1])
+	b := nat(nil).shl(v, shift)
+	a := nat(nil).shl(u, shift)
+	n := len(b)
+
+	// Divide a by b one base-B**n "digit" of a at a time
+	// (with B = 1<<_W), starting with the most significant
+	// digit. Each step is a 2n-by-n word division with a
+	// remainder < b.
+	k := (len(a) + n - 1) / n
+	qq := make(nat, k*n)
+	var rr nat
+	for i := k - 1; i >= 0; i-- {
+		// t = rr*B**n + a[i*n:(i+1)*n] < b*B**n
+		t := make(nat, n+len(rr))
+		copy(t, wordRange(a, i*n, (i+1)*n))
+		copy(t[n:], rr)
+		var qd nat
+		qd, rr = div2n1n(t.norm(), b, n)
+		copy(qq[i*n:], qd)
+	}
+
+	if alias(z, u) || alias(z, v) {
+		z = nil
+	}
+	q = z.set(qq.norm())
+	if alias(z2, u) || alias(z2, v) || alias(z2, q) {
+		z2 = nil
+	}
+	r = z2.shr(rr, shift)
+	return
+}
+
+// div2n1n returns the quotient and remainder of a/b, where b has
+// exactly n words with the most significant bit set, and a < b*B**n.
+func div2n1n(a, b nat, n int) (q, r nat) {
+	if n < divRecursiveThreshold {
+		return nat(nil).div(nil, a, b)
+	}
+
+	// ensure n is even by multiplying a and b with B
+	pad := n&1 != 0
+	if pad {
+		a = nat(nil).shl(a, _W)
+		b = nat(nil).shl(b, _W)
+		n++
+	}
+
+	// split b into the two n/2-word digits b1, b2, and
+	// divide a = [a1 a2 a3 a4] by b in two 3-by-2 steps
+	h := n >> 1
+	b1, b2 := b[h:], wordRange(b, 0, h)
+	q1, r := div3n2n(wordRange(a, n, len(a)), wordRange(a, h, n), b, b1, b2, h)
+	q2, r := div3n2n(r, wordRange(a, 0, h), b, b1, b2, h)
+	q = make(nat, h+len(q1))
+	copy(q, q2)
+	copy(q[h:], q1)
+	q = q.norm()
+
+	if pad {
+		r = r.shr(r, _W)
+	}
+	return
+}
+
+// div3n2n returns the quotient and remainder of (a12*B**n + a3)/b,
+// where b = b1*B**n + b2 has 2n words with the most significant bit
+// set, a3 < B**n, and a12 < b*B**n.
+func div3n2n(a12, a3, b, b1, b2 nat, n int) (q, r nat) {
+	if a1 := wordRange(a12, n, len(a12)); a1.cmp(b1) == 0 {
+		// the quotient estimate a12/b1 would be >= B**n;
+		// use q = B**n - 1, r = a12 - q*b1 = a12 - b1*B**n + b1
+		q = make(nat, n)
+		for i := range q {
+			q[i] = _M
+		}
+		r = nat(nil).add(wordRange(a12, 0, n), b1)
+	} else {
+		q, r = div2n1n(a12, b1, n)
+	}
+
+	// The estimate q may be too large by at most 2; correct it
+	// such that t - q*b2 >= 0, with t = r*B**n + a3.
+	t := make(nat, n+len(r))
+	copy(t, a3)
+	copy(t[n:], r)
+	t = t.norm()
+	p := nat(nil).mul(q, b2)
+	for t.cmp(p) < 0 {
+		q = q.sub(q, natOne)
+		t = t.add(t, b)
+	}
+	r = t.sub(t, p)
+	return
+}
+
 // Length of x in bits. x must be normalized.
 func (x nat) bitLen() int {
 	if i := len(x) - 1; i >= 0 {
@@ -1105,6 +1333,28 @@ func (z nat) bit(i uint) uint {
 	return uint(z[j] >> (i % _W) & 1)
 }
 
+// sticky returns 1 if there's a 1 bit within the
+// i least significant bits, otherwise it returns 0.
+func (x nat) sticky(i uint) uint {
+	j := i / _W
+	if j >= uint(len(x)) {
+		if len(x) == 0 {
+			return 0
+		}
+		return 1
+	}
+	// 0 <= j < len(x)
+	for _, x := range x[:j] {
+		if x != 0 {
+			return 1
+		}
+	}
+	if x[j]<<(_W-i%_W) != 0 {
+		return 1
+	}
+	return 0
+}
+
 func (z nat) and(x, y nat) nat {
 	m := len(x)
 	n := len(y)
@@ -1374,89 +1624,6 @@ func (z nat) expNNWindowed(x, y, m nat) nat {
 	return z.norm()
 }
 
-// probablyPrime performs reps Miller-Rabin tests to check whether n is prime.
-// If it returns true, n is prime with probability 1 - 1/4^reps.
-// If it returns false, n is not prime.
-func (n nat) probablyPrime(reps int) bool {
-	if len(n) == 0 {
-		return false
-	}
-
-	if len(n) == 1 {
-		if n[0] < 2 {
-			return false
-		}
-
-		if n[0]%2 == 0 {
-			return n[0] == 2
-		}
-
-		// We have to exclude these cases because we reject all
-		// multiples of these numbers below.
-		switch n[0] {
-		case 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53:
-			return true
-		}
-	}
-
-	const primesProduct32 = 0xC0CFD797         // Π {p ∈ primes, 2 < p <= 29}
-	const primesProduct64 = 0xE221F97C30E94E1D // Π {p ∈ primes, 2 < p <= 53}
-
-	var r Word
-	switch _W {
-	case 32:
-		r = n.modW(primesProduct32)
-	case 64:
-		r = n.modW(primesProduct64 & _M)
-	default:
-		panic("Unknown word size")
-	}
-
-	if r%3 == 0 || r%5 == 0 || r%7 == 0 || r%11 == 0 ||
-		r%13 == 0 || r%17 == 0 || r%19 == 0 || r%23 == 0 || r%29 == 0 {
-		return false
-	}
-
-	if _W == 64 && (r%31 == 0 || r%37 == 0 || r%41 == 0 ||
-		r%43 == 0 || r%47 == 0 || r%53 == 0) {
-		return false
-	}
-
-	nm1 := nat(nil).sub(n, natOne)
-	// determine q, k such that nm1 = q << k
-	k := nm1.trailingZeroBits()
-	q := nat(nil).shr(nm1, k)
-
-	nm3 := nat(nil).sub(nm1, natTwo)
-	rand := rand.New(rand.NewSource(int64(n[0])))
-
-	var x, y, quotient nat
-	nm3Len := nm3.bitLen()
-
-NextRandom:
-	for i := 0; i < reps; i++ {
-		x = x.random(rand, nm3, nm3Len)
-		x = x.add(x, natTwo)
-		y = y.expNN(x, q, n)
-		if y.cmp(natOne) == 0 || y.cmp(nm1) == 0 {
-			continue
-		}
-		for j := uint(1); j < k; j++ {
-			y = y.mul(y, y)
-			quotient, y = quotient.div(y, y, n)
-			if y.cmp(nm1) == 0 {
-				continue NextRandom
-			}
-			if y.cmp(natOne) == 0 {
-				return false
-			}
-		}
-		return false
-	}
-
-	return true
-}
-
 // bytes writes the value of z into buf using big-endian encoding.
 // len(buf) must be >= len(z)*_S. The value of z is encoded in the
 // slice buf[i:]. The number i of unused bytes at the beginning of
@@ -1501,3 +1668,36 @@ func (z nat) setBytes(buf []byte) nat {
 
 	return z.norm()
 }
+
+// sqrt sets z = ⌊√x⌋
+func (z nat) sqrt(x nat) nat {
+	if x.cmp(natOne) <= 0 {
+		return z.set(x)
+	}
+	if alias(z, x) {
+		z = nil
+	}
+
+	// Start with value known to be too large and repeat "z = ⌊(z + ⌊x/z⌋)/2⌋" until it stops getting smaller.
+	// See Brent and Zimmermann, Modern Computer Arithmetic, Algorithm 1.13 (SqrtInt).
+	// If x is one less than a perfect square, the sequence oscillates between the correct z and z+1;
+	// otherwise it converges to the correct z and stays there.
+	var z1, z2 nat
+	z1 = z
+	z1 = z1.setUint64(1)
+	z1 = z1.shl(z1, uint(x.bitLen()/2+1)) // must be ≥ √x
+	for n := 0; ; n++ {
+		z2, _ = z2.div(nil, x, z1)
+		z2 = z2.add(z2, z1)
+		z2 = z2.shr(z2, 1)
+		if z2.cmp(z1) >= 0 {
+			// z1 is answer.
+			// Figure out whether z1 or z2 is currently aliased to z by looking at loop count.
+			if n&1 == 0 {
+				return z1
+			}
+			return z.set(z1)
+		}
+		z1, z2 = z2, z1
+	}
+}
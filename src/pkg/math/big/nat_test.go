@@ -6,6 +6,7 @@ package big
 
 import (
 	"io"
+	"math/rand"
 	"runtime"
 	"strings"
 	"testing"
@@ -196,6 +197,56 @@ func rndNat(n int) nat {
 	return nat(rndV(n)).norm()
 }
 
+// TestMulAlgorithms checks that Toom-3 and Karatsuba multiplication
+// agree with basic multiplication for a variety of operand sizes.
+func TestMulAlgorithms(t *testing.T) {
+	defer func(k, t3 int) {
+		karatsubaThreshold, toom3Threshold = k, t3
+	}(karatsubaThreshold, toom3Threshold)
+
+	for _, n := range []int{1, 10, 39, 40, 41, 100, 101, 257, 500} {
+		for _, m := range []int{n, n/2 + 1, 3 * n} {
+			x := rndNat(m)
+			y := rndNat(n)
+
+			karatsubaThreshold, toom3Threshold = 1e9, 1e9
+			want := nat(nil).mul(x, y)
+
+			karatsubaThreshold, toom3Threshold = 8, 1e9
+			if got := nat(nil).mul(x, y); got.cmp(want) != 0 {
+				t.Errorf("Karatsuba: %d x %d words: wrong product", m, n)
+			}
+
+			karatsubaThreshold, toom3Threshold = 8, 12
+			if got := nat(nil).mul(x, y); got.cmp(want) != 0 {
+				t.Errorf("Toom-3: %d x %d words: wrong product", m, n)
+			}
+		}
+	}
+}
+
+// TestDivRecursive checks that recursive division agrees
+// with schoolbook division.
+func TestDivRecursive(t *testing.T) {
+	defer func(d int) { divRecursiveThreshold = d }(divRecursiveThreshold)
+
+	for _, n := range []int{4, 10, 33, 100, 250} {
+		for _, m := range []int{n + 1, 2 * n, 3*n + 7} {
+			u := rndNat(m)
+			v := rndNat(n)
+
+			divRecursiveThreshold = 1e9
+			q0, r0 := nat(nil).div(nil, u, v)
+
+			divRecursiveThreshold = 2
+			q1, r1 := nat(nil).div(nil, u, v)
+			if q1.cmp(q0) != 0 || r1.cmp(r0) != 0 {
+				t.Errorf("%d / %d words: got different quotient or remainder", m, n)
+			}
+		}
+	}
+}
+
 func BenchmarkMul(b *testing.B) {
 	mulx := rndNat(1e4)
 	muly := rndNat(1e4)
@@ -206,6 +257,16 @@ func BenchmarkMul(b *testing.B) {
 	}
 }
 
+func BenchmarkDiv(b *testing.B) {
+	divx := rndNat(2e4)
+	divy := rndNat(1e4)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var q, r nat
+		q.div(r, divx, divy)
+	}
+}
+
 func toString(x nat, charset string) string {
 	base := len(charset)
 
@@ -772,3 +833,75 @@ func BenchmarkExp3Power0x10000(b *testing.B)  { ExpHelper(b, 3, 0x10000) }
 func BenchmarkExp3Power0x40000(b *testing.B)  { ExpHelper(b, 3, 0x40000) }
 func BenchmarkExp3Power0x100000(b *testing.B) { ExpHelper(b, 3, 0x100000) }
 func BenchmarkExp3Power0x400000(b *testing.B) { ExpHelper(b, 3, 0x400000) }
+
+var stickyTests = []struct {
+	x    string
+	i    uint
+	want uint
+}{
+	{"0", 0, 0},
+	{"0", 1, 0},
+	{"0", 1000, 0},
+
+	{"0x1", 0, 0},
+	{"0x1", 1, 1},
+
+	{"0x1350", 0, 0},
+	{"0x1350", 4, 0},
+	{"0x1350", 5, 1},
+
+	{"0x8000000000000000", 63, 0},
+	{"0x8000000000000000", 64, 1},
+
+	{"0x1" + strings.Repeat("0", 100), 400, 0},
+	{"0x1" + strings.Repeat("0", 100), 401, 1},
+}
+
+func TestSticky(t *testing.T) {
+	for i, test := range stickyTests {
+		x, _, _ := nat(nil).scan(strings.NewReader(test.x), 0)
+		if sticky := x.sticky(test.i); sticky != test.want {
+			t.Errorf("#%da: %s.sticky(%d) = %v; want %v", i, test.x, test.i, sticky, test.want)
+		}
+		if test.want == 1 {
+			// all subsequent i's should also return 1
+			for d := uint(1); d <= 3; d++ {
+				if sticky := x.sticky(test.i + d); sticky != 1 {
+					t.Errorf("#%db: %s.sticky(%d) = %v; want %v", i, test.x, test.i+d, sticky, 1)
+				}
+			}
+		}
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	// small values, checked against the definition
+	for n := Word(0); n <= 1000; n++ {
+		x := nat(nil).setWord(n)
+		r := nat(nil).sqrt(x)
+		r2 := nat(nil).mul(r, r)
+		r1 := nat(nil).add(r, natOne)
+		r12 := nat(nil).mul(r1, r1)
+		if r2.cmp(x) > 0 || r12.cmp(x) <= 0 {
+			t.Errorf("sqrt(%d) = %s", n, r.decimalString())
+		}
+	}
+
+	// large values: sqrt(r*r + d) == r for 0 <= d <= 2r
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 100; i++ {
+		limit := nat(nil).shl(natOne, uint(r.Intn(1000)+1))
+		root := nat(nil).random(r, limit, limit.bitLen())
+		sq := nat(nil).mul(root, root)
+		for _, d := range []nat{nil, natOne, nat(nil).shl(root, 1)} {
+			x := nat(nil).add(sq, d)
+			if got := nat(nil).sqrt(x); got.cmp(root) != 0 {
+				t.Fatalf("sqrt(%s) = %s; want %s", x.decimalString(), got.decimalString(), root.decimalString())
+			}
+			// aliased operand
+			if got := x.sqrt(x); got.cmp(root) != 0 {
+				t.Fatalf("sqrt (aliased) = %s; want %s", got.decimalString(), root.decimalString())
+			}
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package big
+
+import (
+	"strings"
+	"testing"
+)
+
+// mersennes are the exponents of some Mersenne primes 2**p - 1.
+var mersennes = []uint{2, 3, 5, 7, 13, 17, 19, 31, 61, 89, 107, 127, 521, 607, 1279}
+
+// Composites that pass the Miller-Rabin test with base 2
+// (strong pseudoprimes to base 2; OEIS A001262).
+var mrPseudoprimes = []uint64{
+	2047, 3277, 4033, 4681, 8321, 15841, 29341, 42799, 49141, 52633, 65281, 74665, 80581, 85489, 88357, 90751,
+	3215031751,
+}
+
+// Composites that pass the extra strong Lucas test
+// (extra strong Lucas pseudoprimes; OEIS A217719).
+var lucasPseudoprimes = []uint64{
+	989, 3239, 5777, 10877, 27971, 29681, 30739, 31631, 39059, 72389, 73919, 75077,
+}
+
+func TestProbablyPrimeSmall(t *testing.T) {
+	// compare against a sieve of Eratosthenes
+	n := 100000
+	if testing.Short() {
+		n = 10000
+	}
+	composite := make([]bool, n)
+	for i := 2; i*i < n; i++ {
+		if !composite[i] {
+			for j := i * i; j < n; j += i {
+				composite[j] = true
+			}
+		}
+	}
+	var x Int
+	for i := 0; i < n; i++ {
+		want := i >= 2 && !composite[i]
+		if got := x.SetInt64(int64(i)).ProbablyPrime(0); got != want {
+			t.Errorf("%d.ProbablyPrime(0) = %v; want %v", i, got, want)
+		}
+	}
+}
+
+func TestProbablyPrimeMersenne(t *testing.T) {
+	var x Int
+	for _, p := range mersennes {
+		x.Sub(x.Lsh(intOne, p), intOne)
+		if !x.ProbablyPrime(0) {
+			t.Errorf("2**%d - 1 found to be non-prime", p)
+		}
+	}
+
+	// 2**p - 1 for prime p that are not Mersenne exponents
+	for _, p := range []uint{11, 23, 29, 37, 523} {
+		x.Sub(x.Lsh(intOne, p), intOne)
+		if x.ProbablyPrime(0) {
+			t.Errorf("2**%d - 1 found to be prime", p)
+		}
+	}
+}
+
+func TestProbablyPrimePseudoprimes(t *testing.T) {
+	var x Int
+	for _, n := range mrPseudoprimes {
+		x.SetUint64(n)
+		if !x.abs.probablyPrimeMillerRabin(1, true) {
+			t.Errorf("%d: Miller-Rabin (base 2) = false; want true (test data incorrect?)", n)
+		}
+		if x.ProbablyPrime(0) {
+			t.Errorf("%d: Baillie-PSW test found composite to be prime", n)
+		}
+	}
+	for _, n := range lucasPseudoprimes {
+		x.SetUint64(n)
+		if !x.abs.probablyPrimeLucas() {
+			t.Errorf("%d: Lucas test = false; want true (test data incorrect?)", n)
+		}
+		if x.ProbablyPrime(0) {
+			t.Errorf("%d: Baillie-PSW test found composite to be prime", n)
+		}
+	}
+}
+
+func TestProbablyPrimeLarge(t *testing.T) {
+	for i, s := range primes {
+		p, _ := new(Int).SetString(s, 10)
+		if !p.ProbablyPrime(0) || !p.abs.probablyPrimeLucas() {
+			t.Errorf("#%d prime found to be non-prime (%s)", i, s)
+		}
+	}
+	for i, s := range composites {
+		c, _ := new(Int).SetString(s, 10)
+		if c.ProbablyPrime(0) {
+			t.Errorf("#%d composite found to be prime (%s)", i, s)
+		}
+	}
+
+	// The product of two large primes is not prime,
+	// nor is the square of a prime.
+	p, _ := new(Int).SetString(primes[len(primes)-1], 10)
+	q, _ := new(Int).SetString(primes[len(primes)-2], 10)
+	if new(Int).Mul(p, q).ProbablyPrime(0) {
+		t.Errorf("product of primes found to be prime")
+	}
+	if new(Int).Mul(p, p).ProbablyPrime(0) {
+		t.Errorf("square of prime found to be prime")
+	}
+}
+
+func TestProbablyPrimeNegative(t *testing.T) {
+	if NewInt(-7).ProbablyPrime(10) {
+		t.Errorf("-7 found to be prime")
+	}
+	defer func() {
+		if p := recover(); p == nil || !strings.Contains(p.(string), "negative") {
+			t.Errorf("got %v; want panic for negative n", p)
+		}
+	}()
+	NewInt(7).ProbablyPrime(-1)
+}
+
+var benchPrime, _ = new(Int).SetString("203956878356401977405765866929034577280193993314348263094772646453283062722701277632936616063144088173312372882677123879538709400158306567338328279154499698366071906766440037074217117805690872792848149112022286332144876183376326512083574821647933992961249917319836219304274280243803104015000563790123", 10)
+
+func benchmarkProbablyPrime(b *testing.B, n int) {
+	for i := 0; i < b.N; i++ {
+		benchPrime.ProbablyPrime(n)
+	}
+}
+
+func BenchmarkProbablyPrime0(b *testing.B)  { benchmarkProbablyPrime(b, 0) }
+func BenchmarkProbablyPrime1(b *testing.B)  { benchmarkProbablyPrime(b, 1) }
+func BenchmarkProbablyPrime10(b *testing.B) { benchmarkProbablyPrime(b, 10) }
+func BenchmarkProbablyPrime20(b *testing.B) { benchmarkProbablyPrime(b, 20) }
@@ -270,6 +270,7 @@ func (c *Client) doFollowingRedirects(ireq *Request, shouldRedirect func(int) bo
 				req.Method = "GET"
 			}
 			req.Header = make(Header)
+			req.Trace = ireq.Trace
 			req.URL, err = base.Parse(urlStr)
 			if err != nil {
 				break
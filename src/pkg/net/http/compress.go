@@ -0,0 +1,431 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Response compression.
+
+package http
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// DefaultCompressMinSize is the default value of Compressor.MinSize.
+const DefaultCompressMinSize = 1024
+
+// defaultCompressTypes is the default value of Compressor.ContentTypes.
+var defaultCompressTypes = []string{
+	"text/*",
+	"application/javascript",
+	"application/json",
+	"application/x-javascript",
+	"application/xml",
+	"application/xhtml+xml",
+	"application/rss+xml",
+	"application/atom+xml",
+	"image/svg+xml",
+}
+
+// A Compressor is a Handler that compresses the responses of another
+// Handler with gzip or deflate, when the request's Accept-Encoding
+// header allows it.
+//
+// Only responses whose Content-Type is listed in ContentTypes and
+// whose body is at least MinSize bytes long are compressed. Responses
+// that already have a Content-Encoding, partial content responses
+// (with a Content-Range header, as sent by ServeContent for range
+// requests), responses marked "Cache-Control: no-transform" and
+// responses to HEAD requests are passed through unchanged. Every
+// response that could have been compressed gets a "Vary:
+// Accept-Encoding" header.
+//
+// The ResponseWriter given to Handler implements Flusher, and also
+// Hijacker and CloseNotifier if the server's ResponseWriter does.
+// Flushing sends the data compressed so far, so that streamed
+// responses are compressed too.
+type Compressor struct {
+	// Handler is the handler whose responses are compressed.
+	Handler Handler
+
+	// MinSize is the size in bytes below which a response body
+	// is sent uncompressed. If zero, DefaultCompressMinSize is
+	// used. The body is held back until MinSize bytes have been
+	// written, the handler returns or the handler flushes,
+	// unless it sets a Content-Length header.
+	MinSize int
+
+	// ContentTypes lists the media types to compress. An entry
+	// of the form "type/*" matches all subtypes. If nil, common
+	// text types are compressed, such as "text/*",
+	// "application/json" and "image/svg+xml".
+	ContentTypes []string
+
+	// Level is the compression level, as defined by package
+	// compress/flate. If zero or not a valid level,
+	// flate.DefaultCompression is used.
+	Level int
+}
+
+// CompressHandler returns a handler that compresses the responses of
+// h as described for Compressor, using its default settings.
+func CompressHandler(h Handler) Handler {
+	return &Compressor{Handler: h}
+}
+
+func (c *Compressor) ServeHTTP(w ResponseWriter, r *Request) {
+	cw := &compressWriter{
+		c:        c,
+		w:        w,
+		head:     r.Method == "HEAD",
+		encoding: negotiateEncoding(r.Header["Accept-Encoding"]),
+	}
+	defer cw.close()
+	c.Handler.ServeHTTP(cw.wrap(), r)
+}
+
+func (c *Compressor) minSize() int {
+	if c.MinSize > 0 {
+		return c.MinSize
+	}
+	return DefaultCompressMinSize
+}
+
+func (c *Compressor) level() int {
+	if c.Level != 0 && c.Level >= flate.DefaultCompression && c.Level <= flate.BestCompression {
+		return c.Level
+	}
+	return flate.DefaultCompression
+}
+
+// compressible reports whether responses of media type ct should be
+// compressed.
+func (c *Compressor) compressible(ct string) bool {
+	if i := strings.IndexByte(ct, ';'); i >= 0 {
+		ct = ct[:i]
+	}
+	ct = strings.ToLower(strings.TrimSpace(ct))
+	types := c.ContentTypes
+	if types == nil {
+		types = defaultCompressTypes
+	}
+	for _, t := range types {
+		if strings.HasSuffix(t, "/*") && strings.HasPrefix(ct, t[:len(t)-1]) || t == ct {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateEncoding returns the content coding to use for a client
+// that sent the given Accept-Encoding header values: "gzip",
+// "deflate" or "" for none. The coding with the highest q-value is
+// chosen, preferring gzip on a tie.
+func negotiateEncoding(accept []string) string {
+	var gzipQ, deflateQ, starQ float64 = -1, -1, -1
+	for _, v := range accept {
+		foreachHeaderElement(v, func(e string) {
+			name, q := e, 1.0
+			if i := strings.IndexByte(e, ';'); i >= 0 {
+				name = e[:i]
+				q = parseQValue(e[i+1:])
+			}
+			switch strings.ToLower(strings.TrimSpace(name)) {
+			case "gzip", "x-gzip":
+				gzipQ = q
+			case "deflate":
+				deflateQ = q
+			case "*":
+				starQ = q
+			}
+		})
+	}
+	// A coding not listed gets the q-value of "*", if present.
+	if gzipQ < 0 {
+		gzipQ = starQ
+	}
+	if deflateQ < 0 {
+		deflateQ = starQ
+	}
+	switch {
+	case gzipQ > 0 && gzipQ >= deflateQ:
+		return "gzip"
+	case deflateQ > 0:
+		return "deflate"
+	}
+	return ""
+}
+
+// parseQValue returns the q-value in the parameters of an
+// Accept-Encoding element, such as "q=0.5", or 1 if there's none.
+// An invalid q-value is treated as 0.
+func parseQValue(params string) float64 {
+	for _, p := range strings.Split(params, ";") {
+		p = strings.TrimSpace(p)
+		if !strings.HasPrefix(p, "q=") && !strings.HasPrefix(p, "Q=") {
+			continue
+		}
+		q, err := strconv.ParseFloat(strings.TrimSpace(p[2:]), 64)
+		if err != nil || q < 0 || q > 1 {
+			return 0
+		}
+		return q
+	}
+	return 1
+}
+
+// compressWriter is the ResponseWriter a Compressor passes to its
+// Handler. It holds the start of the body back until it can decide
+// whether to compress.
+type compressWriter struct {
+	c        *Compressor
+	w        ResponseWriter
+	head     bool   // request is HEAD
+	encoding string // negotiated coding, or "" if the client accepts none
+
+	code    int    // status passed to WriteHeader, or 0
+	buf     []byte // body held back until decided
+	decided bool
+	zw      compressFlusher // non-nil if compressing
+}
+
+// A compressFlusher is a gzip or zlib Writer.
+type compressFlusher interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// newCompressFlusher returns a writer that compresses to w with the
+// given content coding, "gzip" or "deflate". The deflate coding is
+// the zlib format, not raw DEFLATE data (RFC 7230, section 4.2.2).
+func newCompressFlusher(w io.Writer, encoding string, level int) (compressFlusher, error) {
+	if encoding == "gzip" {
+		zw, err := gzip.NewWriterLevel(w, level)
+		if err != nil {
+			return nil, err
+		}
+		return zw, nil
+	}
+	zw, err := zlib.NewWriterLevel(w, level)
+	if err != nil {
+		return nil, err
+	}
+	return zw, nil
+}
+
+// wrap returns cw as a ResponseWriter that implements the same
+// optional interfaces as the server's ResponseWriter.
+func (cw *compressWriter) wrap() ResponseWriter {
+	hj, isHijacker := cw.w.(Hijacker)
+	cn, isCloseNotifier := cw.w.(CloseNotifier)
+	switch {
+	case isHijacker && isCloseNotifier:
+		return struct {
+			*compressWriter
+			Hijacker
+			CloseNotifier
+		}{cw, compressHijacker{cw, hj}, cn}
+	case isHijacker:
+		return struct {
+			*compressWriter
+			Hijacker
+		}{cw, compressHijacker{cw, hj}}
+	case isCloseNotifier:
+		return struct {
+			*compressWriter
+			CloseNotifier
+		}{cw, cn}
+	}
+	return cw
+}
+
+// compressHijacker stops cw from writing anything more once the
+// connection has been hijacked.
+type compressHijacker struct {
+	cw *compressWriter
+	hj Hijacker
+}
+
+func (h compressHijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	c, rw, err := h.hj.Hijack()
+	if err == nil {
+		h.cw.decided = true
+		h.cw.buf = nil
+		h.cw.zw = nil
+	}
+	return c, rw, err
+}
+
+func (cw *compressWriter) Header() Header {
+	return cw.w.Header()
+}
+
+func (cw *compressWriter) WriteHeader(code int) {
+	if cw.decided {
+		cw.w.WriteHeader(code) // let the server complain
+		return
+	}
+	if cw.code != 0 {
+		// The header is held back until decide, so complain
+		// like the server would and keep the first status.
+		log.Print("http: multiple response.WriteHeader calls")
+		return
+	}
+	cw.code = code
+	if !cw.eligible(false) {
+		cw.decide(false)
+		return
+	}
+	// A Content-Length settles the size question right away.
+	if cl := cw.w.Header().get("Content-Length"); cl != "" {
+		n, err := strconv.ParseInt(cl, 10, 64)
+		cw.decide(err == nil && n >= int64(cw.c.minSize()))
+	}
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	if !cw.decided {
+		if cw.code == 0 {
+			cw.WriteHeader(StatusOK)
+		}
+		if !cw.decided {
+			cw.buf = append(cw.buf, p...)
+			if len(cw.buf) < cw.c.minSize() {
+				return len(p), nil
+			}
+			if err := cw.decide(true); err != nil {
+				return 0, err
+			}
+			return len(p), nil
+		}
+	}
+	if cw.zw != nil {
+		return cw.zw.Write(p)
+	}
+	return cw.w.Write(p)
+}
+
+// Flush sends any held back or compressed data to the client. A
+// response whose size isn't known yet is compressed.
+func (cw *compressWriter) Flush() {
+	if !cw.decided {
+		if cw.code == 0 {
+			cw.WriteHeader(StatusOK)
+		}
+		if !cw.decided {
+			cw.decide(true)
+		}
+	}
+	if cw.zw != nil {
+		cw.zw.Flush()
+	}
+	if f, ok := cw.w.(Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Push implements Pusher by passing the push on to the server's
+// ResponseWriter, if it supports it.
+func (cw *compressWriter) Push(target string, opts *PushOptions) error {
+	if p, ok := cw.w.(Pusher); ok {
+		return p.Push(target, opts)
+	}
+	return ErrNotSupported
+}
+
+// close finishes the response once the Handler has returned.
+func (cw *compressWriter) close() {
+	if !cw.decided {
+		if cw.code == 0 && len(cw.buf) == 0 {
+			return // leave the empty response to the server
+		}
+		if cw.code == 0 {
+			cw.code = StatusOK
+		}
+		cw.decide(cw.eligible(true) && len(cw.buf) >= cw.c.minSize())
+	}
+	if cw.zw != nil {
+		cw.zw.Close()
+	}
+}
+
+// eligible reports whether the response could be compressed, based
+// on its status and header. If sniff is set and no Content-Type was
+// set, the type is sniffed from the held back data, as the server
+// would.
+func (cw *compressWriter) eligible(sniff bool) bool {
+	h := cw.w.Header()
+	if cw.head || !bodyAllowedForStatus(cw.code) || cw.code == StatusPartialContent {
+		return false
+	}
+	if h.get("Content-Encoding") != "" || h.get("Content-Range") != "" {
+		return false
+	}
+	for _, v := range h["Cache-Control"] {
+		if strings.Contains(strings.ToLower(v), "no-transform") {
+			return false
+		}
+	}
+	ct := h.get("Content-Type")
+	if _, haveType := h["Content-Type"]; !haveType {
+		if !sniff && len(cw.buf) == 0 {
+			// Can't tell yet; decide when there's data.
+			return true
+		}
+		ct = DetectContentType(cw.buf)
+	}
+	return cw.c.compressible(ct)
+}
+
+// decide sends the header, compressing the body from now on if
+// compress is set and the response is eligible, and then writes
+// out any held back data.
+func (cw *compressWriter) decide(compress bool) error {
+	cw.decided = true
+	h := cw.w.Header()
+	if cw.eligible(true) {
+		h.Add("Vary", "Accept-Encoding")
+		var zw compressFlusher
+		if compress && cw.encoding != "" {
+			// level() only returns valid levels, but should
+			// this fail anyway, send the body uncompressed
+			// rather than announce a coding it won't have.
+			zw, _ = newCompressFlusher(cw.w, cw.encoding, cw.c.level())
+		}
+		if zw != nil {
+			if _, haveType := h["Content-Type"]; !haveType {
+				// The server can't sniff compressed data.
+				h.Set("Content-Type", DetectContentType(cw.buf))
+			}
+			h.Del("Content-Length")
+			h.Set("Content-Encoding", cw.encoding)
+			// The compressed body is a different
+			// representation, so an entity tag can only
+			// be a weak match for it.
+			if etag := h.get("Etag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+				h.Set("Etag", "W/"+etag)
+			}
+			cw.zw = zw
+		}
+	}
+	cw.w.WriteHeader(cw.code)
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if cw.zw != nil {
+		_, err = cw.zw.Write(buf)
+	} else {
+		_, err = cw.w.Write(buf)
+	}
+	return err
+}
@@ -0,0 +1,403 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	. "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var negotiateEncodingTests = []struct {
+	accept []string
+	want   string
+}{
+	{nil, ""},
+	{[]string{""}, ""},
+	{[]string{"identity"}, ""},
+	{[]string{"gzip"}, "gzip"},
+	{[]string{"x-gzip"}, "gzip"},
+	{[]string{"deflate"}, "deflate"},
+	{[]string{"gzip, deflate"}, "gzip"},
+	{[]string{"deflate, gzip"}, "gzip"},
+	{[]string{"gzip;q=0.5, deflate"}, "deflate"},
+	{[]string{"gzip; q=0.8", "deflate;q=0.9"}, "deflate"},
+	{[]string{"GZIP;Q=1"}, "gzip"},
+	{[]string{"gzip;q=0"}, ""},
+	{[]string{"gzip;q=0, deflate;q=0.1"}, "deflate"},
+	{[]string{"*"}, "gzip"},
+	{[]string{"*;q=0"}, ""},
+	{[]string{"gzip;q=0, *"}, "deflate"},
+	{[]string{"*;q=0.1, deflate"}, "deflate"},
+	{[]string{"gzip;q=bogus"}, ""},
+	{[]string{"gzip;q=2"}, ""},
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	for _, tt := range negotiateEncodingTests {
+		if got := NegotiateEncoding(tt.accept); got != tt.want {
+			t.Errorf("negotiateEncoding(%q) = %q; want %q", tt.accept, got, tt.want)
+		}
+	}
+}
+
+var compressBody = strings.Repeat("hello, compressed world\n", 100)
+
+func compressRequest(method, accept string) *Request {
+	req, _ := NewRequest(method, "http://example.com/", nil)
+	if accept != "" {
+		req.Header.Set("Accept-Encoding", accept)
+	}
+	return req
+}
+
+func decompress(t *testing.T, encoding string, body []byte) string {
+	var r io.Reader = bytes.NewReader(body)
+	switch encoding {
+	case "gzip":
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			t.Fatalf("gzip.NewReader: %v", err)
+		}
+		r = zr
+	case "deflate":
+		zr, err := zlib.NewReader(r)
+		if err != nil {
+			t.Fatalf("zlib.NewReader: %v", err)
+		}
+		r = zr
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("decompressing %s body: %v", encoding, err)
+	}
+	return string(b)
+}
+
+func TestCompressHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		accept   string
+		handler  HandlerFunc
+		encoding string // wanted Content-Encoding
+		vary     bool   // whether Vary: Accept-Encoding is wanted
+	}{
+		{
+			name:   "gzip",
+			accept: "gzip, deflate",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+				io.WriteString(w, compressBody)
+			},
+			encoding: "gzip",
+			vary:     true,
+		},
+		{
+			name:   "deflate",
+			accept: "gzip;q=0.1, deflate",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, compressBody)
+			},
+			encoding: "deflate",
+			vary:     true,
+		},
+		{
+			name:   "sniffed type",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				io.WriteString(w, "<html><body>"+compressBody+"</body></html>")
+			},
+			encoding: "gzip",
+			vary:     true,
+		},
+		{
+			name:   "many small writes",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				for _, line := range strings.SplitAfter(compressBody, "\n") {
+					io.WriteString(w, line)
+				}
+			},
+			encoding: "gzip",
+			vary:     true,
+		},
+		{
+			name: "not accepted",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				io.WriteString(w, compressBody)
+			},
+			vary: true,
+		},
+		{
+			name:   "too small",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				io.WriteString(w, "short")
+			},
+			vary: true,
+		},
+		{
+			name:   "small content length",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				w.Header().Set("Content-Length", "5")
+				w.WriteHeader(StatusOK)
+				io.WriteString(w, "short")
+			},
+			vary: true,
+		},
+		{
+			name:   "ineligible type",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "image/png")
+				io.WriteString(w, compressBody)
+			},
+		},
+		{
+			name:   "already encoded",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				w.Header().Set("Content-Encoding", "br")
+				io.WriteString(w, compressBody)
+			},
+			encoding: "br",
+		},
+		{
+			name:   "no-transform",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				w.Header().Set("Cache-Control", "public, no-transform")
+				io.WriteString(w, compressBody)
+			},
+		},
+		{
+			name:   "head",
+			method: "HEAD",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				w.Header().Set("Content-Type", "text/plain")
+			},
+		},
+		{
+			name:   "range",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				r.Header.Set("Range", "bytes=0-1999")
+				ServeContent(w, r, "foo.txt", time.Time{}, strings.NewReader(compressBody))
+			},
+		},
+		{
+			name:   "full content",
+			accept: "gzip",
+			handler: func(w ResponseWriter, r *Request) {
+				ServeContent(w, r, "foo.txt", time.Time{}, strings.NewReader(compressBody))
+			},
+			encoding: "gzip",
+			vary:     true,
+		},
+	}
+	for _, tt := range tests {
+		method := tt.method
+		if method == "" {
+			method = "GET"
+		}
+		rec := httptest.NewRecorder()
+		CompressHandler(tt.handler).ServeHTTP(rec, compressRequest(method, tt.accept))
+		h := rec.HeaderMap
+		if got := h.Get("Content-Encoding"); got != tt.encoding {
+			t.Errorf("%s: Content-Encoding = %q; want %q", tt.name, got, tt.encoding)
+			continue
+		}
+		if got := h.Get("Vary") == "Accept-Encoding"; got != tt.vary {
+			t.Errorf("%s: Vary = %q; want Accept-Encoding: %v", tt.name, h.Get("Vary"), tt.vary)
+		}
+		if tt.encoding == "gzip" || tt.encoding == "deflate" {
+			if cl := h.Get("Content-Length"); cl != "" {
+				t.Errorf("%s: Content-Length = %q on compressed response", tt.name, cl)
+			}
+			if rec.Body.Len() >= len(compressBody) {
+				t.Errorf("%s: compressed body is %d bytes; want fewer than %d", tt.name, rec.Body.Len(), len(compressBody))
+			}
+			if got := decompress(t, tt.encoding, rec.Body.Bytes()); !strings.Contains(got, compressBody) {
+				t.Errorf("%s: decompressed body doesn't contain the written data", tt.name)
+			}
+		}
+	}
+}
+
+func TestCompressHandlerETag(t *testing.T) {
+	h := CompressHandler(HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("Etag", `"abc"`)
+		io.WriteString(w, compressBody)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, compressRequest("GET", "gzip"))
+	if got, want := rec.HeaderMap.Get("Etag"), `W/"abc"`; got != want {
+		t.Errorf("compressed Etag = %q; want %q", got, want)
+	}
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, compressRequest("GET", ""))
+	if got, want := rec.HeaderMap.Get("Etag"), `"abc"`; got != want {
+		t.Errorf("uncompressed Etag = %q; want %q", got, want)
+	}
+}
+
+func TestCompressHandlerStatus(t *testing.T) {
+	h := CompressHandler(HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(StatusNotFound)
+		io.WriteString(w, compressBody)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, compressRequest("GET", "gzip"))
+	if rec.Code != StatusNotFound {
+		t.Errorf("Code = %d; want %d", rec.Code, StatusNotFound)
+	}
+	if got := decompress(t, rec.HeaderMap.Get("Content-Encoding"), rec.Body.Bytes()); got != compressBody {
+		t.Errorf("body = %q; want %q", got, compressBody)
+	}
+}
+
+// Tests that a second WriteHeader call before the Compressor has
+// decided whether to compress doesn't send the header early.
+func TestCompressHandlerDoubleWriteHeader(t *testing.T) {
+	h := CompressHandler(HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(StatusOK)
+		w.WriteHeader(StatusInternalServerError)
+		io.WriteString(w, compressBody)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, compressRequest("GET", "gzip"))
+	if rec.Code != StatusOK {
+		t.Errorf("Code = %d; want %d", rec.Code, StatusOK)
+	}
+	if got := rec.HeaderMap.Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("Content-Encoding = %q; want gzip", got)
+	}
+	if got := decompress(t, "gzip", rec.Body.Bytes()); got != compressBody {
+		t.Errorf("body = %q; want %q", got, compressBody)
+	}
+}
+
+// Tests that an invalid Level falls back to the default level
+// instead of breaking the response.
+func TestCompressHandlerLevel(t *testing.T) {
+	for _, level := range []int{-2, 10, 100} {
+		for _, accept := range []string{"gzip", "deflate"} {
+			h := &Compressor{
+				Handler: HandlerFunc(func(w ResponseWriter, r *Request) {
+					w.Header().Set("Content-Type", "text/plain")
+					io.WriteString(w, compressBody)
+				}),
+				Level: level,
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, compressRequest("GET", accept))
+			if got := rec.HeaderMap.Get("Content-Encoding"); got != accept {
+				t.Errorf("Level %d, %s: Content-Encoding = %q; want %q", level, accept, got, accept)
+				continue
+			}
+			if got := decompress(t, accept, rec.Body.Bytes()); got != compressBody {
+				t.Errorf("Level %d, %s: body = %q; want %q", level, accept, got, compressBody)
+			}
+		}
+	}
+}
+
+// Tests that a flushed response is compressed and sent before the
+// handler returns.
+func TestCompressHandlerFlush(t *testing.T) {
+	defer afterTest(t)
+	flushed := make(chan bool)
+	ts := httptest.NewServer(CompressHandler(HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		io.WriteString(w, "first")
+		w.(Flusher).Flush()
+		<-flushed
+		io.WriteString(w, " second")
+	})))
+	defer ts.Close()
+
+	req, _ := NewRequest("GET", ts.URL, nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	res, err := DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if got := res.Header.Get("Content-Encoding"); got != "gzip" {
+		close(flushed)
+		t.Fatalf("Content-Encoding = %q; want gzip", got)
+	}
+	zr, err := gzip.NewReader(res.Body)
+	if err != nil {
+		close(flushed)
+		t.Fatal(err)
+	}
+	buf := make([]byte, len("first"))
+	if _, err := io.ReadFull(zr, buf); err != nil || string(buf) != "first" {
+		close(flushed)
+		t.Fatalf("first read = %q, %v; want %q", buf, err, "first")
+	}
+	close(flushed)
+	rest, err := ioutil.ReadAll(zr)
+	if err != nil || string(rest) != " second" {
+		t.Errorf("rest = %q, %v; want %q", rest, err, " second")
+	}
+}
+
+func TestCompressHandlerInterfaces(t *testing.T) {
+	defer afterTest(t)
+	ts := httptest.NewServer(CompressHandler(HandlerFunc(func(w ResponseWriter, r *Request) {
+		if _, ok := w.(Flusher); !ok {
+			t.Error("ResponseWriter isn't a Flusher")
+		}
+		if _, ok := w.(CloseNotifier); !ok {
+			t.Error("ResponseWriter isn't a CloseNotifier")
+		}
+		hj, ok := w.(Hijacker)
+		if !ok {
+			t.Error("ResponseWriter isn't a Hijacker")
+			return
+		}
+		conn, bufrw, err := hj.Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		bufrw.WriteString("HTTP/1.0 200 OK\r\nContent-Type: text/plain\r\n\r\nhijacked")
+		bufrw.Flush()
+	})))
+	defer ts.Close()
+
+	res, err := Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil || string(body) != "hijacked" {
+		t.Errorf("body = %q, %v; want %q", body, err, "hijacked")
+	}
+}
@@ -3,11 +3,15 @@
 // license that can be found in the LICENSE file.
 
 // Package cookiejar implements an in-memory RFC 6265-compliant http.CookieJar.
+// A Jar's contents can be saved to and loaded from a stream so that
+// they survive a program restart.
 package cookiejar
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -30,7 +34,7 @@ import (
 // set a cookie for bar.com.
 //
 // A public suffix list implementation is in the package
-// code.google.com/p/go.net/publicsuffix.
+// net/http/cookiejar/publicsuffix.
 type PublicSuffixList interface {
 	// PublicSuffix returns the public suffix of domain.
 	//
@@ -298,6 +302,95 @@ func (j *Jar) setCookies(u *url.URL, cookies []*http.Cookie, now time.Time) {
 	}
 }
 
+// bySeqNum is a []entry sort.Interface that sorts entries in the order
+// they were first added to the jar.
+type bySeqNum []entry
+
+func (s bySeqNum) Len() int           { return len(s) }
+func (s bySeqNum) Less(i, j int) bool { return s[i].seqNum < s[j].seqNum }
+func (s bySeqNum) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Save writes the jar's unexpired cookies to w in a form that Load can
+// read. Session cookies are saved too, so that a program that restarts
+// can carry on with its sessions.
+func (j *Jar) Save(w io.Writer) error {
+	return j.save(w, time.Now())
+}
+
+// save is like Save but takes the current time as a parameter.
+func (j *Jar) save(w io.Writer, now time.Time) error {
+	var entries []entry
+	j.mu.Lock()
+	for _, submap := range j.entries {
+		for _, e := range submap {
+			if e.Persistent && !e.Expires.After(now) {
+				continue
+			}
+			entries = append(entries, e)
+		}
+	}
+	j.mu.Unlock()
+	sort.Sort(bySeqNum(entries))
+	return json.NewEncoder(w).Encode(entries)
+}
+
+// Load reads cookies written by Save from r and adds them to the jar,
+// replacing any cookies with the same name, domain and path. Cookies
+// that have expired since they were saved are skipped, as are cookies
+// the jar wouldn't have accepted, such as domain cookies for a public
+// suffix.
+func (j *Jar) Load(r io.Reader) error {
+	return j.load(r, time.Now())
+}
+
+// load is like Load but takes the current time as a parameter.
+func (j *Jar) load(r io.Reader, now time.Time) error {
+	var entries []entry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.Domain == "" || e.Path == "" || e.Path[0] != '/' {
+			return errMalformedEntry
+		}
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	for _, e := range entries {
+		if e.Persistent && !e.Expires.After(now) || !j.acceptable(&e) {
+			continue
+		}
+		// The key is recomputed rather than saved, since the
+		// jar may use a different public suffix list.
+		key := jarKey(e.Domain, j.psList)
+		submap := j.entries[key]
+		if submap == nil {
+			submap = make(map[string]entry)
+			j.entries[key] = submap
+		}
+		e.seqNum = j.nextSeqNum
+		j.nextSeqNum++
+		submap[e.id()] = e
+	}
+	return nil
+}
+
+// acceptable reports whether the jar could have created the loaded
+// entry e: its domain must be canonical, and a domain cookie's
+// domain must pass the checks of domainAndType.
+func (j *Jar) acceptable(e *entry) bool {
+	if host, err := canonicalHost(e.Domain); err != nil || host != e.Domain {
+		return false
+	}
+	if e.HostOnly {
+		return true
+	}
+	domain, hostOnly, err := j.domainAndType(e.Domain, e.Domain)
+	return err == nil && !hostOnly && domain == e.Domain
+}
+
 // canonicalHost strips port from host if present and returns the canonicalized
 // host name.
 func canonicalHost(host string) (string, error) {
@@ -429,6 +522,7 @@ var (
 	errIllegalDomain   = errors.New("cookiejar: illegal cookie domain attribute")
 	errMalformedDomain = errors.New("cookiejar: malformed cookie domain attribute")
 	errNoHostname      = errors.New("cookiejar: no host name available (IP only)")
+	errMalformedEntry  = errors.New("cookiejar: malformed saved cookie")
 )
 
 // endOfTime is the time when session (non-persistent) cookies expire.
@@ -5,6 +5,7 @@
 package cookiejar
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -1265,3 +1266,96 @@ func TestDomainHandling(t *testing.T) {
 		test.run(t, jar)
 	}
 }
+
+func TestSaveLoad(t *testing.T) {
+	jar := newTestJar()
+	var cookies []*http.Cookie
+	for _, cs := range []string{
+		"session=1",
+		"persistent=2; max-age=3600",
+		"short=3; max-age=60",
+		"domain=4; domain=host.test; path=/foo",
+		"secure=5; secure; httponly",
+	} {
+		cookies = append(cookies, (&http.Response{Header: http.Header{"Set-Cookie": {cs}}}).Cookies()...)
+	}
+	jar.setCookies(mustParseURL("http://www.host.test/"), cookies, tNow)
+	jar.setCookies(mustParseURL("http://other.co.uk/"), []*http.Cookie{{Name: "other", Value: "6"}}, tNow)
+
+	var buf bytes.Buffer
+	if err := jar.save(&buf, tNow); err != nil {
+		t.Fatal(err)
+	}
+
+	// Load at a time when "short" has expired.
+	later := tNow.Add(10 * time.Minute)
+	loaded := newTestJar()
+	if err := loaded.load(bytes.NewReader(buf.Bytes()), later); err != nil {
+		t.Fatal(err)
+	}
+	for _, q := range []query{
+		{"http://www.host.test/", "session=1 persistent=2"},
+		{"https://www.host.test/", "session=1 persistent=2 secure=5"},
+		{"http://sub.host.test/foo/bar", "domain=4"},
+		{"http://other.co.uk/", "other=6"},
+		{"http://www.other.co.uk/", ""},
+	} {
+		var s []string
+		for _, c := range loaded.cookies(mustParseURL(q.toURL), later) {
+			s = append(s, c.Name+"="+c.Value)
+		}
+		if got := strings.Join(s, " "); got != q.want {
+			t.Errorf("loaded jar: %s got %q; want %q", q.toURL, got, q.want)
+		}
+	}
+
+	// Loading again replaces rather than duplicates.
+	if err := loaded.load(bytes.NewReader(buf.Bytes()), later); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(loaded.entries["host.test"]); n != 4 {
+		t.Errorf("after second load, %d entries for host.test; want 4", n)
+	}
+}
+
+func TestLoadMalformed(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"{",
+		`[{"Name":"a","Value":"b","Path":"/"}]`,
+		`[{"Name":"a","Value":"b","Domain":"host.test","Path":"x"}]`,
+	} {
+		if err := newTestJar().Load(strings.NewReader(s)); err == nil {
+			t.Errorf("Load(%q) succeeded; want error", s)
+		}
+	}
+
+	// Entries the jar wouldn't have accepted are dropped.
+	for _, tt := range []struct {
+		json, url string
+	}{
+		{`[{"Name":"a","Value":"b","Domain":"co.uk","Path":"/"}]`, "http://www.example.co.uk/"},
+		{`[{"Name":"a","Value":"b","Domain":"test","Path":"/"}]`, "http://www.host.test/"},
+		{`[{"Name":"a","Value":"b","Domain":"127.0.0.1","Path":"/"}]`, "http://127.0.0.1/"},
+		{`[{"Name":"a","Value":"b","Domain":"Host.Test","Path":"/","HostOnly":true}]`, "http://host.test/"},
+		{`[{"Name":"a","Value":"b","Domain":".host.test","Path":"/"}]`, "http://www.host.test/"},
+	} {
+		jar := newTestJar()
+		if err := jar.Load(strings.NewReader(tt.json)); err != nil {
+			t.Errorf("Load(%q): %v", tt.json, err)
+			continue
+		}
+		if cookies := jar.Cookies(mustParseURL(tt.url)); len(cookies) != 0 {
+			t.Errorf("Load(%q): %s got cookies %v; want none", tt.json, tt.url, cookies)
+		}
+	}
+
+	// A host cookie for a public suffix is fine.
+	jar := newTestJar()
+	if err := jar.Load(strings.NewReader(`[{"Name":"a","Value":"b","Domain":"co.uk","Path":"/","HostOnly":true}]`)); err != nil {
+		t.Fatal(err)
+	}
+	if cookies := jar.Cookies(mustParseURL("http://co.uk/")); len(cookies) != 1 {
+		t.Errorf("host cookie for co.uk: got cookies %v; want one", cookies)
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// Public suffix table generator.
+// Data read from the web, or from a local copy with -file.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	url     = flag.String("url", "https://publicsuffix.org/list/public_suffix_list.dat", "URL of the public suffix list")
+	file    = flag.String("file", "", "local copy of the public suffix list; overrides -url")
+	version = flag.String("version", "", "version string for the table; defaults to the source and today's date")
+)
+
+var logger = log.New(os.Stderr, "", log.Lshortfile)
+
+func main() {
+	flag.Parse()
+	src := "-url=" + *url
+	var r io.Reader
+	if *file != "" {
+		src = "-file=" + *file
+		f, err := os.Open(*file)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	} else {
+		res, err := http.Get(*url)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode != 200 {
+			logger.Fatalf("bad GET status for %s: %s", *url, res.Status)
+		}
+		r = res.Body
+	}
+	if *version == "" {
+		*version = fmt.Sprintf("publicsuffix.org's public_suffix_list.dat as of %s", time.Now().UTC().Format("2006-01-02"))
+	}
+
+	icann, private := parse(r)
+	fmt.Printf("// Generated by running\n//\tgo run gen.go %s\n// DO NOT EDIT\n\n", src)
+	fmt.Printf("package publicsuffix\n\n")
+	fmt.Printf("const version = %q\n\n", *version)
+	printRules("icannRules", "ICANN", icann)
+	printRules("privateRules", "privately managed", private)
+}
+
+// parse reads the rules of the list in r, split into the ICANN and
+// private sections. Internationalized labels are converted to
+// Punycode.
+func parse(r io.Reader) (icann, private []string) {
+	inPrivate := false
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.Contains(line, "BEGIN PRIVATE DOMAINS"):
+			inPrivate = true
+			continue
+		case strings.Contains(line, "END PRIVATE DOMAINS"):
+			inPrivate = false
+			continue
+		case line == "", strings.HasPrefix(line, "//"):
+			continue
+		}
+		// A rule ends at the first whitespace.
+		if i := strings.IndexAny(line, " \t"); i >= 0 {
+			line = line[:i]
+		}
+		prefix := ""
+		switch {
+		case strings.HasPrefix(line, "!"):
+			prefix, line = "!", line[1:]
+		case strings.HasPrefix(line, "*."):
+			prefix, line = "*.", line[2:]
+		}
+		rule, err := toASCII(strings.ToLower(line))
+		if err != nil {
+			logger.Fatal(err)
+		}
+		rule = prefix + rule
+		if seen[rule] {
+			continue
+		}
+		seen[rule] = true
+		if inPrivate {
+			private = append(private, rule)
+		} else {
+			icann = append(icann, rule)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		logger.Fatal(err)
+	}
+	sort.Strings(icann)
+	sort.Strings(private)
+	return icann, private
+}
+
+func printRules(name, desc string, rules []string) {
+	fmt.Printf("// %s holds the sorted %s rules. Wildcard rules keep their\n", name, desc)
+	fmt.Printf("// \"*.\" prefix and exception rules their \"!\" prefix.\n")
+	fmt.Printf("var %s = [...]string{\n", name)
+	for _, rule := range rules {
+		fmt.Printf("\t%q,\n", rule)
+	}
+	fmt.Printf("}\n\n")
+}
+
+// The Punycode code below is copied from net/http/cookiejar/punycode.go.
+
+const (
+	base        int32 = 36
+	damp        int32 = 700
+	initialBias int32 = 72
+	initialN    int32 = 128
+	skew        int32 = 38
+	tmax        int32 = 26
+	tmin        int32 = 1
+)
+
+func encode(prefix, s string) (string, error) {
+	output := make([]byte, len(prefix), len(prefix)+1+2*len(s))
+	copy(output, prefix)
+	delta, n, bias := int32(0), initialN, initialBias
+	b, remaining := int32(0), int32(0)
+	for _, r := range s {
+		if r < 0x80 {
+			b++
+			output = append(output, byte(r))
+		} else {
+			remaining++
+		}
+	}
+	h := b
+	if b > 0 {
+		output = append(output, '-')
+	}
+	for remaining != 0 {
+		m := int32(0x7fffffff)
+		for _, r := range s {
+			if m > r && r >= n {
+				m = r
+			}
+		}
+		delta += (m - n) * (h + 1)
+		if delta < 0 {
+			return "", fmt.Errorf("invalid label %q", s)
+		}
+		n = m
+		for _, r := range s {
+			if r < n {
+				delta++
+				if delta < 0 {
+					return "", fmt.Errorf("invalid label %q", s)
+				}
+				continue
+			}
+			if r > n {
+				continue
+			}
+			q := delta
+			for k := base; ; k += base {
+				t := k - bias
+				if t < tmin {
+					t = tmin
+				} else if t > tmax {
+					t = tmax
+				}
+				if q < t {
+					break
+				}
+				output = append(output, encodeDigit(t+(q-t)%(base-t)))
+				q = (q - t) / (base - t)
+			}
+			output = append(output, encodeDigit(q))
+			bias = adapt(delta, h+1, h == b)
+			delta = 0
+			h++
+			remaining--
+		}
+		delta++
+		n++
+	}
+	return string(output), nil
+}
+
+func encodeDigit(digit int32) byte {
+	switch {
+	case 0 <= digit && digit < 26:
+		return byte(digit + 'a')
+	case 26 <= digit && digit < 36:
+		return byte(digit + ('0' - 26))
+	}
+	panic("internal error in punycode encoding")
+}
+
+func adapt(delta, numPoints int32, firstTime bool) int32 {
+	if firstTime {
+		delta /= damp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := int32(0)
+	for delta > ((base-tmin)*tmax)/2 {
+		delta /= base - tmin
+		k += base
+	}
+	return k + (base-tmin+1)*delta/(delta+skew)
+}
+
+func toASCII(s string) (string, error) {
+	if ascii(s) {
+		return s, nil
+	}
+	labels := strings.Split(s, ".")
+	for i, label := range labels {
+		if !ascii(label) {
+			a, err := encode("xn--", label)
+			if err != nil {
+				return "", err
+			}
+			labels[i] = a
+		}
+	}
+	return strings.Join(labels, "."), nil
+}
+
+func ascii(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
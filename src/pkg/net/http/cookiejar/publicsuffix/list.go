@@ -0,0 +1,105 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package publicsuffix provides a public suffix list based on data from
+// http://publicsuffix.org/. A public suffix is one under which Internet
+// users can directly register names.
+//
+// The list is compiled into the package. It is regenerated from the
+// published data by running "make table" in the package directory.
+package publicsuffix
+
+import (
+	"fmt"
+	"net/http/cookiejar"
+	"sort"
+	"strings"
+)
+
+// List implements the cookiejar.PublicSuffixList interface by calling
+// the PublicSuffix function.
+var List cookiejar.PublicSuffixList = list{}
+
+type list struct{}
+
+func (list) PublicSuffix(domain string) string {
+	ps, _ := PublicSuffix(domain)
+	return ps
+}
+
+func (list) String() string {
+	return version
+}
+
+// PublicSuffix returns the public suffix of the domain using a copy of
+// the publicsuffix.org database compiled into the library.
+//
+// icann is whether the public suffix is managed by the Internet
+// Corporation for Assigned Names and Numbers. If not, the public suffix
+// is privately managed. For example, "foo.org" and "foo.co.uk" are
+// ICANN domains, "foo.dyndns.org" and "foo.blogspot.co.uk" are private
+// domains. A domain not covered by any rule falls under the default
+// rule "*", whose public suffix is the last label and which is not
+// reported as ICANN.
+//
+// The domain should be lower case ASCII, with internationalized labels
+// in their Punycode form and without a trailing dot, as produced by
+// the cookiejar package.
+func PublicSuffix(domain string) (publicSuffix string, icann bool) {
+	found := false
+	for s := domain; ; {
+		dot := strings.IndexByte(s, '.')
+		// An exception rule prevails over every other rule, so
+		// keep looking for one after finding the longest match.
+		if ic, ok := lookup("!" + s); ok && dot >= 0 {
+			return s[dot+1:], ic
+		}
+		if !found {
+			if ic, ok := lookup(s); ok {
+				publicSuffix, icann, found = s, ic, true
+			} else if dot >= 0 {
+				if ic, ok := lookup("*." + s[dot+1:]); ok {
+					publicSuffix, icann, found = s, ic, true
+				}
+			}
+		}
+		if dot < 0 {
+			break
+		}
+		s = s[dot+1:]
+	}
+	if found {
+		return publicSuffix, icann
+	}
+	return domain[strings.LastIndex(domain, ".")+1:], false
+}
+
+// lookup reports whether rule is in the list, and if so, whether it is
+// an ICANN rule.
+func lookup(rule string) (icann, ok bool) {
+	if contains(icannRules[:], rule) {
+		return true, true
+	}
+	return false, contains(privateRules[:], rule)
+}
+
+func contains(rules []string, rule string) bool {
+	i := sort.SearchStrings(rules, rule)
+	return i < len(rules) && rules[i] == rule
+}
+
+// EffectiveTLDPlusOne returns the effective top level domain plus one
+// more label. For example, the eTLD+1 for "foo.bar.golang.org" is
+// "golang.org".
+func EffectiveTLDPlusOne(domain string) (string, error) {
+	suffix, _ := PublicSuffix(domain)
+	if len(domain) <= len(suffix) {
+		return "", fmt.Errorf("publicsuffix: cannot derive eTLD+1 for domain %q", domain)
+	}
+	i := len(domain) - len(suffix) - 1
+	if domain[i] != '.' {
+		return "", fmt.Errorf("publicsuffix: invalid public suffix %q for domain %q", suffix, domain)
+	}
+	return domain[1+strings.LastIndex(domain[:i], "."):], nil
+}
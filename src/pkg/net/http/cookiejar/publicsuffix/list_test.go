@@ -0,0 +1,128 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"sort"
+	"testing"
+)
+
+func TestRulesSorted(t *testing.T) {
+	if !sort.StringsAreSorted(icannRules[:]) {
+		t.Error("icannRules is not sorted")
+	}
+	if !sort.StringsAreSorted(privateRules[:]) {
+		t.Error("privateRules is not sorted")
+	}
+}
+
+var publicSuffixTests = []struct {
+	domain, want string
+	icann        bool
+}{
+	// Empty string and unlisted top level domains fall back to "*".
+	{"", "", false},
+	{"notatld", "notatld", false},
+	{"foo.notatld", "notatld", false},
+
+	// Simple rules.
+	{"com", "com", true},
+	{"example.com", "com", true},
+	{"www.example.com", "com", true},
+	{"example.co.uk", "co.uk", true},
+	{"www.example.co.uk", "co.uk", true},
+	{"a.b.pvt.k12.ma.us", "pvt.k12.ma.us", true},
+
+	// Wildcard and exception rules: "*.ck" and "!www.ck".
+	{"ck", "ck", false}, // only "*.ck" is listed, so "*" applies
+	{"foo.ck", "foo.ck", true},
+	{"bar.foo.ck", "foo.ck", true},
+	{"www.ck", "ck", true},
+	{"foo.www.ck", "ck", true},
+	{"kawasaki.jp", "jp", true},
+	{"foo.kawasaki.jp", "foo.kawasaki.jp", true},
+	{"city.kawasaki.jp", "kawasaki.jp", true},
+	{"www.city.kawasaki.jp", "kawasaki.jp", true},
+
+	// Private domains.
+	{"uk.com", "uk.com", false},
+	{"example.uk.com", "uk.com", false},
+	{"foo.blogspot.co.uk", "blogspot.co.uk", false},
+	{"foo.github.io", "github.io", false},
+	{"foo.dyndns.org", "dyndns.org", false},
+
+	// Internationalized domains, in Punycode.
+	{"xn--fiqs8s", "xn--fiqs8s", true},
+	{"foo.xn--fiqs8s", "xn--fiqs8s", true},
+}
+
+func TestPublicSuffix(t *testing.T) {
+	for _, tc := range publicSuffixTests {
+		got, icann := PublicSuffix(tc.domain)
+		if got != tc.want || icann != tc.icann {
+			t.Errorf("PublicSuffix(%q) = %q, %v; want %q, %v", tc.domain, got, icann, tc.want, tc.icann)
+		}
+		if got := List.PublicSuffix(tc.domain); got != tc.want {
+			t.Errorf("List.PublicSuffix(%q) = %q; want %q", tc.domain, got, tc.want)
+		}
+	}
+}
+
+var eTLDPlusOneTests = []struct {
+	domain, want string
+}{
+	{"", ""},
+	{"com", ""},
+	{"example.com", "example.com"},
+	{"www.example.com", "example.com"},
+	{"co.uk", ""},
+	{"www.example.co.uk", "example.co.uk"},
+	{"foo.ck", ""},
+	{"www.foo.ck", "www.foo.ck"},
+	{"a.www.foo.ck", "www.foo.ck"},
+	{"www.ck", "www.ck"},
+	{"foo.www.ck", "www.ck"},
+	{"foo.bar.blogspot.co.uk", "bar.blogspot.co.uk"},
+}
+
+func TestEffectiveTLDPlusOne(t *testing.T) {
+	for _, tc := range eTLDPlusOneTests {
+		got, err := EffectiveTLDPlusOne(tc.domain)
+		if tc.want == "" {
+			if err == nil {
+				t.Errorf("EffectiveTLDPlusOne(%q) = %q; want error", tc.domain, got)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Errorf("EffectiveTLDPlusOne(%q) = %q, %v; want %q", tc.domain, got, err, tc.want)
+		}
+	}
+}
+
+// Tests that a jar using List refuses domain cookies for a public
+// suffix.
+func TestJar(t *testing.T) {
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: List})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("http://www.example.co.uk/")
+	jar.SetCookies(u, []*http.Cookie{
+		{Name: "bad", Value: "1", Domain: "co.uk"},
+		{Name: "good", Value: "2", Domain: "example.co.uk"},
+	})
+	other, _ := url.Parse("http://other.co.uk/")
+	if got := jar.Cookies(other); len(got) != 0 {
+		t.Errorf("cookies for %s = %v; want none", other, got)
+	}
+	sub, _ := url.Parse("http://sub.example.co.uk/")
+	if got := jar.Cookies(sub); len(got) != 1 || got[0].Name != "good" {
+		t.Errorf("cookies for %s = %v; want good=2", sub, got)
+	}
+}
@@ -62,3 +62,13 @@ func NewTestTimeoutHandler(handler Handler, ch <-chan time.Time) Handler {
 }
 
 var DefaultUserAgent = defaultUserAgent
+
+var NegotiateEncoding = negotiateEncoding
+
+func SetRateLimiterClock(l *RateLimiter, now func() time.Time) {
+	l.now = now
+}
+
+func SetHTTP2SkipSecurityCheck(skip bool) {
+	http2skipSecurityCheck = skip
+}
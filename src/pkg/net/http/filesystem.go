@@ -0,0 +1,396 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// FileSystem implementations backed by archives and memory.
+
+package http
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MemFS returns a FileSystem serving the given files, such as assets
+// generated at build time. The keys of files are slash-separated
+// paths, relative to the root of the file system; the directories
+// leading to them are created implicitly. All files and directories
+// report modtime as their modification time.
+//
+// The files map and its contents must not be modified afterwards.
+func MemFS(files map[string][]byte, modtime time.Time) FileSystem {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	t := newMemTree(modtime)
+	for _, name := range names {
+		data := files[name]
+		t.add(name, &memNode{
+			modTime: modtime,
+			mode:    0444,
+			size:    int64(len(data)),
+			open: func() (io.ReadSeeker, error) {
+				return bytes.NewReader(data), nil
+			},
+		})
+	}
+	return t
+}
+
+// ZipFS returns a FileSystem serving the contents of the zip archive
+// read by z. Directories missing from the archive are created
+// implicitly.
+//
+// Files are decompressed as they are read. Seeking backwards in a
+// compressed file restarts decompression from the start of the file.
+func ZipFS(z *zip.Reader) FileSystem {
+	t := newMemTree(time.Time{})
+	for _, f := range z.File {
+		f := f
+		n := &memNode{
+			modTime: f.ModTime(),
+			mode:    f.Mode(),
+		}
+		if strings.HasSuffix(f.Name, "/") {
+			n.mode |= os.ModeDir
+		}
+		if !n.mode.IsDir() {
+			n.size = f.FileInfo().Size()
+			n.open = func() (io.ReadSeeker, error) {
+				return &zipFileReader{f: f, size: n.size}, nil
+			}
+		}
+		t.add(f.Name, n)
+	}
+	return t
+}
+
+// OverlayFS returns a FileSystem that layers the given file systems
+// on top of each other. Opening a file returns it from the first
+// file system that has it. Reading a directory lists the union of
+// the directories of that name in all file systems, the entries of
+// earlier file systems hiding those of later ones.
+func OverlayFS(fss ...FileSystem) FileSystem {
+	return overlayFS(fss)
+}
+
+var errIsDir = errors.New("http: file is a directory")
+
+// memTree is a FileSystem over a tree of memNodes, as built by MemFS
+// and ZipFS.
+type memTree struct {
+	nodes map[string]*memNode // by cleaned path, "/" for the root
+}
+
+func newMemTree(modtime time.Time) *memTree {
+	root := &memNode{name: "/", modTime: modtime, mode: os.ModeDir | 0555}
+	return &memTree{nodes: map[string]*memNode{"/": root}}
+}
+
+// add adds n to the tree at name, creating the directories leading
+// to it as needed. Directories take the latest modification time of
+// their entries. A name that conflicts with an existing file is
+// ignored.
+func (t *memTree) add(name string, n *memNode) {
+	name = path.Clean("/" + name)
+	if name == "/" {
+		return
+	}
+	if old := t.nodes[name]; old != nil {
+		if old.IsDir() && n.IsDir() {
+			old.modTime, old.mode = n.modTime, n.mode
+		}
+		return
+	}
+	dir, base := path.Split(name)
+	parent := t.nodes[path.Clean(dir)]
+	if parent == nil {
+		t.add(dir, &memNode{modTime: n.modTime, mode: os.ModeDir | 0555})
+		parent = t.nodes[path.Clean(dir)]
+	}
+	if !parent.IsDir() {
+		return
+	}
+	if n.modTime.After(parent.modTime) {
+		parent.modTime = n.modTime
+	}
+	n.name = base
+	t.nodes[name] = n
+	// Keep the entries sorted by name, as os.File's Readdir
+	// results usually are.
+	i := sort.Search(len(parent.children), func(i int) bool {
+		return parent.children[i].Name() >= base
+	})
+	parent.children = append(parent.children, nil)
+	copy(parent.children[i+1:], parent.children[i:])
+	parent.children[i] = n
+}
+
+func (t *memTree) Open(name string) (File, error) {
+	n := t.nodes[path.Clean("/"+name)]
+	if n == nil {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	f := &memFile{n: n}
+	if n.open != nil {
+		rs, err := n.open()
+		if err != nil {
+			return nil, &os.PathError{Op: "open", Path: name, Err: err}
+		}
+		f.rs = rs
+	}
+	return f, nil
+}
+
+// memNode is a file or directory in a memTree. It is its own
+// os.FileInfo.
+type memNode struct {
+	name     string
+	modTime  time.Time
+	mode     os.FileMode
+	size     int64
+	children []os.FileInfo                 // for directories, sorted by name
+	open     func() (io.ReadSeeker, error) // for files
+}
+
+func (n *memNode) Name() string       { return n.name }
+func (n *memNode) Size() int64        { return n.size }
+func (n *memNode) Mode() os.FileMode  { return n.mode }
+func (n *memNode) ModTime() time.Time { return n.modTime }
+func (n *memNode) IsDir() bool        { return n.mode.IsDir() }
+func (n *memNode) Sys() interface{}   { return nil }
+
+// memFile is an open memNode.
+type memFile struct {
+	n      *memNode
+	rs     io.ReadSeeker // nil for directories
+	dirPos int           // entries of n.children already returned by Readdir
+}
+
+func (f *memFile) Close() error {
+	if c, ok := f.rs.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+func (f *memFile) Stat() (os.FileInfo, error) {
+	return f.n, nil
+}
+
+func (f *memFile) Read(p []byte) (int, error) {
+	if f.rs == nil {
+		return 0, errIsDir
+	}
+	return f.rs.Read(p)
+}
+
+func (f *memFile) Seek(offset int64, whence int) (int64, error) {
+	if f.rs == nil {
+		if offset == 0 && whence == os.SEEK_SET {
+			f.dirPos = 0
+			return 0, nil
+		}
+		return 0, errIsDir
+	}
+	return f.rs.Seek(offset, whence)
+}
+
+func (f *memFile) Readdir(count int) ([]os.FileInfo, error) {
+	if f.rs != nil {
+		return nil, errors.New("http: Readdir on a file")
+	}
+	return readdir(f.n.children, &f.dirPos, count)
+}
+
+// readdir returns the next count entries of infos after *pos,
+// advancing *pos, with the semantics of os.File's Readdir.
+func readdir(infos []os.FileInfo, pos *int, count int) ([]os.FileInfo, error) {
+	rest := infos[*pos:]
+	if count > 0 {
+		if len(rest) == 0 {
+			return nil, io.EOF
+		}
+		if len(rest) > count {
+			rest = rest[:count]
+		}
+	}
+	*pos += len(rest)
+	return append([]os.FileInfo(nil), rest...), nil
+}
+
+// zipFileReader reads and seeks in a file of a zip archive.
+type zipFileReader struct {
+	f    *zip.File
+	size int64
+	rc   io.ReadCloser // decompressor, or nil if not opened yet
+	rpos int64         // offset of rc
+	pos  int64         // offset of the next Read
+}
+
+func (z *zipFileReader) Read(p []byte) (int, error) {
+	if z.pos >= z.size {
+		return 0, io.EOF
+	}
+	if z.rc == nil || z.rpos > z.pos {
+		z.Close()
+		rc, err := z.f.Open()
+		if err != nil {
+			return 0, err
+		}
+		z.rc, z.rpos = rc, 0
+	}
+	if z.rpos < z.pos {
+		n, err := io.CopyN(ioutil.Discard, z.rc, z.pos-z.rpos)
+		z.rpos += n
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err := z.rc.Read(p)
+	z.rpos += int64(n)
+	z.pos = z.rpos
+	return n, err
+}
+
+func (z *zipFileReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case os.SEEK_SET:
+	case os.SEEK_CUR:
+		offset += z.pos
+	case os.SEEK_END:
+		offset += z.size
+	default:
+		return 0, errors.New("http: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("http: negative position")
+	}
+	z.pos = offset
+	return offset, nil
+}
+
+func (z *zipFileReader) Close() error {
+	if z.rc == nil {
+		return nil
+	}
+	err := z.rc.Close()
+	z.rc = nil
+	return err
+}
+
+type overlayFS []FileSystem
+
+func (fss overlayFS) Open(name string) (File, error) {
+	var (
+		first   File
+		firstFI os.FileInfo
+		dirs    []File // the directories named name, from the top
+	)
+	for _, fs := range fss {
+		f, err := fs.Open(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			if first == nil {
+				return nil, err
+			}
+			break
+		}
+		fi, err := f.Stat()
+		if err != nil || first != nil && !fi.IsDir() {
+			f.Close()
+			if first == nil {
+				return nil, err
+			}
+			continue
+		}
+		if first == nil {
+			if !fi.IsDir() {
+				return f, nil
+			}
+			first, firstFI = f, fi
+		}
+		dirs = append(dirs, f)
+	}
+	if first == nil {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	if len(dirs) == 1 {
+		return first, nil
+	}
+	return &overlayDir{dirs: dirs, fi: firstFI}, nil
+}
+
+// overlayDir is a directory present in several layers of an
+// overlayFS.
+type overlayDir struct {
+	dirs    []File
+	fi      os.FileInfo
+	entries []os.FileInfo // merged entries, or nil if not read yet
+	pos     int           // entries already returned by Readdir
+}
+
+func (d *overlayDir) Close() error {
+	var err error
+	for _, f := range d.dirs {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+func (d *overlayDir) Stat() (os.FileInfo, error) {
+	return d.fi, nil
+}
+
+func (d *overlayDir) Read([]byte) (int, error) {
+	return 0, errIsDir
+}
+
+func (d *overlayDir) Seek(offset int64, whence int) (int64, error) {
+	if offset == 0 && whence == os.SEEK_SET {
+		d.pos = 0
+		return 0, nil
+	}
+	return 0, errIsDir
+}
+
+func (d *overlayDir) Readdir(count int) ([]os.FileInfo, error) {
+	if d.entries == nil {
+		seen := make(map[string]bool)
+		d.entries = []os.FileInfo{}
+		for _, f := range d.dirs {
+			fis, err := f.Readdir(-1)
+			if err != nil {
+				return nil, err
+			}
+			for _, fi := range fis {
+				if !seen[fi.Name()] {
+					seen[fi.Name()] = true
+					d.entries = append(d.entries, fi)
+				}
+			}
+		}
+		sort.Sort(byName(d.entries))
+	}
+	return readdir(d.entries, &d.pos, count)
+}
+
+type byName []os.FileInfo
+
+func (s byName) Len() int           { return len(s) }
+func (s byName) Less(i, j int) bool { return s[i].Name() < s[j].Name() }
+func (s byName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
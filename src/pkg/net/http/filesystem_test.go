@@ -0,0 +1,279 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	. "net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var fsModTime = time.Date(2014, 3, 1, 12, 0, 0, 0, time.UTC)
+
+var fsFiles = map[string][]byte{
+	"index.html":        []byte("<html>index</html>"),
+	"css/site.css":      []byte("body { color: black; }"),
+	"js/app.js":         []byte("alert('hello');"),
+	"js/lib/jquery.js":  []byte("/* jquery */"),
+	"data/numbers.txt":  []byte("0123456789abcdefghijklmnopqrstuvwxyz"),
+	"/data/letters.txt": []byte("abc"),
+}
+
+func newZipFS(t *testing.T, files map[string][]byte, method uint16) FileSystem {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, data := range files {
+		fh := &zip.FileHeader{Name: strings.TrimPrefix(name, "/"), Method: method}
+		fh.SetModTime(fsModTime)
+		w, err := zw.CreateHeader(fh)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(data)
+	}
+	// An explicit directory entry.
+	fh := &zip.FileHeader{Name: "empty/"}
+	fh.SetModTime(fsModTime)
+	if _, err := zw.CreateHeader(fh); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ZipFS(zr)
+}
+
+func testFileSystems(t *testing.T) map[string]FileSystem {
+	return map[string]FileSystem{
+		"MemFS":          MemFS(fsFiles, fsModTime),
+		"ZipFS stored":   newZipFS(t, fsFiles, zip.Store),
+		"ZipFS deflated": newZipFS(t, fsFiles, zip.Deflate),
+	}
+}
+
+func readDirNames(t *testing.T, fs FileSystem, name string) []string {
+	f, err := fs.Open(name)
+	if err != nil {
+		t.Fatalf("Open(%q): %v", name, err)
+	}
+	defer f.Close()
+	var names []string
+	for {
+		fis, err := f.Readdir(2)
+		for _, fi := range fis {
+			names = append(names, fi.Name())
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Readdir in %q: %v", name, err)
+		}
+	}
+	return names
+}
+
+func TestFileSystemOpen(t *testing.T) {
+	for fsName, fs := range testFileSystems(t) {
+		for name, want := range fsFiles {
+			f, err := fs.Open(name)
+			if err != nil {
+				t.Errorf("%s: Open(%q): %v", fsName, name, err)
+				continue
+			}
+			got, err := ioutil.ReadAll(f)
+			f.Close()
+			if err != nil || !bytes.Equal(got, want) {
+				t.Errorf("%s: contents of %q = %q, %v; want %q", fsName, name, got, err, want)
+			}
+		}
+		if _, err := fs.Open("/nonexistent"); !os.IsNotExist(err) {
+			t.Errorf("%s: Open of nonexistent file: %v; want not exist error", fsName, err)
+		}
+		if _, err := fs.Open("/js/../../index.html"); err != nil {
+			t.Errorf("%s: Open of unclean path: %v", fsName, err)
+		}
+
+		f, err := fs.Open("/js/app.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+		fi, err := f.Stat()
+		f.Close()
+		if err != nil || fi.Name() != "app.js" || fi.IsDir() || fi.Size() != int64(len(fsFiles["js/app.js"])) || !fi.ModTime().Equal(fsModTime) {
+			t.Errorf("%s: Stat of /js/app.js = %+v, %v", fsName, fi, err)
+		}
+
+		f, err = fs.Open("/js")
+		if err != nil {
+			t.Fatal(err)
+		}
+		fi, err = f.Stat()
+		if err != nil || !fi.IsDir() || !fi.ModTime().Equal(fsModTime) {
+			t.Errorf("%s: Stat of /js = %+v, %v", fsName, fi, err)
+		}
+		if _, err := f.Read(make([]byte, 1)); err == nil {
+			t.Errorf("%s: Read of directory succeeded", fsName)
+		}
+		f.Close()
+
+		if got, want := readDirNames(t, fs, "/"), []string{"css", "data", "index.html", "js"}; fsName == "MemFS" && !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: Readdir of / = %q; want %q", fsName, got, want)
+		}
+		if got, want := readDirNames(t, fs, "/js"), []string{"app.js", "lib"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: Readdir of /js = %q; want %q", fsName, got, want)
+		}
+	}
+}
+
+func TestFileSystemSeek(t *testing.T) {
+	const name = "/data/numbers.txt"
+	content := string(fsFiles["data/numbers.txt"])
+	for fsName, fs := range testFileSystems(t) {
+		f, err := fs.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		read := func(n int) string {
+			b := make([]byte, n)
+			n, err := io.ReadFull(f, b)
+			if err != nil {
+				t.Errorf("%s: read: %v", fsName, err)
+			}
+			return string(b[:n])
+		}
+		if size, err := f.Seek(0, os.SEEK_END); err != nil || size != int64(len(content)) {
+			t.Errorf("%s: Seek to end = %d, %v; want %d", fsName, size, err, len(content))
+		}
+		f.Seek(10, os.SEEK_SET)
+		if got, want := read(5), content[10:15]; got != want {
+			t.Errorf("%s: read at 10 = %q; want %q", fsName, got, want)
+		}
+		f.Seek(-10, os.SEEK_CUR)
+		if got, want := read(3), content[5:8]; got != want {
+			t.Errorf("%s: read at 5 = %q; want %q", fsName, got, want)
+		}
+		f.Seek(-4, os.SEEK_END)
+		if got, want := read(4), content[len(content)-4:]; got != want {
+			t.Errorf("%s: read at end-4 = %q; want %q", fsName, got, want)
+		}
+		if n, err := f.Read(make([]byte, 1)); n != 0 || err != io.EOF {
+			t.Errorf("%s: read at end = %d, %v; want 0, EOF", fsName, n, err)
+		}
+		f.Close()
+	}
+}
+
+func TestFileSystemFileServer(t *testing.T) {
+	for fsName, fs := range testFileSystems(t) {
+		ts := httptest.NewServer(FileServer(fs))
+
+		get := func(path string, hdr ...string) (*Response, string) {
+			req, _ := NewRequest("GET", ts.URL+path, nil)
+			for i := 0; i < len(hdr); i += 2 {
+				req.Header.Set(hdr[i], hdr[i+1])
+			}
+			res, err := DefaultTransport.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("%s: GET %s: %v", fsName, path, err)
+			}
+			body, _ := ioutil.ReadAll(res.Body)
+			res.Body.Close()
+			return res, string(body)
+		}
+
+		if res, body := get("/"); res.StatusCode != StatusOK || body != string(fsFiles["index.html"]) {
+			t.Errorf("%s: GET / = %d %q", fsName, res.StatusCode, body)
+		}
+		if res, body := get("/js/"); res.StatusCode != StatusOK || !strings.Contains(body, `<a href="lib/">lib/</a>`) {
+			t.Errorf("%s: GET /js/ = %d %q; want directory listing", fsName, res.StatusCode, body)
+		}
+		res, body := get("/data/numbers.txt", "Range", "bytes=10-14")
+		if res.StatusCode != StatusPartialContent || body != "abcde" {
+			t.Errorf("%s: range request = %d %q; want 206 %q", fsName, res.StatusCode, body, "abcde")
+		}
+		res, _ = get("/data/numbers.txt", "If-Modified-Since", fsModTime.Add(time.Hour).Format(TimeFormat))
+		if res.StatusCode != StatusNotModified {
+			t.Errorf("%s: If-Modified-Since request = %d; want 304", fsName, res.StatusCode)
+		}
+		if res, _ := get("/missing.txt"); res.StatusCode != StatusNotFound {
+			t.Errorf("%s: GET /missing.txt = %d; want 404", fsName, res.StatusCode)
+		}
+
+		ts.Close()
+	}
+}
+
+func TestOverlayFS(t *testing.T) {
+	top := MemFS(map[string][]byte{
+		"index.html":     []byte("top index"),
+		"js/override.js": []byte("top override"),
+		"css":            []byte("a file hiding a directory"),
+	}, fsModTime)
+	bottom := MemFS(map[string][]byte{
+		"index.html":     []byte("bottom index"),
+		"js/override.js": []byte("bottom override"),
+		"js/bottom.js":   []byte("bottom only"),
+		"css/site.css":   []byte("body {}"),
+	}, fsModTime)
+	fs := OverlayFS(top, bottom)
+
+	for name, want := range map[string]string{
+		"/index.html":     "top index",
+		"/js/override.js": "top override",
+		"/js/bottom.js":   "bottom only",
+		"/css":            "a file hiding a directory",
+	} {
+		f, err := fs.Open(name)
+		if err != nil {
+			t.Errorf("Open(%q): %v", name, err)
+			continue
+		}
+		got, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil || string(got) != want {
+			t.Errorf("contents of %q = %q, %v; want %q", name, got, err, want)
+		}
+	}
+	if _, err := fs.Open("/css/site.css"); err != nil {
+		t.Errorf("Open of file below a hidden directory: %v", err)
+	}
+	if _, err := fs.Open("/nonexistent"); !os.IsNotExist(err) {
+		t.Errorf("Open of nonexistent file: %v; want not exist error", err)
+	}
+
+	if got, want := readDirNames(t, fs, "/"), []string{"css", "index.html", "js"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Readdir of / = %q; want %q", got, want)
+	}
+	if got, want := readDirNames(t, fs, "/js"), []string{"bottom.js", "override.js"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Readdir of /js = %q; want %q", got, want)
+	}
+	f, err := fs.Open("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	fis, err := f.Readdir(-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fi := range fis {
+		if fi.Name() == "css" && fi.IsDir() {
+			t.Errorf("Readdir of / lists the hidden css directory")
+		}
+	}
+}
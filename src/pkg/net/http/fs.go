@@ -423,6 +423,9 @@ type fileHandler struct {
 // use http.Dir:
 //
 //     http.Handle("/", http.FileServer(http.Dir("/tmp")))
+//
+// To serve files from a zip archive or from memory, use ZipFS or
+// MemFS, optionally layered with OverlayFS.
 func FileServer(root FileSystem) Handler {
 	return &fileHandler{root}
 }
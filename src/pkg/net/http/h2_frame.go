@@ -0,0 +1,573 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// HTTP/2 framing layer. See RFC 7540, section 4 and 6.
+
+package http
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// http2NextProtoTLS is the ALPN protocol name for HTTP/2 over TLS.
+const http2NextProtoTLS = "h2"
+
+// http2ClientPreface is the string that must be sent by new
+// connections from clients.
+const http2ClientPreface = "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n"
+
+// http2goodCipherSuites are the TLS 1.2 cipher suites with ephemeral
+// key exchange and AEAD encryption, the ones not blacklisted by RFC
+// 7540, Appendix A. crypto/tls implements none of them yet, so they
+// are listed by number.
+var http2goodCipherSuites = map[uint16]bool{
+	0x009e: true, // TLS_DHE_RSA_WITH_AES_128_GCM_SHA256
+	0x009f: true, // TLS_DHE_RSA_WITH_AES_256_GCM_SHA384
+	0xc02b: true, // TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256
+	0xc02c: true, // TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384
+	0xc02f: true, // TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+	0xc030: true, // TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384
+	0xcca8: true, // TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256
+	0xcca9: true, // TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256
+	0xccaa: true, // TLS_DHE_RSA_WITH_CHACHA20_POLY1305_SHA256
+}
+
+// http2skipSecurityCheck disables http2checkConnState. It's set by
+// tests, which have no cipher suite that passes the check.
+var http2skipSecurityCheck = false
+
+// http2checkConnState returns an error if a TLS connection with the
+// given state may not be used for HTTP/2: RFC 7540, section 9.2,
+// requires TLS 1.2 or later and a cipher suite that isn't blacklisted.
+func http2checkConnState(state *tls.ConnectionState) error {
+	if http2skipSecurityCheck {
+		return nil
+	}
+	if state.Version < tls.VersionTLS12 {
+		return fmt.Errorf("http2: TLS version %#x is too old", state.Version)
+	}
+	if !http2goodCipherSuites[state.CipherSuite] {
+		return fmt.Errorf("http2: cipher suite %#04x is not allowed", state.CipherSuite)
+	}
+	return nil
+}
+
+const (
+	http2frameHeaderLen = 9
+
+	// http2initialWindowSize is the flow control window that both
+	// sides start with, for the connection and every stream.
+	http2initialWindowSize = 65535
+
+	// http2initialMaxFrameSize is the default SETTINGS_MAX_FRAME_SIZE.
+	http2initialMaxFrameSize = 16384
+
+	// http2maxFrameSizeLimit is the largest permitted
+	// SETTINGS_MAX_FRAME_SIZE.
+	http2maxFrameSizeLimit = 1<<24 - 1
+
+	// http2maxWindowSize is the largest permitted flow control window.
+	http2maxWindowSize = 1<<31 - 1
+
+	// http2initialHeaderTableSize is the default
+	// SETTINGS_HEADER_TABLE_SIZE.
+	http2initialHeaderTableSize = 4096
+)
+
+// An http2FrameType is a registered frame type as defined in RFC 7540,
+// section 11.2.
+type http2FrameType uint8
+
+const (
+	http2FrameData         http2FrameType = 0x0
+	http2FrameHeaders      http2FrameType = 0x1
+	http2FramePriority     http2FrameType = 0x2
+	http2FrameRSTStream    http2FrameType = 0x3
+	http2FrameSettings     http2FrameType = 0x4
+	http2FramePushPromise  http2FrameType = 0x5
+	http2FramePing         http2FrameType = 0x6
+	http2FrameGoAway       http2FrameType = 0x7
+	http2FrameWindowUpdate http2FrameType = 0x8
+	http2FrameContinuation http2FrameType = 0x9
+)
+
+var http2frameName = map[http2FrameType]string{
+	http2FrameData:         "DATA",
+	http2FrameHeaders:      "HEADERS",
+	http2FramePriority:     "PRIORITY",
+	http2FrameRSTStream:    "RST_STREAM",
+	http2FrameSettings:     "SETTINGS",
+	http2FramePushPromise:  "PUSH_PROMISE",
+	http2FramePing:         "PING",
+	http2FrameGoAway:       "GOAWAY",
+	http2FrameWindowUpdate: "WINDOW_UPDATE",
+	http2FrameContinuation: "CONTINUATION",
+}
+
+func (t http2FrameType) String() string {
+	if s, ok := http2frameName[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("UNKNOWN_FRAME_TYPE_%d", uint8(t))
+}
+
+// Frame flags. Their meaning depends on the frame type.
+const (
+	http2FlagEndStream  = 0x1
+	http2FlagAck        = 0x1
+	http2FlagEndHeaders = 0x4
+	http2FlagPadded     = 0x8
+	http2FlagPriority   = 0x20
+)
+
+// An http2SettingID is an HTTP/2 setting as defined in RFC 7540,
+// section 6.5.2.
+type http2SettingID uint16
+
+const (
+	http2SettingHeaderTableSize      http2SettingID = 0x1
+	http2SettingEnablePush           http2SettingID = 0x2
+	http2SettingMaxConcurrentStreams http2SettingID = 0x3
+	http2SettingInitialWindowSize    http2SettingID = 0x4
+	http2SettingMaxFrameSize         http2SettingID = 0x5
+	http2SettingMaxHeaderListSize    http2SettingID = 0x6
+)
+
+// An http2Setting is a setting parameter: which setting it is, and
+// its value.
+type http2Setting struct {
+	ID  http2SettingID
+	Val uint32
+}
+
+// valid reports whether the setting's value is within the range
+// permitted by RFC 7540, section 6.5.2.
+func (s http2Setting) valid() error {
+	switch s.ID {
+	case http2SettingEnablePush:
+		if s.Val != 0 && s.Val != 1 {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+	case http2SettingInitialWindowSize:
+		if s.Val > http2maxWindowSize {
+			return http2ConnectionError(http2ErrCodeFlowControl)
+		}
+	case http2SettingMaxFrameSize:
+		if s.Val < http2initialMaxFrameSize || s.Val > http2maxFrameSizeLimit {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+	}
+	return nil
+}
+
+// An http2ErrCode is an unsigned 32-bit error code as defined in
+// RFC 7540, section 7.
+type http2ErrCode uint32
+
+const (
+	http2ErrCodeNo                 http2ErrCode = 0x0
+	http2ErrCodeProtocol           http2ErrCode = 0x1
+	http2ErrCodeInternal           http2ErrCode = 0x2
+	http2ErrCodeFlowControl        http2ErrCode = 0x3
+	http2ErrCodeSettingsTimeout    http2ErrCode = 0x4
+	http2ErrCodeStreamClosed       http2ErrCode = 0x5
+	http2ErrCodeFrameSize          http2ErrCode = 0x6
+	http2ErrCodeRefusedStream      http2ErrCode = 0x7
+	http2ErrCodeCancel             http2ErrCode = 0x8
+	http2ErrCodeCompression        http2ErrCode = 0x9
+	http2ErrCodeConnect            http2ErrCode = 0xa
+	http2ErrCodeEnhanceYourCalm    http2ErrCode = 0xb
+	http2ErrCodeInadequateSecurity http2ErrCode = 0xc
+	http2ErrCodeHTTP11Required     http2ErrCode = 0xd
+)
+
+var http2errCodeName = map[http2ErrCode]string{
+	http2ErrCodeNo:                 "NO_ERROR",
+	http2ErrCodeProtocol:           "PROTOCOL_ERROR",
+	http2ErrCodeInternal:           "INTERNAL_ERROR",
+	http2ErrCodeFlowControl:        "FLOW_CONTROL_ERROR",
+	http2ErrCodeSettingsTimeout:    "SETTINGS_TIMEOUT",
+	http2ErrCodeStreamClosed:       "STREAM_CLOSED",
+	http2ErrCodeFrameSize:          "FRAME_SIZE_ERROR",
+	http2ErrCodeRefusedStream:      "REFUSED_STREAM",
+	http2ErrCodeCancel:             "CANCEL",
+	http2ErrCodeCompression:        "COMPRESSION_ERROR",
+	http2ErrCodeConnect:            "CONNECT_ERROR",
+	http2ErrCodeEnhanceYourCalm:    "ENHANCE_YOUR_CALM",
+	http2ErrCodeInadequateSecurity: "INADEQUATE_SECURITY",
+	http2ErrCodeHTTP11Required:     "HTTP_1_1_REQUIRED",
+}
+
+func (e http2ErrCode) String() string {
+	if s, ok := http2errCodeName[e]; ok {
+		return s
+	}
+	return fmt.Sprintf("unknown error code 0x%x", uint32(e))
+}
+
+// http2ConnectionError is an error that results in the termination
+// of the entire connection.
+type http2ConnectionError http2ErrCode
+
+func (e http2ConnectionError) Error() string {
+	return fmt.Sprintf("http2: connection error: %v", http2ErrCode(e))
+}
+
+// http2StreamError is an error that only affects one stream within
+// an HTTP/2 connection.
+type http2StreamError struct {
+	StreamID uint32
+	Code     http2ErrCode
+}
+
+func (e http2StreamError) Error() string {
+	return fmt.Sprintf("http2: stream error: stream ID %d; %v", e.StreamID, e.Code)
+}
+
+// http2GoAwayError is returned by the Transport when the server
+// closes the connection with a GOAWAY frame before a request's
+// stream was processed.
+type http2GoAwayError struct {
+	LastStreamID uint32
+	Code         http2ErrCode
+	DebugData    string
+}
+
+func (e http2GoAwayError) Error() string {
+	return fmt.Sprintf("http2: server sent GOAWAY and closed the connection; LastStreamID=%v, ErrCode=%v, debug=%q",
+		e.LastStreamID, e.Code, e.DebugData)
+}
+
+var http2errFrameSize = http2ConnectionError(http2ErrCodeFrameSize)
+
+// An http2FrameHeader is the 9 byte header of all HTTP/2 frames.
+type http2FrameHeader struct {
+	Type     http2FrameType
+	Flags    uint8
+	Length   uint32
+	StreamID uint32
+}
+
+func (h http2FrameHeader) has(flag uint8) bool {
+	return h.Flags&flag == flag
+}
+
+func (h http2FrameHeader) String() string {
+	return fmt.Sprintf("[%v flags=0x%x stream=%d len=%d]", h.Type, h.Flags, h.StreamID, h.Length)
+}
+
+// An http2Framer reads and writes HTTP/2 frames. Reading and writing
+// may happen concurrently, but at most one goroutine may read and one
+// goroutine may write at a time.
+type http2Framer struct {
+	r       io.Reader
+	hdrBuf  [http2frameHeaderLen]byte
+	readBuf []byte
+
+	// maxReadSize is the largest frame payload accepted from the
+	// peer; it is our advertised SETTINGS_MAX_FRAME_SIZE.
+	maxReadSize uint32
+
+	w    *bufio.Writer
+	wbuf []byte
+}
+
+func http2newFramer(w *bufio.Writer, r io.Reader) *http2Framer {
+	return &http2Framer{
+		r:           r,
+		w:           w,
+		maxReadSize: http2initialMaxFrameSize,
+	}
+}
+
+// ReadFrame reads a single frame. The returned payload is only valid
+// until the next call to ReadFrame.
+func (fr *http2Framer) ReadFrame() (http2FrameHeader, []byte, error) {
+	if _, err := io.ReadFull(fr.r, fr.hdrBuf[:]); err != nil {
+		return http2FrameHeader{}, nil, err
+	}
+	b := fr.hdrBuf[:]
+	fh := http2FrameHeader{
+		Length:   uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2]),
+		Type:     http2FrameType(b[3]),
+		Flags:    b[4],
+		StreamID: binary.BigEndian.Uint32(b[5:]) & (1<<31 - 1),
+	}
+	if fh.Length > fr.maxReadSize {
+		return fh, nil, http2errFrameSize
+	}
+	if uint32(cap(fr.readBuf)) < fh.Length {
+		fr.readBuf = make([]byte, fh.Length)
+	}
+	payload := fr.readBuf[:fh.Length]
+	if _, err := io.ReadFull(fr.r, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return fh, nil, err
+	}
+	if err := http2checkFrameLength(fh); err != nil {
+		return fh, nil, err
+	}
+	return fh, payload, nil
+}
+
+// http2checkFrameLength validates the stream ID and length of the
+// frame types with fixed-size payloads.
+func http2checkFrameLength(fh http2FrameHeader) error {
+	var want uint32
+	switch fh.Type {
+	case http2FramePriority:
+		want = 5
+	case http2FrameRSTStream, http2FrameWindowUpdate:
+		want = 4
+	case http2FramePing:
+		want = 8
+	case http2FrameSettings:
+		if fh.StreamID != 0 {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+		if fh.has(http2FlagAck) && fh.Length != 0 || fh.Length%6 != 0 {
+			return http2errFrameSize
+		}
+		return nil
+	case http2FrameGoAway:
+		if fh.StreamID != 0 {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+		if fh.Length < 8 {
+			return http2errFrameSize
+		}
+		return nil
+	default:
+		return nil
+	}
+	if fh.Length != want {
+		return http2errFrameSize
+	}
+	return nil
+}
+
+// http2stripPadding removes the padding from the payload of a
+// DATA, HEADERS or PUSH_PROMISE frame with the PADDED flag.
+func http2stripPadding(fh http2FrameHeader, p []byte) ([]byte, error) {
+	if !fh.has(http2FlagPadded) {
+		return p, nil
+	}
+	if len(p) < 1 {
+		return nil, http2errFrameSize
+	}
+	padLen := int(p[0])
+	p = p[1:]
+	if padLen > len(p) {
+		return nil, http2ConnectionError(http2ErrCodeProtocol)
+	}
+	return p[:len(p)-padLen], nil
+}
+
+// http2headersFragment returns the header block fragment in the
+// payload of a HEADERS frame.
+func http2headersFragment(fh http2FrameHeader, p []byte) ([]byte, error) {
+	p, err := http2stripPadding(fh, p)
+	if err != nil {
+		return nil, err
+	}
+	if fh.has(http2FlagPriority) {
+		// Stream dependency and weight, which we ignore.
+		if len(p) < 5 {
+			return nil, http2errFrameSize
+		}
+		p = p[5:]
+	}
+	return p, nil
+}
+
+// http2parseSettings returns the settings in the payload of a
+// SETTINGS frame.
+func http2parseSettings(p []byte) ([]http2Setting, error) {
+	settings := make([]http2Setting, 0, len(p)/6)
+	for ; len(p) >= 6; p = p[6:] {
+		s := http2Setting{
+			ID:  http2SettingID(binary.BigEndian.Uint16(p)),
+			Val: binary.BigEndian.Uint32(p[2:]),
+		}
+		if err := s.valid(); err != nil {
+			return nil, err
+		}
+		settings = append(settings, s)
+	}
+	return settings, nil
+}
+
+// http2parseWindowUpdate returns the increment in the payload of a
+// WINDOW_UPDATE frame.
+func http2parseWindowUpdate(fh http2FrameHeader, p []byte) (uint32, error) {
+	inc := binary.BigEndian.Uint32(p) & (1<<31 - 1)
+	if inc == 0 {
+		if fh.StreamID == 0 {
+			return 0, http2ConnectionError(http2ErrCodeProtocol)
+		}
+		return 0, http2StreamError{fh.StreamID, http2ErrCodeProtocol}
+	}
+	return inc, nil
+}
+
+// http2parseGoAway returns the contents of a GOAWAY frame.
+func http2parseGoAway(p []byte) http2GoAwayError {
+	return http2GoAwayError{
+		LastStreamID: binary.BigEndian.Uint32(p) & (1<<31 - 1),
+		Code:         http2ErrCode(binary.BigEndian.Uint32(p[4:])),
+		DebugData:    string(p[8:]),
+	}
+}
+
+var errHTTP2WriteTooLarge = errors.New("http2: frame too large")
+
+// startWrite begins a frame in the write buffer.
+func (fr *http2Framer) startWrite(t http2FrameType, flags uint8, streamID uint32) {
+	fr.wbuf = append(fr.wbuf[:0],
+		0, 0, 0, // length, filled in by endWrite
+		byte(t),
+		flags,
+		byte(streamID>>24),
+		byte(streamID>>16),
+		byte(streamID>>8),
+		byte(streamID))
+}
+
+// endWrite fills in the frame length and writes the buffered frame.
+// The frame is not flushed.
+func (fr *http2Framer) endWrite() error {
+	length := len(fr.wbuf) - http2frameHeaderLen
+	if length > http2maxFrameSizeLimit {
+		return errHTTP2WriteTooLarge
+	}
+	fr.wbuf[0] = byte(length >> 16)
+	fr.wbuf[1] = byte(length >> 8)
+	fr.wbuf[2] = byte(length)
+	_, err := fr.w.Write(fr.wbuf)
+	return err
+}
+
+func (fr *http2Framer) writeUint32(v uint32) {
+	fr.wbuf = append(fr.wbuf, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// WriteData writes a DATA frame.
+func (fr *http2Framer) WriteData(streamID uint32, endStream bool, data []byte) error {
+	var flags uint8
+	if endStream {
+		flags |= http2FlagEndStream
+	}
+	fr.startWrite(http2FrameData, flags, streamID)
+	fr.wbuf = append(fr.wbuf, data...)
+	return fr.endWrite()
+}
+
+// WriteHeaders writes a header block as a HEADERS frame followed by
+// as many CONTINUATION frames as needed to stay within maxFrameSize.
+func (fr *http2Framer) WriteHeaders(streamID uint32, endStream bool, block []byte, maxFrameSize uint32) error {
+	var flags uint8
+	if endStream {
+		flags |= http2FlagEndStream
+	}
+	return fr.writeHeaderBlock(http2FrameHeaders, flags, streamID, nil, block, maxFrameSize)
+}
+
+// WritePushPromise writes a PUSH_PROMISE frame, and any needed
+// CONTINUATION frames, promising the stream promiseID on streamID.
+func (fr *http2Framer) WritePushPromise(streamID, promiseID uint32, block []byte, maxFrameSize uint32) error {
+	prefix := []byte{byte(promiseID >> 24), byte(promiseID >> 16), byte(promiseID >> 8), byte(promiseID)}
+	return fr.writeHeaderBlock(http2FramePushPromise, 0, streamID, prefix, block, maxFrameSize)
+}
+
+func (fr *http2Framer) writeHeaderBlock(t http2FrameType, flags uint8, streamID uint32, prefix, block []byte, maxFrameSize uint32) error {
+	max := int(maxFrameSize) - len(prefix)
+	first := true
+	for first || len(block) > 0 {
+		frag := block
+		if len(frag) > max {
+			frag = frag[:max]
+		}
+		block = block[len(frag):]
+		f := flags
+		if len(block) == 0 {
+			f |= http2FlagEndHeaders
+		}
+		if first {
+			fr.startWrite(t, f, streamID)
+			fr.wbuf = append(fr.wbuf, prefix...)
+		} else {
+			fr.startWrite(http2FrameContinuation, f&http2FlagEndHeaders, streamID)
+		}
+		fr.wbuf = append(fr.wbuf, frag...)
+		if err := fr.endWrite(); err != nil {
+			return err
+		}
+		first = false
+		max = int(maxFrameSize)
+	}
+	return nil
+}
+
+// WriteSettings writes a SETTINGS frame with the given settings.
+func (fr *http2Framer) WriteSettings(settings ...http2Setting) error {
+	fr.startWrite(http2FrameSettings, 0, 0)
+	for _, s := range settings {
+		fr.wbuf = append(fr.wbuf, byte(s.ID>>8), byte(s.ID))
+		fr.writeUint32(s.Val)
+	}
+	return fr.endWrite()
+}
+
+// WriteSettingsAck writes an empty SETTINGS frame with the ACK flag.
+func (fr *http2Framer) WriteSettingsAck() error {
+	fr.startWrite(http2FrameSettings, http2FlagAck, 0)
+	return fr.endWrite()
+}
+
+// WritePing writes a PING frame.
+func (fr *http2Framer) WritePing(ack bool, data [8]byte) error {
+	var flags uint8
+	if ack {
+		flags = http2FlagAck
+	}
+	fr.startWrite(http2FramePing, flags, 0)
+	fr.wbuf = append(fr.wbuf, data[:]...)
+	return fr.endWrite()
+}
+
+// WriteGoAway writes a GOAWAY frame.
+func (fr *http2Framer) WriteGoAway(maxStreamID uint32, code http2ErrCode, debugData []byte) error {
+	fr.startWrite(http2FrameGoAway, 0, 0)
+	fr.writeUint32(maxStreamID & (1<<31 - 1))
+	fr.writeUint32(uint32(code))
+	fr.wbuf = append(fr.wbuf, debugData...)
+	return fr.endWrite()
+}
+
+// WriteWindowUpdate writes a WINDOW_UPDATE frame. A streamID of zero
+// updates the connection's window.
+func (fr *http2Framer) WriteWindowUpdate(streamID, incr uint32) error {
+	fr.startWrite(http2FrameWindowUpdate, 0, streamID)
+	fr.writeUint32(incr)
+	return fr.endWrite()
+}
+
+// WriteRSTStream writes a RST_STREAM frame.
+func (fr *http2Framer) WriteRSTStream(streamID uint32, code http2ErrCode) error {
+	fr.startWrite(http2FrameRSTStream, 0, streamID)
+	fr.writeUint32(uint32(code))
+	return fr.endWrite()
+}
+
+// Flush flushes any buffered frames to the underlying writer.
+func (fr *http2Framer) Flush() error {
+	return fr.w.Flush()
+}
@@ -0,0 +1,632 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// HPACK header compression for HTTP/2. See RFC 7541.
+
+package http
+
+import (
+	"errors"
+	"sync"
+)
+
+// An http2headerField is a name-value pair. Both the name and value
+// are treated as opaque sequences of octets.
+type http2headerField struct {
+	name, value string
+
+	// sensitive means that this header field should never be
+	// indexed.
+	sensitive bool
+}
+
+// size returns the size of an entry per RFC 7541, section 4.1.
+func (f http2headerField) size() uint32 {
+	return uint32(len(f.name) + len(f.value) + 32)
+}
+
+var (
+	errHPACKIndex    = errors.New("hpack: invalid header table index")
+	errHPACKInteger  = errors.New("hpack: invalid integer")
+	errHPACKTrunc    = errors.New("hpack: truncated header block")
+	errHPACKHuffman  = errors.New("hpack: invalid Huffman-encoded data")
+	errHPACKSize     = errors.New("hpack: invalid dynamic table size update")
+	errHPACKTooLarge = errors.New("hpack: string too long")
+)
+
+// http2headerTable is an HPACK dynamic table. Entries are kept oldest
+// first, so dynamic index 1 is the last element of ents.
+type http2headerTable struct {
+	ents    []http2headerField
+	size    uint32
+	maxSize uint32
+}
+
+func (t *http2headerTable) add(f http2headerField) {
+	t.ents = append(t.ents, f)
+	t.size += f.size()
+	t.evict()
+}
+
+// setMaxSize changes the table's capacity, evicting entries as needed.
+func (t *http2headerTable) setMaxSize(v uint32) {
+	t.maxSize = v
+	t.evict()
+}
+
+func (t *http2headerTable) evict() {
+	n := 0
+	for t.size > t.maxSize && n < len(t.ents) {
+		t.size -= t.ents[n].size()
+		n++
+	}
+	if n > 0 {
+		copy(t.ents, t.ents[n:])
+		for i := len(t.ents) - n; i < len(t.ents); i++ {
+			t.ents[i] = http2headerField{}
+		}
+		t.ents = t.ents[:len(t.ents)-n]
+	}
+}
+
+// at returns the entry at the given HPACK index, which addresses the
+// static table followed by the dynamic table.
+func (t *http2headerTable) at(i uint64) (http2headerField, bool) {
+	if i == 0 {
+		return http2headerField{}, false
+	}
+	if i <= uint64(len(http2staticTable)) {
+		return http2staticTable[i-1], true
+	}
+	i -= uint64(len(http2staticTable))
+	if i > uint64(len(t.ents)) {
+		return http2headerField{}, false
+	}
+	return t.ents[uint64(len(t.ents))-i], true
+}
+
+// search returns the index of an entry matching f, preferring an
+// entry whose value matches too. It returns zero if the name isn't
+// present in either table.
+func (t *http2headerTable) search(f http2headerField) (i uint64, nameValueMatch bool) {
+	http2staticIndexOnce.Do(http2buildStaticIndex)
+	if i, ok := http2staticIndex[f.name+"\x00"+f.value]; ok {
+		return i, true
+	}
+	for j := len(t.ents) - 1; j >= 0; j-- {
+		e := t.ents[j]
+		if e.name != f.name {
+			continue
+		}
+		idx := uint64(len(http2staticTable) + len(t.ents) - j)
+		if e.value == f.value {
+			return idx, true
+		}
+		if i == 0 {
+			i = idx
+		}
+	}
+	if i != 0 {
+		return i, false
+	}
+	return http2staticNameIndex[f.name], false
+}
+
+// http2staticTable is the HPACK static table from RFC 7541, appendix A.
+var http2staticTable = [...]http2headerField{
+	{name: ":authority"},
+	{name: ":method", value: "GET"},
+	{name: ":method", value: "POST"},
+	{name: ":path", value: "/"},
+	{name: ":path", value: "/index.html"},
+	{name: ":scheme", value: "http"},
+	{name: ":scheme", value: "https"},
+	{name: ":status", value: "200"},
+	{name: ":status", value: "204"},
+	{name: ":status", value: "206"},
+	{name: ":status", value: "304"},
+	{name: ":status", value: "400"},
+	{name: ":status", value: "404"},
+	{name: ":status", value: "500"},
+	{name: "accept-charset"},
+	{name: "accept-encoding", value: "gzip, deflate"},
+	{name: "accept-language"},
+	{name: "accept-ranges"},
+	{name: "accept"},
+	{name: "access-control-allow-origin"},
+	{name: "age"},
+	{name: "allow"},
+	{name: "authorization"},
+	{name: "cache-control"},
+	{name: "content-disposition"},
+	{name: "content-encoding"},
+	{name: "content-language"},
+	{name: "content-length"},
+	{name: "content-location"},
+	{name: "content-range"},
+	{name: "content-type"},
+	{name: "cookie"},
+	{name: "date"},
+	{name: "etag"},
+	{name: "expect"},
+	{name: "expires"},
+	{name: "from"},
+	{name: "host"},
+	{name: "if-match"},
+	{name: "if-modified-since"},
+	{name: "if-none-match"},
+	{name: "if-range"},
+	{name: "if-unmodified-since"},
+	{name: "last-modified"},
+	{name: "link"},
+	{name: "location"},
+	{name: "max-forwards"},
+	{name: "proxy-authenticate"},
+	{name: "proxy-authorization"},
+	{name: "range"},
+	{name: "referer"},
+	{name: "refresh"},
+	{name: "retry-after"},
+	{name: "server"},
+	{name: "set-cookie"},
+	{name: "strict-transport-security"},
+	{name: "transfer-encoding"},
+	{name: "user-agent"},
+	{name: "vary"},
+	{name: "via"},
+	{name: "www-authenticate"},
+}
+
+var (
+	http2staticIndexOnce sync.Once
+	http2staticIndex     map[string]uint64 // "name\x00value" => index
+	http2staticNameIndex map[string]uint64 // name => lowest index
+)
+
+func http2buildStaticIndex() {
+	http2staticIndex = make(map[string]uint64, len(http2staticTable))
+	http2staticNameIndex = make(map[string]uint64, len(http2staticTable))
+	for i, f := range http2staticTable {
+		idx := uint64(i + 1)
+		http2staticIndex[f.name+"\x00"+f.value] = idx
+		if _, ok := http2staticNameIndex[f.name]; !ok {
+			http2staticNameIndex[f.name] = idx
+		}
+	}
+}
+
+// An http2hpackEncoder encodes header fields into header blocks,
+// maintaining the dynamic table that its peer's decoder mirrors.
+type http2hpackEncoder struct {
+	table http2headerTable
+
+	// maxSizeLimit is the peer's SETTINGS_HEADER_TABLE_SIZE, the
+	// upper bound on table.maxSize.
+	maxSizeLimit uint32
+
+	// minSize is the smallest table size set since the last header
+	// block, and tableSizeUpdate reports whether the size changed.
+	// Both are signalled to the peer at the start of the next block.
+	minSize         uint32
+	tableSizeUpdate bool
+}
+
+func http2newHPACKEncoder() *http2hpackEncoder {
+	e := &http2hpackEncoder{maxSizeLimit: http2initialHeaderTableSize}
+	e.table.maxSize = http2initialHeaderTableSize
+	return e
+}
+
+// setMaxDynamicTableSizeLimit applies the peer's
+// SETTINGS_HEADER_TABLE_SIZE. The encoder never uses a table larger
+// than the default size of 4096 bytes.
+func (e *http2hpackEncoder) setMaxDynamicTableSizeLimit(v uint32) {
+	e.maxSizeLimit = v
+	if v > http2initialHeaderTableSize {
+		v = http2initialHeaderTableSize
+	}
+	if v == e.table.maxSize {
+		return
+	}
+	if !e.tableSizeUpdate || v < e.minSize {
+		e.minSize = v
+	}
+	e.tableSizeUpdate = true
+	e.table.setMaxSize(v)
+}
+
+// encode appends the header block for fields to dst.
+func (e *http2hpackEncoder) encode(dst []byte, fields []http2headerField) []byte {
+	if e.tableSizeUpdate {
+		e.tableSizeUpdate = false
+		if e.minSize < e.table.maxSize {
+			dst = http2appendVarInt(dst, 5, 0x20, uint64(e.minSize))
+		}
+		dst = http2appendVarInt(dst, 5, 0x20, uint64(e.table.maxSize))
+	}
+	for _, f := range fields {
+		dst = e.appendField(dst, f)
+	}
+	return dst
+}
+
+func (e *http2hpackEncoder) appendField(dst []byte, f http2headerField) []byte {
+	idx, nameValueMatch := e.table.search(f)
+	if nameValueMatch && !f.sensitive {
+		// Indexed header field, RFC 7541 section 6.1.
+		return http2appendVarInt(dst, 7, 0x80, idx)
+	}
+
+	// Literal header field, RFC 7541 section 6.2.
+	indexing := !f.sensitive && f.size() <= e.table.maxSize
+	switch {
+	case f.sensitive:
+		dst = http2appendVarInt(dst, 4, 0x10, idx)
+	case indexing:
+		dst = http2appendVarInt(dst, 6, 0x40, idx)
+	default:
+		dst = http2appendVarInt(dst, 4, 0, idx)
+	}
+	if idx == 0 {
+		dst = http2appendHPACKString(dst, f.name)
+	}
+	dst = http2appendHPACKString(dst, f.value)
+	if indexing {
+		e.table.add(http2headerField{name: f.name, value: f.value})
+	}
+	return dst
+}
+
+// http2appendVarInt appends i encoded with an n-bit prefix, as
+// described in RFC 7541 section 5.1. The bits of first not covered by
+// the prefix are the representation's leading bits.
+func http2appendVarInt(dst []byte, n uint, first byte, i uint64) []byte {
+	k := uint64(1)<<n - 1
+	if i < k {
+		return append(dst, first|byte(i))
+	}
+	dst = append(dst, first|byte(k))
+	i -= k
+	for ; i >= 128; i >>= 7 {
+		dst = append(dst, byte(0x80|i&0x7f))
+	}
+	return append(dst, byte(i))
+}
+
+// http2readVarInt decodes an integer with an n-bit prefix from p.
+func http2readVarInt(n uint, p []byte) (i uint64, rest []byte, err error) {
+	if len(p) == 0 {
+		return 0, p, errHPACKTrunc
+	}
+	k := uint64(1)<<n - 1
+	i = uint64(p[0]) & k
+	p = p[1:]
+	if i < k {
+		return i, p, nil
+	}
+	var m uint
+	for len(p) > 0 {
+		b := p[0]
+		p = p[1:]
+		i += uint64(b&0x7f) << m
+		if b&0x80 == 0 {
+			return i, p, nil
+		}
+		m += 7
+		if m >= 63 {
+			return 0, nil, errHPACKInteger
+		}
+	}
+	return 0, nil, errHPACKTrunc
+}
+
+// http2appendHPACKString appends s as a string literal, Huffman
+// encoded if that is shorter.
+func http2appendHPACKString(dst []byte, s string) []byte {
+	if n := http2huffmanEncodedLen(s); n < len(s) {
+		dst = http2appendVarInt(dst, 7, 0x80, uint64(n))
+		return http2appendHuffman(dst, s)
+	}
+	dst = http2appendVarInt(dst, 7, 0, uint64(len(s)))
+	return append(dst, s...)
+}
+
+// An http2hpackDecoder decodes header blocks, maintaining the dynamic
+// table that mirrors its peer's encoder.
+type http2hpackDecoder struct {
+	table http2headerTable
+
+	// maxAllowedSize is our SETTINGS_HEADER_TABLE_SIZE, the largest
+	// size the peer may set the table to.
+	maxAllowedSize uint32
+
+	// maxStringLen bounds the length of decoded names and values.
+	maxStringLen int
+}
+
+func http2newHPACKDecoder(maxStringLen int) *http2hpackDecoder {
+	d := &http2hpackDecoder{
+		maxAllowedSize: http2initialHeaderTableSize,
+		maxStringLen:   maxStringLen,
+	}
+	d.table.maxSize = http2initialHeaderTableSize
+	return d
+}
+
+// decode decodes a complete header block.
+func (d *http2hpackDecoder) decode(p []byte) ([]http2headerField, error) {
+	var fields []http2headerField
+	for len(p) > 0 {
+		b := p[0]
+		var err error
+		switch {
+		case b&0x80 != 0:
+			// Indexed header field.
+			var idx uint64
+			if idx, p, err = http2readVarInt(7, p); err != nil {
+				return nil, err
+			}
+			f, ok := d.table.at(idx)
+			if !ok {
+				return nil, errHPACKIndex
+			}
+			fields = append(fields, f)
+		case b&0xc0 == 0x40:
+			// Literal with incremental indexing.
+			var f http2headerField
+			if f, p, err = d.readLiteral(6, p); err != nil {
+				return nil, err
+			}
+			fields = append(fields, f)
+			d.table.add(f)
+		case b&0xe0 == 0x20:
+			// Dynamic table size update; only allowed at the
+			// start of a header block.
+			if len(fields) > 0 {
+				return nil, errHPACKSize
+			}
+			var size uint64
+			if size, p, err = http2readVarInt(5, p); err != nil {
+				return nil, err
+			}
+			if size > uint64(d.maxAllowedSize) {
+				return nil, errHPACKSize
+			}
+			d.table.setMaxSize(uint32(size))
+		default:
+			// Literal without indexing (0000) or never
+			// indexed (0001).
+			var f http2headerField
+			if f, p, err = d.readLiteral(4, p); err != nil {
+				return nil, err
+			}
+			f.sensitive = b&0x10 != 0
+			fields = append(fields, f)
+		}
+	}
+	return fields, nil
+}
+
+func (d *http2hpackDecoder) readLiteral(n uint, p []byte) (f http2headerField, rest []byte, err error) {
+	idx, p, err := http2readVarInt(n, p)
+	if err != nil {
+		return f, nil, err
+	}
+	if idx > 0 {
+		ent, ok := d.table.at(idx)
+		if !ok {
+			return f, nil, errHPACKIndex
+		}
+		f.name = ent.name
+	} else if f.name, p, err = d.readString(p); err != nil {
+		return f, nil, err
+	}
+	if f.value, p, err = d.readString(p); err != nil {
+		return f, nil, err
+	}
+	return f, p, nil
+}
+
+func (d *http2hpackDecoder) readString(p []byte) (s string, rest []byte, err error) {
+	if len(p) == 0 {
+		return "", nil, errHPACKTrunc
+	}
+	huff := p[0]&0x80 != 0
+	n, p, err := http2readVarInt(7, p)
+	if err != nil {
+		return "", nil, err
+	}
+	if n > uint64(len(p)) {
+		return "", nil, errHPACKTrunc
+	}
+	if d.maxStringLen > 0 && n > uint64(d.maxStringLen) {
+		return "", nil, errHPACKTooLarge
+	}
+	b := p[:n]
+	p = p[n:]
+	if !huff {
+		return string(b), p, nil
+	}
+	dec, err := http2huffmanDecode(nil, b)
+	if err != nil {
+		return "", nil, err
+	}
+	if d.maxStringLen > 0 && len(dec) > d.maxStringLen {
+		return "", nil, errHPACKTooLarge
+	}
+	return string(dec), p, nil
+}
+
+// http2huffmanEncodedLen returns the number of bytes s occupies once
+// Huffman encoded.
+func http2huffmanEncodedLen(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n += int(http2huffmanCodeLen[s[i]])
+	}
+	return (n + 7) / 8
+}
+
+// http2appendHuffman appends the Huffman encoding of s to dst, padded
+// with the most significant bits of the EOS symbol.
+func http2appendHuffman(dst []byte, s string) []byte {
+	var x uint64
+	var n uint // number of pending bits in x
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		n += uint(http2huffmanCodeLen[c])
+		x = x<<http2huffmanCodeLen[c] | uint64(http2huffmanCodes[c])
+		for n >= 8 {
+			n -= 8
+			dst = append(dst, byte(x>>n))
+		}
+	}
+	if n > 0 {
+		x = x<<(8-n) | (1<<(8-n) - 1)
+		dst = append(dst, byte(x))
+	}
+	return dst
+}
+
+// An http2huffmanNode is a node of the Huffman decoding tree. Internal
+// nodes consume 8 bits at a time; leaves hold a symbol and the number
+// of bits of the final byte that its code uses.
+type http2huffmanNode struct {
+	children *[256]*http2huffmanNode // nil for leaves
+	codeLen  uint8
+	sym      byte
+}
+
+var (
+	http2huffmanRootOnce sync.Once
+	http2huffmanRoot     *http2huffmanNode
+)
+
+func http2buildHuffmanTree() {
+	http2huffmanRoot = &http2huffmanNode{children: new([256]*http2huffmanNode)}
+	for i, code := range http2huffmanCodes {
+		codeLen := http2huffmanCodeLen[i]
+		cur := http2huffmanRoot
+		for codeLen > 8 {
+			codeLen -= 8
+			j := uint8(code >> codeLen)
+			if cur.children[j] == nil {
+				cur.children[j] = &http2huffmanNode{children: new([256]*http2huffmanNode)}
+			}
+			cur = cur.children[j]
+		}
+		shift := 8 - codeLen
+		start, end := int(uint8(code<<shift)), 1<<shift
+		for j := start; j < start+end; j++ {
+			cur.children[j] = &http2huffmanNode{sym: byte(i), codeLen: codeLen}
+		}
+	}
+}
+
+// http2huffmanDecode appends the decoding of the Huffman-encoded v to
+// dst.
+func http2huffmanDecode(dst []byte, v []byte) ([]byte, error) {
+	http2huffmanRootOnce.Do(http2buildHuffmanTree)
+	root := http2huffmanRoot
+	n := root
+	// cur is the bit buffer; cbits is the number of unconsumed bits
+	// in it, and sbits the number of bits of the current symbol.
+	cur, cbits, sbits := uint(0), uint8(0), uint8(0)
+	for _, b := range v {
+		cur = cur<<8 | uint(b)
+		cbits += 8
+		sbits += 8
+		for cbits >= 8 {
+			n = n.children[byte(cur>>(cbits-8))]
+			if n == nil {
+				return nil, errHPACKHuffman
+			}
+			if n.children == nil {
+				dst = append(dst, n.sym)
+				cbits -= n.codeLen
+				n = root
+				sbits = cbits
+			} else {
+				cbits -= 8
+			}
+		}
+	}
+	for cbits > 0 {
+		n = n.children[byte(cur<<(8-cbits))]
+		if n == nil {
+			return nil, errHPACKHuffman
+		}
+		if n.children != nil || n.codeLen > cbits {
+			break
+		}
+		dst = append(dst, n.sym)
+		cbits -= n.codeLen
+		n = root
+		sbits = cbits
+	}
+	if sbits > 7 {
+		// Padding longer than 7 bits, RFC 7541 section 5.2.
+		return nil, errHPACKHuffman
+	}
+	if mask := uint(1<<cbits - 1); cur&mask != mask {
+		// Padding that isn't a prefix of EOS.
+		return nil, errHPACKHuffman
+	}
+	return dst, nil
+}
+
+// The Huffman code from RFC 7541, appendix B.
+var http2huffmanCodes = [256]uint32{
+	0x1ff8, 0x7fffd8, 0xfffffe2, 0xfffffe3, 0xfffffe4, 0xfffffe5, 0xfffffe6, 0xfffffe7,
+	0xfffffe8, 0xffffea, 0x3ffffffc, 0xfffffe9, 0xfffffea, 0x3ffffffd, 0xfffffeb, 0xfffffec,
+	0xfffffed, 0xfffffee, 0xfffffef, 0xffffff0, 0xffffff1, 0xffffff2, 0x3ffffffe, 0xffffff3,
+	0xffffff4, 0xffffff5, 0xffffff6, 0xffffff7, 0xffffff8, 0xffffff9, 0xffffffa, 0xffffffb,
+	0x14, 0x3f8, 0x3f9, 0xffa, 0x1ff9, 0x15, 0xf8, 0x7fa,
+	0x3fa, 0x3fb, 0xf9, 0x7fb, 0xfa, 0x16, 0x17, 0x18,
+	0x0, 0x1, 0x2, 0x19, 0x1a, 0x1b, 0x1c, 0x1d,
+	0x1e, 0x1f, 0x5c, 0xfb, 0x7ffc, 0x20, 0xffb, 0x3fc,
+	0x1ffa, 0x21, 0x5d, 0x5e, 0x5f, 0x60, 0x61, 0x62,
+	0x63, 0x64, 0x65, 0x66, 0x67, 0x68, 0x69, 0x6a,
+	0x6b, 0x6c, 0x6d, 0x6e, 0x6f, 0x70, 0x71, 0x72,
+	0xfc, 0x73, 0xfd, 0x1ffb, 0x7fff0, 0x1ffc, 0x3ffc, 0x22,
+	0x7ffd, 0x3, 0x23, 0x4, 0x24, 0x5, 0x25, 0x26,
+	0x27, 0x6, 0x74, 0x75, 0x28, 0x29, 0x2a, 0x7,
+	0x2b, 0x76, 0x2c, 0x8, 0x9, 0x2d, 0x77, 0x78,
+	0x79, 0x7a, 0x7b, 0x7ffe, 0x7fc, 0x3ffd, 0x1ffd, 0xffffffc,
+	0xfffe6, 0x3fffd2, 0xfffe7, 0xfffe8, 0x3fffd3, 0x3fffd4, 0x3fffd5, 0x7fffd9,
+	0x3fffd6, 0x7fffda, 0x7fffdb, 0x7fffdc, 0x7fffdd, 0x7fffde, 0xffffeb, 0x7fffdf,
+	0xffffec, 0xffffed, 0x3fffd7, 0x7fffe0, 0xffffee, 0x7fffe1, 0x7fffe2, 0x7fffe3,
+	0x7fffe4, 0x1fffdc, 0x3fffd8, 0x7fffe5, 0x3fffd9, 0x7fffe6, 0x7fffe7, 0xffffef,
+	0x3fffda, 0x1fffdd, 0xfffe9, 0x3fffdb, 0x3fffdc, 0x7fffe8, 0x7fffe9, 0x1fffde,
+	0x7fffea, 0x3fffdd, 0x3fffde, 0xfffff0, 0x1fffdf, 0x3fffdf, 0x7fffeb, 0x7fffec,
+	0x1fffe0, 0x1fffe1, 0x3fffe0, 0x1fffe2, 0x7fffed, 0x3fffe1, 0x7fffee, 0x7fffef,
+	0xfffea, 0x3fffe2, 0x3fffe3, 0x3fffe4, 0x7ffff0, 0x3fffe5, 0x3fffe6, 0x7ffff1,
+	0x3ffffe0, 0x3ffffe1, 0xfffeb, 0x7fff1, 0x3fffe7, 0x7ffff2, 0x3fffe8, 0x1ffffec,
+	0x3ffffe2, 0x3ffffe3, 0x3ffffe4, 0x7ffffde, 0x7ffffdf, 0x3ffffe5, 0xfffff1, 0x1ffffed,
+	0x7fff2, 0x1fffe3, 0x3ffffe6, 0x7ffffe0, 0x7ffffe1, 0x3ffffe7, 0x7ffffe2, 0xfffff2,
+	0x1fffe4, 0x1fffe5, 0x3ffffe8, 0x3ffffe9, 0xffffffd, 0x7ffffe3, 0x7ffffe4, 0x7ffffe5,
+	0xfffec, 0xfffff3, 0xfffed, 0x1fffe6, 0x3fffe9, 0x1fffe7, 0x1fffe8, 0x7ffff3,
+	0x3fffea, 0x3fffeb, 0x1ffffee, 0x1ffffef, 0xfffff4, 0xfffff5, 0x3ffffea, 0x7ffff4,
+	0x3ffffeb, 0x7ffffe6, 0x3ffffec, 0x3ffffed, 0x7ffffe7, 0x7ffffe8, 0x7ffffe9, 0x7ffffea,
+	0x7ffffeb, 0xffffffe, 0x7ffffec, 0x7ffffed, 0x7ffffee, 0x7ffffef, 0x7fffff0, 0x3ffffee,
+}
+
+var http2huffmanCodeLen = [256]uint8{
+	13, 23, 28, 28, 28, 28, 28, 28, 28, 24, 30, 28, 28, 30, 28, 28,
+	28, 28, 28, 28, 28, 28, 30, 28, 28, 28, 28, 28, 28, 28, 28, 28,
+	6, 10, 10, 12, 13, 6, 8, 11, 10, 10, 8, 11, 8, 6, 6, 6,
+	5, 5, 5, 6, 6, 6, 6, 6, 6, 6, 7, 8, 15, 6, 12, 10,
+	13, 6, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+	7, 7, 7, 7, 7, 7, 7, 7, 8, 7, 8, 13, 19, 13, 14, 6,
+	15, 5, 6, 5, 6, 5, 6, 6, 6, 5, 7, 7, 6, 6, 6, 5,
+	6, 7, 6, 5, 5, 6, 7, 7, 7, 7, 7, 15, 11, 14, 13, 28,
+	20, 22, 20, 20, 22, 22, 22, 23, 22, 23, 23, 23, 23, 23, 24, 23,
+	24, 24, 22, 23, 24, 23, 23, 23, 23, 21, 22, 23, 22, 23, 23, 24,
+	22, 21, 20, 22, 22, 23, 23, 21, 23, 22, 22, 24, 21, 22, 23, 23,
+	21, 21, 22, 21, 23, 22, 23, 23, 20, 22, 22, 22, 23, 22, 22, 23,
+	26, 26, 20, 19, 22, 23, 22, 25, 26, 26, 26, 27, 27, 26, 24, 25,
+	19, 21, 26, 27, 27, 26, 27, 24, 21, 21, 26, 26, 28, 27, 27, 27,
+	20, 24, 20, 21, 22, 21, 21, 23, 22, 22, 25, 25, 24, 24, 26, 23,
+	26, 27, 26, 26, 27, 27, 27, 27, 27, 28, 27, 27, 27, 27, 27, 26,
+}
@@ -0,0 +1,120 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http
+
+import (
+	"bytes"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func hf(name, value string) http2headerField {
+	return http2headerField{name: name, value: value}
+}
+
+// Three consecutive request header blocks on one connection, from
+// RFC 7541 appendix C.3 (without Huffman coding) and C.4 (with).
+var hpackRequests = [][]http2headerField{
+	{hf(":method", "GET"), hf(":scheme", "http"), hf(":path", "/"), hf(":authority", "www.example.com")},
+	{hf(":method", "GET"), hf(":scheme", "http"), hf(":path", "/"), hf(":authority", "www.example.com"), hf("cache-control", "no-cache")},
+	{hf(":method", "GET"), hf(":scheme", "https"), hf(":path", "/index.html"), hf(":authority", "www.example.com"), hf("custom-key", "custom-value")},
+}
+
+var hpackPlainBlocks = []string{
+	"828684410f7777772e6578616d706c652e636f6d",
+	"828684be58086e6f2d6361636865",
+	"828785bf400a637573746f6d2d6b65790c637573746f6d2d76616c7565",
+}
+
+var hpackHuffmanBlocks = []string{
+	"828684418cf1e3c2e5f23a6ba0ab90f4ff",
+	"828684be5886a8eb10649cbf",
+	"828785bf408825a849e95ba97d7f8925a849e95bb8e8b4bf",
+}
+
+func TestHPACKDecode(t *testing.T) {
+	for _, blocks := range [][]string{hpackPlainBlocks, hpackHuffmanBlocks} {
+		d := http2newHPACKDecoder(1 << 20)
+		for i, h := range blocks {
+			block, _ := hex.DecodeString(h)
+			fields, err := d.decode(block)
+			if err != nil {
+				t.Errorf("block %s: %v", h, err)
+				break
+			}
+			if !reflect.DeepEqual(fields, hpackRequests[i]) {
+				t.Errorf("block %s decoded to %v; want %v", h, fields, hpackRequests[i])
+			}
+		}
+	}
+}
+
+func TestHPACKEncode(t *testing.T) {
+	e := http2newHPACKEncoder()
+	for i, fields := range hpackRequests {
+		got := hex.EncodeToString(e.encode(nil, fields))
+		if got != hpackHuffmanBlocks[i] {
+			t.Errorf("request %d encoded to %s; want %s", i, got, hpackHuffmanBlocks[i])
+		}
+	}
+}
+
+func TestHPACKRoundTrip(t *testing.T) {
+	e := http2newHPACKEncoder()
+	d := http2newHPACKDecoder(1 << 20)
+	fields := []http2headerField{
+		hf("content-type", "text/html; charset=utf-8"),
+		hf("x-long", strings.Repeat("abc~\x7f", 1000)),
+		{name: "authorization", value: "secret", sensitive: true},
+		hf("x-empty", ""),
+	}
+	for i := 0; i < 3; i++ {
+		got, err := d.decode(e.encode(nil, fields))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, fields) {
+			t.Fatalf("round %d: got %v; want %v", i, got, fields)
+		}
+	}
+	// Sensitive fields must never be added to the dynamic table.
+	if _, match := e.table.search(fields[2]); match {
+		t.Errorf("sensitive field was indexed")
+	}
+}
+
+func TestHPACKDecodeErrors(t *testing.T) {
+	tests := []string{
+		"be",       // index past the end of the dynamic table
+		"80",       // index zero
+		"410f7777", // truncated string
+		"3fe1ff",   // table size update above the limit
+	}
+	for _, h := range tests {
+		block, _ := hex.DecodeString(h)
+		if _, err := http2newHPACKDecoder(1 << 20).decode(block); err == nil {
+			t.Errorf("decode(%s) succeeded; want error", h)
+		}
+	}
+}
+
+func TestHuffmanRoundTrip(t *testing.T) {
+	var all bytes.Buffer
+	for i := 0; i < 256; i++ {
+		all.WriteByte(byte(i))
+	}
+	for _, s := range []string{"", "www.example.com", "no-cache", all.String()} {
+		enc := http2appendHuffman(nil, s)
+		if len(enc) != http2huffmanEncodedLen(s) {
+			t.Errorf("%q: encoded length %d; huffmanEncodedLen = %d", s, len(enc), http2huffmanEncodedLen(s))
+		}
+		dec, err := http2huffmanDecode(nil, enc)
+		if err != nil || string(dec) != s {
+			t.Errorf("%q: decoded to %q, %v", s, dec, err)
+		}
+	}
+}
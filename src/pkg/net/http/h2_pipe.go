@@ -0,0 +1,95 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http
+
+import (
+	"bytes"
+	"sync"
+)
+
+// http2pipe is a goroutine-safe buffered pipe carrying the body of
+// an HTTP/2 request or response from the connection's read loop to
+// the Handler or Client. Writes never block; the amount of buffered
+// data is bounded by the flow control window instead.
+type http2pipe struct {
+	mu       sync.Mutex
+	c        sync.Cond // c.L is &mu; signalled on writes and closes
+	b        bytes.Buffer
+	err      error // returned by Read once b is drained
+	breakErr error // returned by Read immediately; b is discarded
+
+	// onRead, if non-nil, is called without mu held after Read
+	// consumes n bytes, so the reader can return flow control
+	// credit to the peer.
+	onRead func(n int)
+}
+
+func http2newPipe(onRead func(n int)) *http2pipe {
+	p := &http2pipe{onRead: onRead}
+	p.c.L = &p.mu
+	return p
+}
+
+// Read waits until data is available and copies it into d.
+func (p *http2pipe) Read(d []byte) (n int, err error) {
+	p.mu.Lock()
+	for p.breakErr == nil && p.b.Len() == 0 && p.err == nil {
+		p.c.Wait()
+	}
+	switch {
+	case p.breakErr != nil:
+		err = p.breakErr
+	case p.b.Len() > 0:
+		n, _ = p.b.Read(d)
+	default:
+		err = p.err
+	}
+	p.mu.Unlock()
+	if n > 0 && p.onRead != nil {
+		p.onRead(n)
+	}
+	return n, err
+}
+
+// Write buffers d for the reader. It fails if the pipe has been
+// closed or broken.
+func (p *http2pipe) Write(d []byte) (n int, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.breakErr != nil {
+		return 0, p.breakErr
+	}
+	if p.err != nil {
+		return 0, errHTTP2StreamClosed
+	}
+	defer p.c.Signal()
+	return p.b.Write(d)
+}
+
+// CloseWithError causes Read to return err once all buffered data
+// has been read. Only the first close takes effect.
+func (p *http2pipe) CloseWithError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err == nil {
+		p.err = err
+		p.c.Broadcast()
+	}
+}
+
+// BreakWithError causes Read to return err immediately, discarding
+// any buffered data. It returns the number of bytes discarded.
+func (p *http2pipe) BreakWithError(err error) (discarded int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.breakErr != nil {
+		return 0
+	}
+	p.breakErr = err
+	discarded = p.b.Len()
+	p.b.Reset()
+	p.c.Broadcast()
+	return discarded
+}
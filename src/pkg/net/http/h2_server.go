@@ -0,0 +1,1404 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// HTTP/2 server. See RFC 7540.
+//
+// A Server speaks HTTP/2 on TLS connections that negotiate the "h2"
+// protocol with ALPN. Each connection has one goroutine reading
+// frames and one goroutine per stream running its Handler. Writes
+// from any goroutine are serialized by the connection's write mutex;
+// the connection's state, including the flow control windows, is
+// guarded by a separate mutex whose condition variable wakes writers
+// waiting for window space.
+
+package http
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// http2maxConcurrentStreams is the SETTINGS_MAX_CONCURRENT_STREAMS
+	// advertised by the server.
+	http2maxConcurrentStreams = 250
+
+	// http2goAwayTimeout is how long a connection that has sent a
+	// graceful GOAWAY lingers after its last stream completes,
+	// waiting for the client to close it.
+	http2goAwayTimeout = 1 * time.Second
+)
+
+var (
+	errHTTP2StreamClosed  = errors.New("http2: stream closed")
+	errHTTP2ClientGone    = errors.New("http2: client connection lost")
+	errHTTP2ClientReset   = errors.New("http2: client reset stream")
+	errHTTP2RecursivePush = errors.New("http2: recursive push not allowed")
+)
+
+// Pusher is the interface implemented by ResponseWriters that support
+// HTTP/2 server push.
+type Pusher interface {
+	// Push initiates an HTTP/2 server push. This constructs a
+	// synthetic request using the given target and options,
+	// serializes that request into a PUSH_PROMISE frame, then
+	// dispatches that request using the server's request handler.
+	//
+	// The target must either be an absolute path (like "/path") or
+	// an absolute URL that contains a valid host and the same
+	// scheme as the parent request. If opts is nil, default options
+	// are used.
+	//
+	// Push returns ErrNotSupported if the client has disabled push
+	// or if push is not supported on the underlying connection.
+	// Push must be called before the response to the parent
+	// request is complete.
+	Push(target string, opts *PushOptions) error
+}
+
+// PushOptions describes options for Pusher.Push.
+type PushOptions struct {
+	// Method specifies the HTTP method for the promised request.
+	// If set, it must be "GET" or "HEAD". Empty means "GET".
+	Method string
+
+	// Header specifies additional promised request headers. This
+	// cannot include HTTP/2 pseudo header fields like ":path"
+	// and ":scheme", which will be added automatically.
+	Header Header
+}
+
+// setupHTTP2 enables HTTP/2 on srv if the user asked for it and
+// didn't configure TLSNextProto themselves.
+func (srv *Server) setupHTTP2() {
+	srv.nextProtoOnce.Do(srv.onceSetNextProtoDefaults)
+}
+
+func (srv *Server) onceSetNextProtoDefaults() {
+	if srv.EnableHTTP2 && srv.TLSNextProto == nil {
+		srv.TLSNextProto = map[string]func(*Server, *tls.Conn, Handler){
+			http2NextProtoTLS: http2serveConn,
+		}
+	}
+}
+
+func (srv *Server) trackHTTP2Conn(sc *http2serverConn, add bool) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if srv.h2conns == nil {
+		srv.h2conns = make(map[*http2serverConn]bool)
+	}
+	if add {
+		srv.h2conns[sc] = true
+	} else {
+		delete(srv.h2conns, sc)
+	}
+}
+
+// shutdownHTTP2Conns sends a graceful GOAWAY on all of the server's
+// HTTP/2 connections.
+func (srv *Server) shutdownHTTP2Conns() {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	for sc := range srv.h2conns {
+		go sc.startGracefulShutdown()
+	}
+}
+
+// http2serverConn is the server side of an HTTP/2 connection.
+type http2serverConn struct {
+	srv        *Server
+	handler    Handler
+	conn       *tls.Conn
+	remoteAddr string
+	tlsState   *tls.ConnectionState
+	framer     *http2Framer
+
+	// Owned by the serve goroutine.
+	hdec            *http2hpackDecoder
+	headerStream    uint32 // stream of the header block being read
+	headerEndStream bool   // whether that block's HEADERS had END_STREAM
+	headerBlock     []byte
+	inContinuation  bool
+
+	wmu  sync.Mutex // guards the framer's writes, henc and hbuf
+	henc *http2hpackEncoder
+	hbuf []byte
+
+	mu                sync.Mutex
+	cond              sync.Cond // c.L is &mu; signalled when windows grow or streams end
+	streams           map[uint32]*http2serverStream
+	maxClientStreamID uint32
+	nextPushID        uint32
+	curClientStreams  int
+	curPushStreams    int
+	pushEnabled       bool
+	peerMaxStreams    uint32
+	peerMaxFrameSize  uint32
+	peerInitialWindow int32
+	sendWindow        int32 // connection window for our DATA frames
+	recvWindow        int32 // connection window for the client's DATA frames
+	recvUnacked       int32 // consumed bytes not yet returned to the client
+	goAwaySent        bool
+	closed            bool
+	idleTimer         *time.Timer // running while there are no streams, if the Server has an IdleTimeout
+}
+
+// http2serverStream is a stream on an http2serverConn. Its fields are
+// guarded by the connection's mu.
+type http2serverStream struct {
+	id    uint32
+	req   *Request
+	body  *http2pipe // nil if the request has no body
+	rbody *http2requestBody
+
+	declBodyBytes int64 // Content-Length of the request, or -1
+	bodyBytes     int64 // bytes of request body received
+
+	// trailer holds the request trailers until the handler reads
+	// the body to EOF, when they're moved to req.Trailer. It's
+	// written by the read loop before the body pipe is closed.
+	trailer Header
+
+	sendWindow   int32
+	recvWindow   int32
+	recvUnacked  int32
+	remoteClosed bool // END_STREAM received, or a pushed stream
+	localClosed  bool // END_STREAM sent
+	reset        bool // RST_STREAM sent or received, or connection lost
+	closeNotifyc chan bool
+}
+
+// http2serveConn serves HTTP/2 on a TLS connection that negotiated
+// "h2". It is the TLSNextProto entry of a Server with EnableHTTP2 set.
+func http2serveConn(srv *Server, c *tls.Conn, h Handler) {
+	sc := &http2serverConn{
+		srv:               srv,
+		handler:           h,
+		conn:              c,
+		remoteAddr:        c.RemoteAddr().String(),
+		hdec:              http2newHPACKDecoder(srv.maxHeaderBytes()),
+		henc:              http2newHPACKEncoder(),
+		streams:           make(map[uint32]*http2serverStream),
+		nextPushID:        2,
+		pushEnabled:       true,
+		peerMaxStreams:    1<<32 - 1,
+		peerMaxFrameSize:  http2initialMaxFrameSize,
+		peerInitialWindow: http2initialWindowSize,
+		sendWindow:        http2initialWindowSize,
+		recvWindow:        http2initialWindowSize,
+	}
+	sc.cond.L = &sc.mu
+	state := c.ConnectionState()
+	sc.tlsState = &state
+	sc.framer = http2newFramer(bufio.NewWriter(c), bufio.NewReader(c))
+
+	srv.trackHTTP2Conn(sc, true)
+	defer srv.trackHTTP2Conn(sc, false)
+	sc.serve()
+}
+
+func (sc *http2serverConn) serve() {
+	defer sc.close()
+
+	// The deadlines set for the TLS handshake by conn.serve don't
+	// make sense for a long-lived, multiplexed connection.
+	sc.conn.SetDeadline(time.Time{})
+
+	if err := http2checkConnState(sc.tlsState); err != nil {
+		sc.writeFrame(func(fr *http2Framer) error {
+			return fr.WriteGoAway(0, http2ErrCodeInadequateSecurity, []byte(err.Error()))
+		})
+		return
+	}
+
+	err := sc.writeFrame(func(fr *http2Framer) error {
+		return fr.WriteSettings(
+			http2Setting{http2SettingMaxConcurrentStreams, http2maxConcurrentStreams},
+			http2Setting{http2SettingMaxHeaderListSize, uint32(sc.srv.maxHeaderBytes())},
+		)
+	})
+	if err != nil {
+		return
+	}
+	if err := sc.readPreface(); err != nil {
+		return
+	}
+	if d := sc.srv.idleTimeout(); d != 0 {
+		sc.mu.Lock()
+		sc.idleTimer = time.AfterFunc(d, sc.startGracefulShutdown)
+		sc.mu.Unlock()
+	}
+	first := true
+	for {
+		fh, p, err := sc.framer.ReadFrame()
+		if err == nil && first && fh.Type != http2FrameSettings {
+			err = http2ConnectionError(http2ErrCodeProtocol)
+		}
+		first = false
+		if err == nil {
+			err = sc.processFrame(fh, p)
+		}
+		switch ev := err.(type) {
+		case nil:
+		case http2StreamError:
+			sc.resetStream(ev)
+		case http2ConnectionError:
+			sc.goAway(http2ErrCode(ev))
+			return
+		default:
+			return
+		}
+	}
+}
+
+// readPreface reads the client connection preface, which must
+// arrive within the server's ReadHeaderTimeout, if any.
+func (sc *http2serverConn) readPreface() error {
+	if d := sc.srv.readHeaderTimeout(); d != 0 {
+		sc.conn.SetReadDeadline(time.Now().Add(d))
+		defer sc.conn.SetReadDeadline(time.Time{})
+	}
+	buf := make([]byte, len(http2ClientPreface))
+	if _, err := io.ReadFull(sc.framer.r, buf); err != nil {
+		return err
+	}
+	if string(buf) != http2ClientPreface {
+		return errors.New("http2: bogus client preface")
+	}
+	return nil
+}
+
+// writeFrame calls fn with the connection's framer, then flushes it.
+func (sc *http2serverConn) writeFrame(fn func(*http2Framer) error) error {
+	sc.wmu.Lock()
+	defer sc.wmu.Unlock()
+	if err := fn(sc.framer); err != nil {
+		return err
+	}
+	return sc.framer.Flush()
+}
+
+// goAway sends a GOAWAY frame with the given error code. The caller
+// closes the connection afterwards.
+func (sc *http2serverConn) goAway(code http2ErrCode) {
+	sc.mu.Lock()
+	sc.goAwaySent = true
+	last := sc.maxClientStreamID
+	sc.mu.Unlock()
+	sc.writeFrame(func(fr *http2Framer) error {
+		return fr.WriteGoAway(last, code, nil)
+	})
+}
+
+// startGracefulShutdown tells the client that no new streams will be
+// accepted. Streams already started run to completion, after which
+// the connection is closed.
+func (sc *http2serverConn) startGracefulShutdown() {
+	sc.mu.Lock()
+	if sc.goAwaySent || sc.closed {
+		sc.mu.Unlock()
+		return
+	}
+	sc.goAwaySent = true
+	last := sc.maxClientStreamID
+	idle := len(sc.streams) == 0
+	sc.mu.Unlock()
+
+	sc.writeFrame(func(fr *http2Framer) error {
+		return fr.WriteGoAway(last, http2ErrCodeNo, nil)
+	})
+	if idle {
+		time.AfterFunc(http2goAwayTimeout, sc.closeConn)
+	}
+}
+
+func (sc *http2serverConn) closeConn() {
+	sc.conn.Close()
+}
+
+// close shuts down the connection, failing all of its streams.
+func (sc *http2serverConn) close() {
+	sc.mu.Lock()
+	sc.closed = true
+	if sc.idleTimer != nil {
+		sc.idleTimer.Stop()
+	}
+	for _, st := range sc.streams {
+		sc.abortStreamLocked(st, errHTTP2ClientGone)
+	}
+	sc.cond.Broadcast()
+	sc.mu.Unlock()
+	sc.conn.Close()
+}
+
+func (sc *http2serverConn) processFrame(fh http2FrameHeader, p []byte) error {
+	if sc.inContinuation && (fh.Type != http2FrameContinuation || fh.StreamID != sc.headerStream) {
+		return http2ConnectionError(http2ErrCodeProtocol)
+	}
+	switch fh.Type {
+	case http2FrameSettings:
+		return sc.processSettings(fh, p)
+	case http2FrameHeaders:
+		return sc.processHeaders(fh, p)
+	case http2FrameContinuation:
+		return sc.processContinuation(fh, p)
+	case http2FrameData:
+		return sc.processData(fh, p)
+	case http2FrameWindowUpdate:
+		return sc.processWindowUpdate(fh, p)
+	case http2FrameRSTStream:
+		if fh.StreamID == 0 {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+		return sc.processResetStream(fh.StreamID)
+	case http2FramePing:
+		if fh.StreamID != 0 {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+		if fh.has(http2FlagAck) {
+			return nil
+		}
+		var data [8]byte
+		copy(data[:], p)
+		return sc.writeFrame(func(fr *http2Framer) error {
+			return fr.WritePing(true, data)
+		})
+	case http2FramePriority:
+		if fh.StreamID == 0 {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+		return nil
+	case http2FrameGoAway:
+		// The client won't start new streams; stop pushing.
+		sc.mu.Lock()
+		sc.pushEnabled = false
+		sc.mu.Unlock()
+		return nil
+	case http2FramePushPromise:
+		// Clients can't push.
+		return http2ConnectionError(http2ErrCodeProtocol)
+	}
+	// Unknown frame types are ignored.
+	return nil
+}
+
+func (sc *http2serverConn) processSettings(fh http2FrameHeader, p []byte) error {
+	if fh.has(http2FlagAck) {
+		return nil
+	}
+	settings, err := http2parseSettings(p)
+	if err != nil {
+		return err
+	}
+	var tableSize *uint32
+	sc.mu.Lock()
+	for i, s := range settings {
+		switch s.ID {
+		case http2SettingHeaderTableSize:
+			tableSize = &settings[i].Val
+		case http2SettingEnablePush:
+			sc.pushEnabled = s.Val != 0
+		case http2SettingMaxConcurrentStreams:
+			sc.peerMaxStreams = s.Val
+		case http2SettingInitialWindowSize:
+			// Adjust the windows of all streams by the
+			// difference, RFC 7540 section 6.9.2.
+			delta := int32(s.Val) - sc.peerInitialWindow
+			sc.peerInitialWindow = int32(s.Val)
+			for _, st := range sc.streams {
+				if int64(st.sendWindow)+int64(delta) > http2maxWindowSize {
+					sc.mu.Unlock()
+					return http2ConnectionError(http2ErrCodeFlowControl)
+				}
+				st.sendWindow += delta
+			}
+		case http2SettingMaxFrameSize:
+			sc.peerMaxFrameSize = s.Val
+		}
+	}
+	sc.cond.Broadcast()
+	sc.mu.Unlock()
+
+	return sc.writeFrame(func(fr *http2Framer) error {
+		if tableSize != nil {
+			sc.henc.setMaxDynamicTableSizeLimit(*tableSize)
+		}
+		return fr.WriteSettingsAck()
+	})
+}
+
+func (sc *http2serverConn) processWindowUpdate(fh http2FrameHeader, p []byte) error {
+	inc, err := http2parseWindowUpdate(fh, p)
+	if err != nil {
+		return err
+	}
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if fh.StreamID == 0 {
+		if int64(sc.sendWindow)+int64(inc) > http2maxWindowSize {
+			return http2ConnectionError(http2ErrCodeFlowControl)
+		}
+		sc.sendWindow += int32(inc)
+	} else {
+		st := sc.streams[fh.StreamID]
+		if st == nil {
+			// Closed streams may still see updates in flight.
+			return nil
+		}
+		if int64(st.sendWindow)+int64(inc) > http2maxWindowSize {
+			return http2StreamError{fh.StreamID, http2ErrCodeFlowControl}
+		}
+		st.sendWindow += int32(inc)
+	}
+	sc.cond.Broadcast()
+	return nil
+}
+
+func (sc *http2serverConn) processResetStream(id uint32) error {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	st := sc.streams[id]
+	if st == nil {
+		if id%2 == 1 && id > sc.maxClientStreamID {
+			// RST_STREAM on an idle stream.
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+		return nil
+	}
+	sc.abortStreamLocked(st, errHTTP2ClientReset)
+	sc.closeStreamLocked(st)
+	return nil
+}
+
+// resetStream sends RST_STREAM for a stream error and forgets the
+// stream.
+func (sc *http2serverConn) resetStream(se http2StreamError) {
+	sc.writeFrame(func(fr *http2Framer) error {
+		return fr.WriteRSTStream(se.StreamID, se.Code)
+	})
+	sc.mu.Lock()
+	if st := sc.streams[se.StreamID]; st != nil {
+		sc.abortStreamLocked(st, se)
+		sc.closeStreamLocked(st)
+	}
+	sc.mu.Unlock()
+}
+
+// abortStreamLocked marks st as reset, failing its request body and
+// notifying any CloseNotifier. sc.mu must be held.
+func (sc *http2serverConn) abortStreamLocked(st *http2serverStream, err error) {
+	if st.reset {
+		return
+	}
+	st.reset = true
+	if st.body != nil {
+		sc.recvUnacked += int32(st.body.BreakWithError(err))
+	}
+	if st.closeNotifyc != nil {
+		select {
+		case st.closeNotifyc <- true:
+		default:
+		}
+	}
+	sc.cond.Broadcast()
+}
+
+// closeStreamLocked forgets st once both sides are done with it, or
+// it has been reset. sc.mu must be held.
+func (sc *http2serverConn) closeStreamLocked(st *http2serverStream) {
+	if _, ok := sc.streams[st.id]; !ok {
+		return
+	}
+	delete(sc.streams, st.id)
+	if st.id%2 == 1 {
+		sc.curClientStreams--
+	} else {
+		sc.curPushStreams--
+	}
+	sc.cond.Broadcast()
+	if len(sc.streams) == 0 && !sc.closed {
+		if sc.goAwaySent {
+			time.AfterFunc(http2goAwayTimeout, sc.closeConn)
+		} else if sc.idleTimer != nil {
+			sc.idleTimer.Reset(sc.srv.idleTimeout())
+		}
+	}
+}
+
+func (sc *http2serverConn) processHeaders(fh http2FrameHeader, p []byte) error {
+	if fh.StreamID == 0 || fh.StreamID%2 != 1 {
+		return http2ConnectionError(http2ErrCodeProtocol)
+	}
+	frag, err := http2headersFragment(fh, p)
+	if err != nil {
+		return err
+	}
+	sc.headerStream = fh.StreamID
+	sc.headerEndStream = fh.has(http2FlagEndStream)
+	sc.headerBlock = append(sc.headerBlock[:0], frag...)
+	if fh.has(http2FlagEndHeaders) {
+		return sc.endHeaderBlock()
+	}
+	sc.inContinuation = true
+	return nil
+}
+
+func (sc *http2serverConn) processContinuation(fh http2FrameHeader, p []byte) error {
+	if !sc.inContinuation {
+		return http2ConnectionError(http2ErrCodeProtocol)
+	}
+	sc.headerBlock = append(sc.headerBlock, p...)
+	if len(sc.headerBlock) > 2*sc.srv.maxHeaderBytes() {
+		return http2ConnectionError(http2ErrCodeEnhanceYourCalm)
+	}
+	if !fh.has(http2FlagEndHeaders) {
+		return nil
+	}
+	sc.inContinuation = false
+	return sc.endHeaderBlock()
+}
+
+// endHeaderBlock decodes a complete header block, which either
+// starts a new stream or carries trailers for an existing one.
+func (sc *http2serverConn) endHeaderBlock() error {
+	// The block must be decoded even if the stream is then
+	// refused, to keep the HPACK state in sync.
+	fields, err := sc.hdec.decode(sc.headerBlock)
+	if err != nil {
+		return http2ConnectionError(http2ErrCodeCompression)
+	}
+	id := sc.headerStream
+	endStream := sc.headerEndStream
+
+	sc.mu.Lock()
+	if st := sc.streams[id]; st != nil {
+		sc.mu.Unlock()
+		return sc.processTrailers(st, fields, endStream)
+	}
+	if id <= sc.maxClientStreamID {
+		// A stream that has already been closed or reset.
+		sc.mu.Unlock()
+		return nil
+	}
+	sc.maxClientStreamID = id
+	if sc.goAwaySent {
+		sc.mu.Unlock()
+		return nil
+	}
+	if sc.curClientStreams >= http2maxConcurrentStreams {
+		sc.mu.Unlock()
+		return http2StreamError{id, http2ErrCodeRefusedStream}
+	}
+	sc.mu.Unlock()
+
+	req, err := sc.newRequest(id, fields, endStream)
+	if err != nil {
+		return err
+	}
+	handler := sc.handler
+	if http2headerListSize(fields) > uint32(sc.srv.maxHeaderBytes()) {
+		handler = HandlerFunc(func(w ResponseWriter, r *Request) {
+			w.WriteHeader(statusRequestHeaderFieldsTooLarge)
+		})
+	}
+
+	st := &http2serverStream{
+		id:            id,
+		req:           req,
+		declBodyBytes: req.ContentLength,
+		remoteClosed:  endStream,
+	}
+	if !endStream {
+		st.body = http2newPipe(func(n int) { sc.noteBodyRead(st, n) })
+		st.rbody = &http2requestBody{sc: sc, st: st, pipe: st.body}
+		req.Body = st.rbody
+	}
+	sc.mu.Lock()
+	st.sendWindow = sc.peerInitialWindow
+	st.recvWindow = http2initialWindowSize
+	sc.streams[id] = st
+	sc.curClientStreams++
+	if sc.idleTimer != nil {
+		sc.idleTimer.Stop()
+	}
+	sc.mu.Unlock()
+
+	go sc.runHandler(sc.newResponseWriter(st), req, handler)
+
+	// Without keep-alives, this is the connection's only stream.
+	if !sc.srv.doKeepAlives() {
+		sc.startGracefulShutdown()
+	}
+	return nil
+}
+
+func http2headerListSize(fields []http2headerField) uint32 {
+	var n uint32
+	for _, f := range fields {
+		n += f.size()
+	}
+	return n
+}
+
+func (sc *http2serverConn) processTrailers(st *http2serverStream, fields []http2headerField, endStream bool) error {
+	if !endStream || st.remoteClosed {
+		return http2StreamError{st.id, http2ErrCodeProtocol}
+	}
+	trailer := make(Header)
+	for _, f := range fields {
+		if strings.HasPrefix(f.name, ":") {
+			return http2StreamError{st.id, http2ErrCodeProtocol}
+		}
+		trailer.Add(CanonicalHeaderKey(f.name), f.value)
+	}
+	st.trailer = trailer
+	return sc.endRequestBody(st)
+}
+
+// endRequestBody handles END_STREAM from the client.
+func (sc *http2serverConn) endRequestBody(st *http2serverStream) error {
+	if st.declBodyBytes != -1 && st.bodyBytes != st.declBodyBytes {
+		return http2StreamError{st.id, http2ErrCodeProtocol}
+	}
+	if st.body != nil {
+		st.body.CloseWithError(io.EOF)
+	}
+	sc.mu.Lock()
+	st.remoteClosed = true
+	if st.localClosed {
+		sc.closeStreamLocked(st)
+	}
+	sc.mu.Unlock()
+	return nil
+}
+
+func (sc *http2serverConn) processData(fh http2FrameHeader, p []byte) error {
+	id := fh.StreamID
+	if id == 0 {
+		return http2ConnectionError(http2ErrCodeProtocol)
+	}
+	n := int32(len(p)) // flow control counts the padding too
+
+	sc.mu.Lock()
+	if n > sc.recvWindow {
+		sc.mu.Unlock()
+		return http2ConnectionError(http2ErrCodeFlowControl)
+	}
+	sc.recvWindow -= n
+	st := sc.streams[id]
+	if st == nil || st.remoteClosed || st.reset {
+		idle := st == nil && (id%2 == 0 || id > sc.maxClientStreamID)
+		sc.recvUnacked += n
+		sc.mu.Unlock()
+		if idle {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+		sc.sendWindowUpdates(nil)
+		if st != nil && !st.reset {
+			return http2StreamError{id, http2ErrCodeStreamClosed}
+		}
+		return nil
+	}
+	if n > st.recvWindow {
+		sc.recvUnacked += n
+		sc.mu.Unlock()
+		sc.sendWindowUpdates(nil)
+		return http2StreamError{id, http2ErrCodeFlowControl}
+	}
+	st.recvWindow -= n
+	sc.mu.Unlock()
+
+	data, err := http2stripPadding(fh, p)
+	if err != nil {
+		return err
+	}
+	if pad := len(p) - len(data); pad > 0 {
+		sc.noteBodyRead(st, pad)
+	}
+	if len(data) > 0 {
+		st.bodyBytes += int64(len(data))
+		if st.declBodyBytes != -1 && st.bodyBytes > st.declBodyBytes {
+			return http2StreamError{id, http2ErrCodeProtocol}
+		}
+		if _, err := st.body.Write(data); err != nil {
+			// The handler closed the body; just return the
+			// connection-level credit.
+			sc.mu.Lock()
+			sc.recvUnacked += int32(len(data))
+			sc.mu.Unlock()
+			sc.sendWindowUpdates(nil)
+		}
+	}
+	if fh.has(http2FlagEndStream) {
+		return sc.endRequestBody(st)
+	}
+	return nil
+}
+
+// noteBodyRead returns flow control credit for n bytes of st's
+// request body that have been consumed.
+func (sc *http2serverConn) noteBodyRead(st *http2serverStream, n int) {
+	sc.mu.Lock()
+	sc.recvUnacked += int32(n)
+	if !st.remoteClosed && !st.reset {
+		st.recvUnacked += int32(n)
+	}
+	sc.mu.Unlock()
+	sc.sendWindowUpdates(st)
+}
+
+// sendWindowUpdates sends WINDOW_UPDATE frames for the connection and,
+// if non-nil, st once enough credit has accumulated. Waiting for half
+// a window keeps the number of frames down without ever stalling a
+// client that has data to send.
+func (sc *http2serverConn) sendWindowUpdates(st *http2serverStream) {
+	const threshold = http2initialWindowSize / 2
+	var connInc, streamInc int32
+	sc.mu.Lock()
+	if sc.recvUnacked >= threshold {
+		connInc = sc.recvUnacked
+		sc.recvUnacked = 0
+		sc.recvWindow += connInc
+	}
+	if st != nil && st.recvUnacked >= threshold && !st.remoteClosed && !st.reset {
+		streamInc = st.recvUnacked
+		st.recvUnacked = 0
+		st.recvWindow += streamInc
+	}
+	sc.mu.Unlock()
+	if connInc == 0 && streamInc == 0 {
+		return
+	}
+	sc.writeFrame(func(fr *http2Framer) error {
+		if connInc > 0 {
+			if err := fr.WriteWindowUpdate(0, uint32(connInc)); err != nil {
+				return err
+			}
+		}
+		if streamInc > 0 {
+			return fr.WriteWindowUpdate(st.id, uint32(streamInc))
+		}
+		return nil
+	})
+}
+
+// newRequest builds the Request for a stream from its decoded header
+// block, validating it as described in RFC 7540 section 8.1.2.
+func (sc *http2serverConn) newRequest(id uint32, fields []http2headerField, endStream bool) (*Request, error) {
+	malformed := http2StreamError{id, http2ErrCodeProtocol}
+	var method, scheme, authority, path string
+	header := make(Header)
+	sawRegular := false
+	for _, f := range fields {
+		if strings.HasPrefix(f.name, ":") {
+			var p *string
+			switch f.name {
+			case ":method":
+				p = &method
+			case ":scheme":
+				p = &scheme
+			case ":authority":
+				p = &authority
+			case ":path":
+				p = &path
+			}
+			if p == nil || *p != "" || sawRegular {
+				return nil, malformed
+			}
+			*p = f.value
+			continue
+		}
+		sawRegular = true
+		if !http2validHeaderFieldName(f.name) {
+			return nil, malformed
+		}
+		switch f.name {
+		case "connection", "keep-alive", "proxy-connection", "transfer-encoding", "upgrade":
+			return nil, malformed
+		case "te":
+			if f.value != "trailers" {
+				return nil, malformed
+			}
+		}
+		header.Add(CanonicalHeaderKey(f.name), f.value)
+	}
+	if method == "" || method != "CONNECT" && (scheme == "" || path == "") {
+		return nil, malformed
+	}
+
+	// Cookies may be split into separate header fields, RFC 7540
+	// section 8.1.2.5.
+	if cookies := header["Cookie"]; len(cookies) > 1 {
+		header.Set("Cookie", strings.Join(cookies, "; "))
+	}
+
+	host := authority
+	if host == "" {
+		host = header.get("Host")
+	}
+	header.Del("Host")
+
+	req := &Request{
+		Method:     method,
+		Proto:      "HTTP/2.0",
+		ProtoMajor: 2,
+		ProtoMinor: 0,
+		Header:     header,
+		Host:       host,
+		RemoteAddr: sc.remoteAddr,
+		TLS:        sc.tlsState,
+		RequestURI: path,
+		Body:       eofReader,
+	}
+	if method == "CONNECT" {
+		req.URL = &url.URL{Host: authority}
+		req.RequestURI = authority
+	} else {
+		u, err := url.ParseRequestURI(path)
+		if err != nil {
+			return nil, malformed
+		}
+		req.URL = u
+	}
+
+	req.ContentLength = -1
+	if v := header.get("Content-Length"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 0 {
+			return nil, malformed
+		}
+		req.ContentLength = n
+	}
+	if endStream {
+		if req.ContentLength > 0 {
+			return nil, malformed
+		}
+		req.ContentLength = 0
+	}
+
+	for _, key := range declaredTrailers(header) {
+		if req.Trailer == nil {
+			req.Trailer = make(Header)
+		}
+		req.Trailer[key] = nil
+	}
+	header.Del("Trailer")
+	return req, nil
+}
+
+// http2validHeaderFieldName reports whether v is a valid HTTP/2 header
+// field name: a token in lower case.
+func http2validHeaderFieldName(v string) bool {
+	if v == "" {
+		return false
+	}
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if 'A' <= c && c <= 'Z' || !isToken(rune(c)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (sc *http2serverConn) runHandler(rw *http2responseWriter, req *Request, handler Handler) {
+	didPanic := true
+	defer func() {
+		if !didPanic {
+			return
+		}
+		err := recover()
+		const size = 4096
+		buf := make([]byte, size)
+		buf = buf[:runtime.Stack(buf, false)]
+		log.Printf("http: panic serving %v: %v\n%s", sc.remoteAddr, err, buf)
+		sc.resetStream(http2StreamError{rw.st.id, http2ErrCodeInternal})
+	}()
+	handler.ServeHTTP(rw, req)
+	didPanic = false
+	rw.finish()
+}
+
+// writeHeaders encodes and sends a header block on st.
+func (sc *http2serverConn) writeHeaders(st *http2serverStream, fields []http2headerField, endStream bool) error {
+	sc.mu.Lock()
+	if st.reset || sc.closed {
+		sc.mu.Unlock()
+		return errHTTP2StreamClosed
+	}
+	maxFrameSize := sc.peerMaxFrameSize
+	sc.mu.Unlock()
+
+	err := sc.writeFrame(func(fr *http2Framer) error {
+		sc.hbuf = sc.henc.encode(sc.hbuf[:0], fields)
+		return fr.WriteHeaders(st.id, endStream, sc.hbuf, maxFrameSize)
+	})
+	if err == nil && endStream {
+		sc.noteLocalEnd(st)
+	}
+	return err
+}
+
+// writeData sends p on st as DATA frames, waiting for flow control
+// window as needed.
+func (sc *http2serverConn) writeData(st *http2serverStream, p []byte, endStream bool) error {
+	for {
+		sc.mu.Lock()
+		for !st.reset && !sc.closed && len(p) > 0 && (st.sendWindow <= 0 || sc.sendWindow <= 0) {
+			sc.cond.Wait()
+		}
+		if st.reset || sc.closed {
+			sc.mu.Unlock()
+			return errHTTP2StreamClosed
+		}
+		n := int32(len(p))
+		if n > st.sendWindow {
+			n = st.sendWindow
+		}
+		if n > sc.sendWindow {
+			n = sc.sendWindow
+		}
+		if max := int32(sc.peerMaxFrameSize); n > max {
+			n = max
+		}
+		st.sendWindow -= n
+		sc.sendWindow -= n
+		sc.mu.Unlock()
+
+		chunk := p[:n]
+		p = p[n:]
+		end := endStream && len(p) == 0
+		err := sc.writeFrame(func(fr *http2Framer) error {
+			return fr.WriteData(st.id, end, chunk)
+		})
+		if err != nil {
+			return err
+		}
+		if len(p) == 0 {
+			if end {
+				sc.noteLocalEnd(st)
+			}
+			return nil
+		}
+	}
+}
+
+// noteLocalEnd records that END_STREAM was sent on st.
+func (sc *http2serverConn) noteLocalEnd(st *http2serverStream) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	st.localClosed = true
+	if st.remoteClosed {
+		sc.closeStreamLocked(st)
+	}
+}
+
+// handlerDone is called when st's handler has finished writing its
+// response. If the client is still sending a request body, it is
+// asked to stop, RFC 7540 section 8.1.
+func (sc *http2serverConn) handlerDone(st *http2serverStream) {
+	sc.mu.Lock()
+	done := st.remoteClosed || st.reset
+	sc.mu.Unlock()
+	if done {
+		return
+	}
+	sc.resetStream(http2StreamError{st.id, http2ErrCodeNo})
+}
+
+func (sc *http2serverConn) closeNotify(st *http2serverStream) <-chan bool {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if st.closeNotifyc == nil {
+		st.closeNotifyc = make(chan bool, 1)
+		if st.reset {
+			st.closeNotifyc <- true
+		}
+	}
+	return st.closeNotifyc
+}
+
+// http2requestBody is the Request.Body of an HTTP/2 server request.
+type http2requestBody struct {
+	sc   *http2serverConn
+	st   *http2serverStream
+	pipe *http2pipe
+}
+
+func (b *http2requestBody) Read(p []byte) (n int, err error) {
+	n, err = b.pipe.Read(p)
+	if err == io.EOF && b.st.trailer != nil {
+		mergeSetHeader(&b.st.req.Trailer, b.st.trailer)
+		b.st.trailer = nil
+	}
+	return n, err
+}
+
+func (b *http2requestBody) Close() error {
+	n := b.pipe.BreakWithError(ErrBodyReadAfterClose)
+	if n > 0 {
+		b.sc.mu.Lock()
+		b.sc.recvUnacked += int32(n)
+		b.sc.mu.Unlock()
+		b.sc.sendWindowUpdates(nil)
+	}
+	return nil
+}
+
+// http2responseWriter is the ResponseWriter of an HTTP/2 stream. Like
+// the HTTP/1 response, output is buffered by a bufio.Writer in front
+// of a chunk writer that sends the header with the first chunk, so
+// that short responses get a Content-Length and sniffed Content-Type.
+type http2responseWriter struct {
+	sc  *http2serverConn
+	st  *http2serverStream
+	req *Request
+
+	handlerHeader Header
+	snapHeader    Header // handlerHeader as of WriteHeader
+	status        int
+	wroteHeader   bool // WriteHeader called
+	sentHeader    bool // HEADERS frame sent
+	sentEnd       bool // END_STREAM sent
+	handlerDone   bool
+
+	written       int64 // bytes of body written by the handler
+	contentLength int64 // declared Content-Length, or -1
+
+	// trailers are the declared trailer keys, sent from
+	// handlerHeader in a HEADERS frame that ends the stream.
+	trailers []string
+
+	bw *bufio.Writer
+	sw *switchWriter
+}
+
+func (sc *http2serverConn) newResponseWriter(st *http2serverStream) *http2responseWriter {
+	rw := &http2responseWriter{
+		sc:            sc,
+		st:            st,
+		req:           st.req,
+		handlerHeader: make(Header),
+		contentLength: -1,
+	}
+	rw.bw, rw.sw = newBufioWriterSize(http2chunkWriter{rw}, bufferBeforeChunkingSize)
+	return rw
+}
+
+// http2chunkWriter writes to an http2responseWriter's stream.
+type http2chunkWriter struct{ rw *http2responseWriter }
+
+func (cw http2chunkWriter) Write(p []byte) (n int, err error) {
+	return cw.rw.writeChunk(p)
+}
+
+func (rw *http2responseWriter) Header() Header {
+	return rw.handlerHeader
+}
+
+func (rw *http2responseWriter) WriteHeader(code int) {
+	if rw.wroteHeader {
+		log.Print("http: multiple response.WriteHeader calls")
+		return
+	}
+	rw.wroteHeader = true
+	rw.status = code
+	rw.snapHeader = rw.handlerHeader.clone()
+	if cl := rw.snapHeader.get("Content-Length"); cl != "" {
+		v, err := strconv.ParseInt(cl, 10, 64)
+		if err == nil && v >= 0 {
+			rw.contentLength = v
+		} else {
+			log.Printf("http: invalid Content-Length of %q", cl)
+			rw.snapHeader.Del("Content-Length")
+		}
+	}
+}
+
+func (rw *http2responseWriter) bodyAllowed() bool {
+	return http2bodyAllowedForStatus(rw.status) && rw.req.Method != "HEAD"
+}
+
+func http2bodyAllowedForStatus(code int) bool {
+	switch {
+	case code >= 100 && code <= 199:
+		return false
+	case code == StatusNoContent, code == StatusNotModified:
+		return false
+	}
+	return true
+}
+
+func (rw *http2responseWriter) Write(data []byte) (n int, err error) {
+	if !rw.wroteHeader {
+		rw.WriteHeader(StatusOK)
+	}
+	if len(data) == 0 {
+		return 0, nil
+	}
+	if !rw.bodyAllowed() {
+		return 0, ErrBodyNotAllowed
+	}
+	rw.written += int64(len(data))
+	if rw.contentLength != -1 && rw.written > rw.contentLength {
+		return 0, ErrContentLength
+	}
+	return rw.bw.Write(data)
+}
+
+func (rw *http2responseWriter) Flush() {
+	if !rw.wroteHeader {
+		rw.WriteHeader(StatusOK)
+	}
+	rw.bw.Flush()
+	if !rw.sentHeader {
+		rw.writeChunk(nil)
+	}
+}
+
+func (rw *http2responseWriter) CloseNotify() <-chan bool {
+	return rw.sc.closeNotify(rw.st)
+}
+
+// writeChunk sends the response header, if it hasn't been sent yet,
+// followed by p.
+func (rw *http2responseWriter) writeChunk(p []byte) (n int, err error) {
+	if !rw.sentHeader {
+		rw.sentHeader = true
+		if err := rw.writeResponseHeader(p); err != nil {
+			return 0, err
+		}
+		if rw.sentEnd {
+			return len(p), nil
+		}
+	}
+	endStream := rw.handlerDone && len(rw.trailers) == 0
+	if len(p) == 0 && !endStream {
+		return 0, nil
+	}
+	if err := rw.sc.writeData(rw.st, p, endStream); err != nil {
+		return 0, err
+	}
+	rw.sentEnd = endStream
+	return len(p), nil
+}
+
+func (rw *http2responseWriter) writeResponseHeader(p []byte) error {
+	h := rw.snapHeader
+	code := rw.status
+	isHEAD := rw.req.Method == "HEAD"
+	rw.trailers = declaredTrailers(h)
+
+	// As with HTTP/1, a handler that finished without declaring a
+	// Content-Length or trailers gets one computed from its only
+	// chunk.
+	if rw.handlerDone && len(rw.trailers) == 0 && h.get("Content-Length") == "" && !isHEAD && http2bodyAllowedForStatus(code) {
+		h.Set("Content-Length", strconv.Itoa(len(p)))
+	}
+	if code == StatusNotModified {
+		for _, k := range []string{"Content-Type", "Content-Length", "Transfer-Encoding"} {
+			h.Del(k)
+		}
+	} else if _, haveType := h["Content-Type"]; !haveType && !isHEAD {
+		h.Set("Content-Type", DetectContentType(p))
+	}
+	if _, ok := h["Date"]; !ok {
+		h.Set("Date", time.Now().UTC().Format(TimeFormat))
+	}
+
+	fields := []http2headerField{{name: ":status", value: strconv.Itoa(code)}}
+	fields = http2appendHeaderFields(fields, h)
+	endStream := rw.handlerDone && len(p) == 0 && len(rw.trailers) == 0
+	err := rw.sc.writeHeaders(rw.st, fields, endStream)
+	if err == nil && endStream {
+		rw.sentEnd = true
+	}
+	return err
+}
+
+// http2appendHeaderFields appends the fields of h in sorted order,
+// lower-casing names and dropping the connection-specific headers
+// that HTTP/2 forbids.
+func http2appendHeaderFields(fields []http2headerField, h Header) []http2headerField {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		name := strings.ToLower(k)
+		switch name {
+		case "connection", "keep-alive", "proxy-connection", "transfer-encoding", "upgrade":
+			continue
+		}
+		for _, v := range h[k] {
+			fields = append(fields, http2headerField{
+				name:      name,
+				value:     v,
+				sensitive: name == "authorization" || name == "proxy-authorization",
+			})
+		}
+	}
+	return fields
+}
+
+// finish completes the response after the handler returns.
+func (rw *http2responseWriter) finish() {
+	rw.handlerDone = true
+	if !rw.wroteHeader {
+		rw.WriteHeader(StatusOK)
+	}
+	rw.bw.Flush()
+	putBufioWriter(rw.bw, rw.sw)
+	if !rw.sentHeader {
+		rw.writeChunk(nil)
+	}
+	if !rw.sentEnd {
+		rw.writeEnd()
+	}
+	if rw.st.rbody != nil {
+		rw.st.rbody.Close()
+	}
+	if rw.req.MultipartForm != nil {
+		rw.req.MultipartForm.RemoveAll()
+	}
+	rw.sc.handlerDone(rw.st)
+}
+
+// writeEnd ends the response stream, sending the trailers if the
+// handler set any.
+func (rw *http2responseWriter) writeEnd() {
+	var err error
+	if trailers := trailerValues(rw.handlerHeader, rw.trailers); trailers != nil {
+		err = rw.sc.writeHeaders(rw.st, http2appendHeaderFields(nil, trailers), true)
+	} else {
+		err = rw.sc.writeData(rw.st, nil, true)
+	}
+	if err == nil {
+		rw.sentEnd = true
+	}
+}
+
+// Push implements Pusher.
+func (rw *http2responseWriter) Push(target string, opts *PushOptions) error {
+	sc := rw.sc
+	if rw.st.id%2 == 0 {
+		return errHTTP2RecursivePush
+	}
+	if opts == nil {
+		opts = new(PushOptions)
+	}
+	method := opts.Method
+	if method == "" {
+		method = "GET"
+	}
+	if method != "GET" && method != "HEAD" {
+		return fmt.Errorf("http2: method %q must be GET or HEAD", method)
+	}
+
+	host := rw.req.Host
+	if !strings.HasPrefix(target, "/") {
+		u, err := url.Parse(target)
+		if err != nil {
+			return err
+		}
+		if u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("http2: target must be an absolute path or an https URL: %q", target)
+		}
+		host = u.Host
+		target = u.RequestURI()
+	}
+	u, err := url.ParseRequestURI(target)
+	if err != nil {
+		return err
+	}
+	for k := range opts.Header {
+		if strings.HasPrefix(k, ":") {
+			return fmt.Errorf("http2: promised request headers cannot include pseudo header %q", k)
+		}
+		switch strings.ToLower(k) {
+		case "content-length", "content-encoding", "trailer", "te", "expect", "host":
+			return fmt.Errorf("http2: promised request headers cannot include %q", k)
+		}
+	}
+
+	sc.mu.Lock()
+	if !sc.pushEnabled || sc.goAwaySent || sc.closed {
+		sc.mu.Unlock()
+		return ErrNotSupported
+	}
+	if rw.st.reset || rw.st.localClosed {
+		sc.mu.Unlock()
+		return errHTTP2StreamClosed
+	}
+	if uint32(sc.curPushStreams) >= sc.peerMaxStreams {
+		sc.mu.Unlock()
+		return errors.New("http2: push would exceed peer's SETTINGS_MAX_CONCURRENT_STREAMS")
+	}
+	id := sc.nextPushID
+	sc.nextPushID += 2
+	req := &Request{
+		Method:     method,
+		URL:        u,
+		Proto:      "HTTP/2.0",
+		ProtoMajor: 2,
+		ProtoMinor: 0,
+		Header:     opts.Header.clone(),
+		Host:       host,
+		RemoteAddr: sc.remoteAddr,
+		TLS:        sc.tlsState,
+		RequestURI: target,
+		Body:       eofReader,
+	}
+	if req.Header == nil {
+		req.Header = make(Header)
+	}
+	st := &http2serverStream{
+		id:            id,
+		req:           req,
+		declBodyBytes: -1,
+		remoteClosed:  true, // pushed streams are half closed (remote)
+		sendWindow:    sc.peerInitialWindow,
+	}
+	sc.streams[id] = st
+	sc.curPushStreams++
+	maxFrameSize := sc.peerMaxFrameSize
+	sc.mu.Unlock()
+
+	fields := []http2headerField{
+		{name: ":method", value: method},
+		{name: ":scheme", value: "https"},
+		{name: ":authority", value: host},
+		{name: ":path", value: target},
+	}
+	fields = http2appendHeaderFields(fields, req.Header)
+	err = sc.writeFrame(func(fr *http2Framer) error {
+		sc.hbuf = sc.henc.encode(sc.hbuf[:0], fields)
+		return fr.WritePushPromise(rw.st.id, id, sc.hbuf, maxFrameSize)
+	})
+	if err != nil {
+		sc.mu.Lock()
+		sc.closeStreamLocked(st)
+		sc.mu.Unlock()
+		return err
+	}
+	go sc.runHandler(sc.newResponseWriter(st), req, sc.handler)
+	return nil
+}
+
+var _ Pusher = (*http2responseWriter)(nil)
+var _ Flusher = (*http2responseWriter)(nil)
+var _ CloseNotifier = (*http2responseWriter)(nil)
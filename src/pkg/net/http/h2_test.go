@@ -0,0 +1,428 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// End-to-end tests for HTTP/2.
+
+package http_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	. "net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func init() {
+	// crypto/tls has no cipher suite that RFC 7540 allows, so the
+	// tests use HTTP/2 over ones it doesn't.
+	SetHTTP2SkipSecurityCheck(true)
+}
+
+func newHTTP2Server(h Handler) *httptest.Server {
+	ts := httptest.NewUnstartedServer(h)
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	return ts
+}
+
+func newHTTP2Transport() *Transport {
+	return &Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		EnableHTTP2:     true,
+	}
+}
+
+func TestHTTP2Basic(t *testing.T) {
+	defer afterTest(t)
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {
+		if r.ProtoMajor != 2 || r.Proto != "HTTP/2.0" {
+			t.Errorf("request proto = %q (%d.%d)", r.Proto, r.ProtoMajor, r.ProtoMinor)
+		}
+		if r.TLS == nil {
+			t.Errorf("request TLS is nil")
+		}
+		w.Header().Set("X-Foo", r.Header.Get("X-Bar"))
+		fmt.Fprintf(w, "%s %s %s", r.Method, r.Host, r.URL.RequestURI())
+	}))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	defer tr.CloseIdleConnections()
+
+	req, _ := NewRequest("GET", ts.URL+"/path?q=1", nil)
+	req.Header.Set("X-Bar", "bar")
+	res, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.Proto != "HTTP/2.0" || res.ProtoMajor != 2 {
+		t.Errorf("response proto = %q", res.Proto)
+	}
+	if res.StatusCode != 200 || res.Status != "200 OK" {
+		t.Errorf("status = %q", res.Status)
+	}
+	if got := res.Header.Get("X-Foo"); got != "bar" {
+		t.Errorf("X-Foo = %q; want bar", got)
+	}
+	slurp, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "GET " + strings.TrimPrefix(ts.URL, "https://") + " /path?q=1"
+	if string(slurp) != want {
+		t.Errorf("body = %q; want %q", slurp, want)
+	}
+}
+
+func TestHTTP2Disabled(t *testing.T) {
+	defer afterTest(t)
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {
+		io.WriteString(w, r.Proto)
+	}))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	tr.TLSNextProto = map[string]func(string, *tls.Conn) RoundTripper{}
+	defer tr.CloseIdleConnections()
+
+	res, err := (&Client{Transport: tr}).Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slurp, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if res.Proto != "HTTP/1.1" || string(slurp) != "HTTP/1.1" {
+		t.Errorf("got response %q, body %q; want HTTP/1.1", res.Proto, slurp)
+	}
+}
+
+// Tests that the Transport only uses HTTP/2 when enabled.
+func TestHTTP2OptIn(t *testing.T) {
+	defer afterTest(t)
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {
+		io.WriteString(w, r.Proto)
+	}))
+	defer ts.Close()
+	tr := &Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	defer tr.CloseIdleConnections()
+
+	res, err := (&Client{Transport: tr}).Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slurp, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if res.Proto != "HTTP/1.1" || string(slurp) != "HTTP/1.1" {
+		t.Errorf("got response %q, body %q; want HTTP/1.1", res.Proto, slurp)
+	}
+
+}
+
+// Tests that the server refuses HTTP/2 on a connection without TLS
+// 1.2 and an allowed cipher suite, as RFC 7540, section 9.2 requires.
+func TestHTTP2ServerInadequateSecurity(t *testing.T) {
+	defer afterTest(t)
+	SetHTTP2SkipSecurityCheck(false)
+	defer SetHTTP2SkipSecurityCheck(true)
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {}))
+	defer ts.Close()
+
+	c, err := tls.Dial("tcp", ts.Listener.Addr().String(), &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"h2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if p := c.ConnectionState().NegotiatedProtocol; p != "h2" {
+		t.Fatalf("negotiated %q; want h2", p)
+	}
+	io.WriteString(c, "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n")
+
+	// The server's first frame is a GOAWAY with INADEQUATE_SECURITY.
+	c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var hdr [9 + 8]byte
+	if _, err := io.ReadFull(c, hdr[:]); err != nil {
+		t.Fatal(err)
+	}
+	if typ := hdr[3]; typ != 0x7 {
+		t.Fatalf("frame type = %#x; want GOAWAY", typ)
+	}
+	if code := uint32(hdr[13])<<24 | uint32(hdr[14])<<16 | uint32(hdr[15])<<8 | uint32(hdr[16]); code != 0xc {
+		t.Errorf("GOAWAY error code = %#x; want INADEQUATE_SECURITY", code)
+	}
+}
+
+// Tests that the Transport falls back to HTTP/1.1 when a server picks
+// HTTP/2 for a connection that doesn't allow it.
+func TestHTTP2TransportInadequateSecurity(t *testing.T) {
+	defer afterTest(t)
+	SetHTTP2SkipSecurityCheck(false)
+	defer SetHTTP2SkipSecurityCheck(true)
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {
+		io.WriteString(w, r.Proto)
+	}))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	defer tr.CloseIdleConnections()
+	c := &Client{Transport: tr}
+
+	for i := 0; i < 2; i++ {
+		res, err := c.Get(ts.URL)
+		if err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		slurp, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if res.Proto != "HTTP/1.1" || string(slurp) != "HTTP/1.1" {
+			t.Errorf("request %d: got response %q, body %q; want HTTP/1.1", i, res.Proto, slurp)
+		}
+	}
+}
+
+// Tests that concurrent requests share a single connection.
+func TestHTTP2Multiplexing(t *testing.T) {
+	defer afterTest(t)
+	const n = 20
+	var (
+		mu      sync.Mutex
+		arrived int
+		all     = make(chan bool)
+	)
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {
+		if r.URL.Path == "/wait" {
+			// Don't reply until all requests are in flight at once.
+			mu.Lock()
+			if arrived++; arrived == n {
+				close(all)
+			}
+			mu.Unlock()
+			<-all
+		}
+		io.WriteString(w, r.RemoteAddr)
+	}))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	defer tr.CloseIdleConnections()
+	c := &Client{Transport: tr}
+
+	// Prime the connection.
+	res, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	addrs := make(chan string, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			res, err := c.Get(ts.URL + "/wait")
+			if err != nil {
+				t.Error(err)
+				addrs <- ""
+				return
+			}
+			slurp, _ := ioutil.ReadAll(res.Body)
+			res.Body.Close()
+			addrs <- string(slurp)
+		}()
+	}
+	for i := 0; i < n; i++ {
+		select {
+		case addr := <-addrs:
+			if addr != string(first) {
+				t.Errorf("request %d used connection from %q; want %q", i, addr, first)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("timeout waiting for concurrent requests")
+		}
+	}
+}
+
+// Tests request and response bodies larger than the flow control
+// windows.
+func TestHTTP2LargeBodies(t *testing.T) {
+	defer afterTest(t)
+	const size = 5 << 20
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {
+		n, err := io.Copy(ioutil.Discard, r.Body)
+		if err != nil || n != size {
+			t.Errorf("server read %d, %v; want %d bytes", n, err, size)
+		}
+		w.Write(bytes.Repeat([]byte("b"), size))
+	}))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	defer tr.CloseIdleConnections()
+
+	res, err := (&Client{Transport: tr}).Post(ts.URL, "text/plain", bytes.NewReader(bytes.Repeat([]byte("a"), size)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	n, err := io.Copy(ioutil.Discard, res.Body)
+	if err != nil || n != size {
+		t.Errorf("client read %d, %v; want %d bytes", n, err, size)
+	}
+}
+
+func TestHTTP2Gzip(t *testing.T) {
+	defer afterTest(t)
+	const msg = "Hello, gzipped world"
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {
+		if ae := r.Header.Get("Accept-Encoding"); ae != "gzip" {
+			t.Errorf("Accept-Encoding = %q; want gzip", ae)
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		io.WriteString(gz, msg)
+		gz.Close()
+	}))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	defer tr.CloseIdleConnections()
+
+	res, err := (&Client{Transport: tr}).Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if ce := res.Header.Get("Content-Encoding"); ce != "" {
+		t.Errorf("Content-Encoding = %q; want none", ce)
+	}
+	slurp, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(slurp) != msg {
+		t.Errorf("body = %q; want %q", slurp, msg)
+	}
+}
+
+func TestHTTP2Trailers(t *testing.T) {
+	defer afterTest(t)
+	ts := newHTTP2Server(trailerHandler(t))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	defer tr.CloseIdleConnections()
+	testTrailers(t, &Client{Transport: tr}, ts.URL)
+}
+
+func TestHTTP2Trace(t *testing.T) {
+	defer afterTest(t)
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {}))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	defer tr.CloseIdleConnections()
+	c := &Client{Transport: tr}
+
+	var r traceRecorder
+	r.get(t, c, ts.URL)
+	got := r.get(t, c, ts.URL)
+	want := []string{
+		"GetConn",
+		"GotConn",
+		"GotFirstResponseByte",
+		"WroteHeaders",
+		"WroteRequest <nil>",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events:\n got %q\nwant %q", got, want)
+	}
+	if !r.conn.Reused || r.conn.Conn == nil {
+		t.Errorf("GotConnInfo = %+v; want a reused connection", r.conn)
+	}
+}
+
+func TestHTTP2PushNotSupported(t *testing.T) {
+	defer afterTest(t)
+	pushErr := make(chan error, 1)
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {
+		p, ok := w.(Pusher)
+		if !ok {
+			t.Errorf("ResponseWriter %T doesn't implement Pusher", w)
+			pushErr <- nil
+			return
+		}
+		// The Transport disables push in its SETTINGS.
+		pushErr <- p.Push("/style.css", nil)
+	}))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	defer tr.CloseIdleConnections()
+
+	res, err := (&Client{Transport: tr}).Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if err := <-pushErr; err != ErrNotSupported {
+		t.Errorf("Push = %v; want ErrNotSupported", err)
+	}
+}
+
+// Tests that SetKeepAlivesEnabled(false) sends GOAWAY, lets the
+// in-flight request finish, and that the Transport then moves on to
+// a new connection.
+func TestHTTP2GracefulShutdown(t *testing.T) {
+	defer afterTest(t)
+	inHandler := make(chan bool)
+	unblock := make(chan bool)
+	ts := newHTTP2Server(HandlerFunc(func(w ResponseWriter, r *Request) {
+		if r.URL.Path == "/slow" {
+			inHandler <- true
+			<-unblock
+		}
+		io.WriteString(w, r.RemoteAddr)
+	}))
+	defer ts.Close()
+	tr := newHTTP2Transport()
+	defer tr.CloseIdleConnections()
+	c := &Client{Transport: tr}
+
+	type result struct {
+		body string
+		err  error
+	}
+	get := func(path string) result {
+		res, err := c.Get(ts.URL + path)
+		if err != nil {
+			return result{err: err}
+		}
+		slurp, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		return result{string(slurp), err}
+	}
+
+	slowc := make(chan result, 1)
+	go func() { slowc <- get("/slow") }()
+	<-inHandler
+
+	ts.Config.SetKeepAlivesEnabled(false)
+	time.Sleep(100 * time.Millisecond) // let the GOAWAY arrive
+	close(unblock)
+	slow := <-slowc
+	if slow.err != nil {
+		t.Fatalf("in-flight request failed: %v", slow.err)
+	}
+
+	ts.Config.SetKeepAlivesEnabled(true)
+	next := get("/")
+	if next.err != nil {
+		t.Fatalf("request after GOAWAY failed: %v", next.err)
+	}
+	if next.body == slow.body {
+		t.Errorf("request after GOAWAY reused connection %q", next.body)
+	}
+}
@@ -0,0 +1,1157 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// HTTP/2 client. See RFC 7540.
+//
+// When a TLS connection dialed by the Transport negotiates "h2", it
+// becomes an http2clientConn, which is pooled by the Transport and
+// shared by concurrent requests to the same host, each on its own
+// stream. As on the server, a single goroutine reads frames while
+// writes from the goroutines of RoundTrip and request body writers are
+// serialized by a write mutex.
+
+package http
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http/httptrace"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// http2transportStreamWindow and http2transportConnWindow are
+	// the receive windows the Transport advertises, for each stream
+	// and for the whole connection.
+	http2transportStreamWindow = 4 << 20
+	http2transportConnWindow   = 1 << 30
+
+	// http2transportMaxHeaderListSize is the
+	// SETTINGS_MAX_HEADER_LIST_SIZE advertised by the Transport.
+	http2transportMaxHeaderListSize = 10 << 20
+)
+
+var (
+	// errHTTP2RetryRequest is returned by http2clientConn.RoundTrip
+	// when the request wasn't processed by the server and can be
+	// retried on another connection.
+	errHTTP2RetryRequest = errors.New("http2: request not processed; retry on a new connection")
+
+	// errHTTP2StopBody stops the request body writer once the server
+	// has sent a complete response and doesn't want the rest.
+	errHTTP2StopBody = errors.New("http2: server no longer wants request body")
+
+	errHTTP2RequestCanceled = errors.New("net/http: request canceled")
+)
+
+// setupHTTP2 enables HTTP/2 on t if the user asked for it and
+// didn't configure TLSNextProto themselves.
+func (t *Transport) setupHTTP2() {
+	t.nextProtoOnce.Do(t.onceSetNextProtoDefaults)
+}
+
+func (t *Transport) onceSetNextProtoDefaults() {
+	if !t.EnableHTTP2 || t.TLSNextProto != nil {
+		return
+	}
+	t.TLSNextProto = map[string]func(string, *tls.Conn) RoundTripper{
+		http2NextProtoTLS: func(authority string, c *tls.Conn) RoundTripper {
+			cc, err := t.newHTTP2ClientConn(authority, c)
+			if err != nil {
+				c.Close()
+				return http2erringRoundTripper{err}
+			}
+			return cc
+		},
+	}
+}
+
+// http2erringRoundTripper is returned by TLSNextProto when the HTTP/2
+// connection couldn't be set up.
+type http2erringRoundTripper struct{ err error }
+
+func (rt http2erringRoundTripper) RoundTrip(*Request) (*Response, error) { return nil, rt.err }
+
+// tlsNextProtos returns the ALPN protocols the Transport offers to
+// authority.
+func (t *Transport) tlsNextProtos(authority string) []string {
+	if len(t.TLSNextProto) == 0 {
+		return nil
+	}
+	protos := make([]string, 0, len(t.TLSNextProto)+1)
+	if _, ok := t.TLSNextProto[http2NextProtoTLS]; ok && !t.http2Refused(authority) {
+		protos = append(protos, http2NextProtoTLS)
+	}
+	var others []string
+	for p := range t.TLSNextProto {
+		if p != http2NextProtoTLS {
+			others = append(others, p)
+		}
+	}
+	sort.Strings(others)
+	protos = append(protos, others...)
+	return append(protos, "http/1.1")
+}
+
+// refuseHTTP2 makes the Transport stop offering HTTP/2 to authority,
+// whose server chose it for a connection that RFC 7540 doesn't allow
+// it on.
+func (t *Transport) refuseHTTP2(authority string) {
+	t.h2mu.Lock()
+	defer t.h2mu.Unlock()
+	if t.h2refused == nil {
+		t.h2refused = make(map[string]bool)
+	}
+	t.h2refused[authority] = true
+}
+
+func (t *Transport) http2Refused(authority string) bool {
+	t.h2mu.Lock()
+	defer t.h2mu.Unlock()
+	return t.h2refused[authority]
+}
+
+func (t *Transport) addHTTP2Conn(key string, cc *http2clientConn) {
+	t.h2mu.Lock()
+	defer t.h2mu.Unlock()
+	if t.h2conns == nil {
+		t.h2conns = make(map[string][]*http2clientConn)
+	}
+	cc.key = key
+	t.h2conns[key] = append(t.h2conns[key], cc)
+}
+
+func (t *Transport) removeHTTP2Conn(cc *http2clientConn) {
+	t.h2mu.Lock()
+	defer t.h2mu.Unlock()
+	conns := t.h2conns[cc.key]
+	for i, c := range conns {
+		if c == cc {
+			conns = append(conns[:i], conns[i+1:]...)
+			break
+		}
+	}
+	if len(conns) == 0 {
+		delete(t.h2conns, cc.key)
+	} else {
+		t.h2conns[cc.key] = conns
+	}
+}
+
+// getHTTP2Conn returns a pooled HTTP/2 connection for key that can
+// take another request, or nil.
+func (t *Transport) getHTTP2Conn(key string) *http2clientConn {
+	t.h2mu.Lock()
+	defer t.h2mu.Unlock()
+	for _, cc := range t.h2conns[key] {
+		if cc.canTakeNewRequest() {
+			return cc
+		}
+	}
+	return nil
+}
+
+// closeIdleHTTP2Conns closes the pooled HTTP/2 connections that have
+// no active streams.
+func (t *Transport) closeIdleHTTP2Conns() {
+	t.h2mu.Lock()
+	var conns []*http2clientConn
+	for _, cs := range t.h2conns {
+		conns = append(conns, cs...)
+	}
+	t.h2mu.Unlock()
+	for _, cc := range conns {
+		cc.closeIfIdle()
+	}
+}
+
+// cancelHTTP2Request cancels req if it is in flight on an HTTP/2
+// connection, and reports whether it was.
+func (t *Transport) cancelHTTP2Request(req *Request) bool {
+	t.h2mu.Lock()
+	var conns []*http2clientConn
+	for _, cs := range t.h2conns {
+		conns = append(conns, cs...)
+	}
+	t.h2mu.Unlock()
+	for _, cc := range conns {
+		if cc.cancelRequest(req) {
+			return true
+		}
+	}
+	return false
+}
+
+// http2clientConn is the client side of an HTTP/2 connection.
+type http2clientConn struct {
+	t         *Transport
+	key       string // connectMethod key in t.h2conns; guarded by t.h2mu
+	tconn     *tls.Conn
+	framer    *http2Framer
+	singleUse bool // close after the first request, for DisableKeepAlives
+
+	// Owned by the read loop.
+	hdec         *http2hpackDecoder
+	headerStream uint32
+	headerEnd    bool // whether the block's HEADERS had END_STREAM
+	headerBlock  []byte
+	inHeaders    bool
+
+	wmu  sync.Mutex // guards the framer's writes, henc and hbuf
+	henc *http2hpackEncoder
+	hbuf []byte
+
+	mu                sync.Mutex
+	cond              sync.Cond // c.L is &mu; signalled when windows grow or streams end
+	streams           map[uint32]*http2clientStream
+	nextStreamID      uint32
+	usedOnce          bool
+	maxStreams        uint32 // the server's SETTINGS_MAX_CONCURRENT_STREAMS
+	peerMaxFrameSize  uint32
+	peerInitialWindow int32
+	sendWindow        int32
+	recvUnacked       int32
+	goAway            *http2GoAwayError
+	closed            bool
+}
+
+// http2clientStream is a request in flight on an http2clientConn. Its
+// fields are guarded by the connection's mu, except those owned by
+// the read loop.
+type http2clientStream struct {
+	cc            *http2clientConn
+	id            uint32
+	req           *Request
+	requestedGzip bool
+	resc          chan responseAndError // buffered; receives the response or error
+
+	// Owned by the read loop.
+	res         *Response
+	body        *http2pipe
+	pastHeaders bool
+	trailer     Header // moved to res.Trailer when the body reaches EOF
+
+	sendWindow   int32
+	recvUnacked  int32
+	localClosed  bool  // END_STREAM sent
+	remoteClosed bool  // END_STREAM received
+	abortErr     error // non-nil once the stream is reset or the conn lost
+	gotResponse  bool
+}
+
+// newHTTP2ClientConn starts HTTP/2 on c, a connection to authority.
+// If c doesn't meet the security requirements of HTTP/2, it tells the
+// server so and returns errHTTP2RetryRequest, after which requests to
+// authority use HTTP/1.1 on a new connection.
+func (t *Transport) newHTTP2ClientConn(authority string, c *tls.Conn) (*http2clientConn, error) {
+	state := c.ConnectionState()
+	if err := http2checkConnState(&state); err != nil {
+		t.refuseHTTP2(authority)
+		fr := http2newFramer(bufio.NewWriter(c), c)
+		fr.w.WriteString(http2ClientPreface)
+		fr.WriteGoAway(0, http2ErrCodeInadequateSecurity, []byte(err.Error()))
+		fr.Flush()
+		return nil, errHTTP2RetryRequest
+	}
+
+	cc := &http2clientConn{
+		t:                 t,
+		tconn:             c,
+		singleUse:         t.DisableKeepAlives,
+		hdec:              http2newHPACKDecoder(http2transportMaxHeaderListSize),
+		henc:              http2newHPACKEncoder(),
+		streams:           make(map[uint32]*http2clientStream),
+		nextStreamID:      1,
+		maxStreams:        1<<32 - 1,
+		peerMaxFrameSize:  http2initialMaxFrameSize,
+		peerInitialWindow: http2initialWindowSize,
+		sendWindow:        http2initialWindowSize,
+	}
+	cc.cond.L = &cc.mu
+	cc.framer = http2newFramer(bufio.NewWriter(c), bufio.NewReader(c))
+
+	err := cc.writeFrame(func(fr *http2Framer) error {
+		if _, err := fr.w.WriteString(http2ClientPreface); err != nil {
+			return err
+		}
+		err := fr.WriteSettings(
+			http2Setting{http2SettingEnablePush, 0},
+			http2Setting{http2SettingInitialWindowSize, http2transportStreamWindow},
+			http2Setting{http2SettingMaxHeaderListSize, http2transportMaxHeaderListSize},
+		)
+		if err != nil {
+			return err
+		}
+		return fr.WriteWindowUpdate(0, http2transportConnWindow-http2initialWindowSize)
+	})
+	if err != nil {
+		return nil, err
+	}
+	go cc.readLoop()
+	return cc, nil
+}
+
+func (cc *http2clientConn) writeFrame(fn func(*http2Framer) error) error {
+	cc.wmu.Lock()
+	defer cc.wmu.Unlock()
+	if err := fn(cc.framer); err != nil {
+		return err
+	}
+	return cc.framer.Flush()
+}
+
+func (cc *http2clientConn) canTakeNewRequest() bool {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.canTakeNewRequestLocked()
+}
+
+func (cc *http2clientConn) canTakeNewRequestLocked() bool {
+	return !cc.closed && cc.goAway == nil && !(cc.singleUse && cc.usedOnce) &&
+		uint32(len(cc.streams)) < cc.maxStreams && cc.nextStreamID < 1<<31
+}
+
+func (cc *http2clientConn) closeIfIdle() {
+	cc.mu.Lock()
+	if cc.closed || len(cc.streams) > 0 {
+		cc.mu.Unlock()
+		return
+	}
+	cc.closed = true
+	cc.mu.Unlock()
+	cc.tconn.Close()
+	cc.t.removeHTTP2Conn(cc)
+}
+
+// closeWithError closes the connection, failing its streams with err.
+func (cc *http2clientConn) closeWithError(err error) {
+	cc.mu.Lock()
+	if cc.closed {
+		cc.mu.Unlock()
+		return
+	}
+	cc.closed = true
+	for _, cs := range cc.streams {
+		cc.abortStreamLocked(cs, err)
+	}
+	cc.cond.Broadcast()
+	cc.mu.Unlock()
+	cc.tconn.Close()
+	cc.t.removeHTTP2Conn(cc)
+}
+
+// abortStreamLocked fails cs with err and forgets it. cc.mu must be
+// held.
+func (cc *http2clientConn) abortStreamLocked(cs *http2clientStream, err error) {
+	if cs.abortErr == nil {
+		cs.abortErr = err
+		if !cs.gotResponse {
+			cs.gotResponse = true
+			cs.resc <- responseAndError{nil, err}
+		}
+		if cs.body != nil {
+			bodyErr := err
+			if _, ok := err.(http2GoAwayError); ok || err == errHTTP2RetryRequest {
+				bodyErr = io.ErrUnexpectedEOF
+			}
+			cc.recvUnacked += int32(cs.body.BreakWithError(bodyErr))
+		}
+	}
+	cc.forgetStreamLocked(cs)
+}
+
+func (cc *http2clientConn) forgetStreamLocked(cs *http2clientStream) {
+	if _, ok := cc.streams[cs.id]; !ok {
+		return
+	}
+	delete(cc.streams, cs.id)
+	cc.cond.Broadcast()
+	if len(cc.streams) == 0 && (cc.goAway != nil || cc.singleUse) && !cc.closed {
+		go cc.closeWithError(errors.New("http2: connection closed"))
+	}
+}
+
+// resetStream sends RST_STREAM for cs and fails it with err.
+func (cc *http2clientConn) resetStream(cs *http2clientStream, code http2ErrCode, err error) {
+	cc.mu.Lock()
+	_, active := cc.streams[cs.id]
+	cc.abortStreamLocked(cs, err)
+	cc.mu.Unlock()
+	if active {
+		cc.writeFrame(func(fr *http2Framer) error {
+			return fr.WriteRSTStream(cs.id, code)
+		})
+	}
+	cc.sendWindowUpdates(nil)
+}
+
+func (cc *http2clientConn) cancelRequest(req *Request) bool {
+	cc.mu.Lock()
+	var cs *http2clientStream
+	for _, s := range cc.streams {
+		if s.req == req {
+			cs = s
+			break
+		}
+	}
+	cc.mu.Unlock()
+	if cs == nil {
+		return false
+	}
+	cc.resetStream(cs, http2ErrCodeCancel, errHTTP2RequestCanceled)
+	return true
+}
+
+// RoundTrip sends req on a new stream and waits for its response.
+func (cc *http2clientConn) RoundTrip(req *Request) (*Response, error) {
+	hasBody := req.Body != nil
+	requestedGzip := !cc.t.DisableCompression && req.Header.Get("Accept-Encoding") == "" &&
+		req.Header.Get("Range") == "" && req.Method != "HEAD"
+
+	cc.mu.Lock()
+	if !cc.canTakeNewRequestLocked() {
+		cc.mu.Unlock()
+		return nil, errHTTP2RetryRequest
+	}
+	cs := &http2clientStream{
+		cc:            cc,
+		id:            cc.nextStreamID,
+		req:           req,
+		requestedGzip: requestedGzip,
+		resc:          make(chan responseAndError, 1),
+		sendWindow:    cc.peerInitialWindow,
+	}
+	cc.nextStreamID += 2
+	cc.usedOnce = true
+	cc.streams[cs.id] = cs
+	maxFrameSize := cc.peerMaxFrameSize
+	cc.mu.Unlock()
+
+	fields := cc.requestHeaderFields(req, requestedGzip)
+	err := cc.writeFrame(func(fr *http2Framer) error {
+		cc.hbuf = cc.henc.encode(cc.hbuf[:0], fields)
+		return fr.WriteHeaders(cs.id, !hasBody, cc.hbuf, maxFrameSize)
+	})
+	if err != nil {
+		cc.closeWithError(err)
+		return nil, err
+	}
+	trace := req.Trace
+	if trace != nil && trace.WroteHeaders != nil {
+		trace.WroteHeaders()
+	}
+
+	var bodyErrc chan error
+	var respHeaderTimer <-chan time.Time
+	if hasBody {
+		bodyErrc = make(chan error, 1)
+		go func() {
+			err := cs.writeRequestBody(req.Body)
+			if err != nil {
+				cc.resetStream(cs, http2ErrCodeCancel, err)
+			}
+			if trace != nil && trace.WroteRequest != nil {
+				trace.WroteRequest(httptrace.WroteRequestInfo{Err: err})
+			}
+			bodyErrc <- err
+		}()
+	} else {
+		cc.mu.Lock()
+		cs.localClosed = true
+		cc.mu.Unlock()
+		if trace != nil && trace.WroteRequest != nil {
+			trace.WroteRequest(httptrace.WroteRequestInfo{})
+		}
+		if d := cc.t.ResponseHeaderTimeout; d > 0 {
+			respHeaderTimer = time.After(d)
+		}
+	}
+
+	for {
+		select {
+		case re := <-cs.resc:
+			if re.err != nil && !hasBody {
+				if ga, ok := re.err.(http2GoAwayError); ok && cs.id > ga.LastStreamID {
+					re.err = errHTTP2RetryRequest
+				}
+			}
+			return re.res, re.err
+		case err := <-bodyErrc:
+			bodyErrc = nil
+			if err != nil {
+				return nil, err
+			}
+			if d := cc.t.ResponseHeaderTimeout; d > 0 {
+				respHeaderTimer = time.After(d)
+			}
+		case <-respHeaderTimer:
+			err := errors.New("net/http: timeout awaiting response headers")
+			cc.resetStream(cs, http2ErrCodeCancel, err)
+			return nil, err
+		}
+	}
+}
+
+// requestHeaderFields returns the header fields for req.
+func (cc *http2clientConn) requestHeaderFields(req *Request, requestedGzip bool) []http2headerField {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	fields := []http2headerField{
+		{name: ":authority", value: host},
+		{name: ":method", value: valueOrDefault(req.Method, "GET")},
+		{name: ":path", value: req.URL.RequestURI()},
+		{name: ":scheme", value: "https"},
+	}
+	h := make(Header, len(req.Header)+3)
+	for k, vv := range req.Header {
+		switch k {
+		case "Host", "Content-Length", "Trailer":
+			// Sent as :authority, or computed below.
+			continue
+		case "Te":
+			// Only "trailers" is allowed in HTTP/2.
+			continue
+		}
+		h[k] = vv
+	}
+	if req.Body != nil && req.ContentLength > 0 {
+		h.Set("Content-Length", strconv.FormatInt(req.ContentLength, 10))
+	}
+	if req.Body != nil && len(req.Trailer) > 0 {
+		keys := make([]string, 0, len(req.Trailer))
+		for k := range req.Trailer {
+			keys = append(keys, CanonicalHeaderKey(k))
+		}
+		sort.Strings(keys)
+		h.Set("Trailer", strings.Join(keys, ","))
+	}
+	if _, ok := h["User-Agent"]; !ok {
+		h.Set("User-Agent", defaultUserAgent)
+	}
+	if requestedGzip {
+		h.Set("Accept-Encoding", "gzip")
+	}
+	return http2appendHeaderFields(fields, h)
+}
+
+// writeRequestBody copies body to the stream as DATA frames.
+func (cs *http2clientStream) writeRequestBody(body io.ReadCloser) error {
+	defer body.Close()
+	cc := cs.cc
+	sendTrailers := len(cs.req.Trailer) > 0
+	buf := make([]byte, http2initialMaxFrameSize)
+	var written int64
+	var err error
+	for err == nil {
+		n, rerr := body.Read(buf)
+		written += int64(n)
+		eof := rerr == io.EOF
+		if rerr != nil && !eof {
+			return rerr
+		}
+		if cl := cs.req.ContentLength; cl > 0 && (written > cl || eof && written != cl) {
+			return fmt.Errorf("http: ContentLength=%d with Body length %d", cs.req.ContentLength, written)
+		}
+		endStream := eof && !sendTrailers
+		if n > 0 || endStream {
+			err = cc.writeData(cs, buf[:n], endStream)
+		}
+		if eof {
+			if err == nil && sendTrailers {
+				err = cc.writeTrailers(cs)
+			}
+			break
+		}
+	}
+	if err == errHTTP2StopBody {
+		cc.stopRequestBody(cs)
+		return nil
+	}
+	return err
+}
+
+// writeTrailers sends the request's trailers in a HEADERS frame that
+// ends the stream. The values are only looked at now, after the body
+// has been read.
+func (cc *http2clientConn) writeTrailers(cs *http2clientStream) error {
+	cc.mu.Lock()
+	if cs.abortErr != nil {
+		err := cs.abortErr
+		cc.mu.Unlock()
+		return err
+	}
+	if cs.remoteClosed && cs.gotResponse {
+		cc.mu.Unlock()
+		return errHTTP2StopBody
+	}
+	maxFrameSize := cc.peerMaxFrameSize
+	cc.mu.Unlock()
+
+	fields := http2appendHeaderFields(nil, cs.req.Trailer)
+	err := cc.writeFrame(func(fr *http2Framer) error {
+		cc.hbuf = cc.henc.encode(cc.hbuf[:0], fields)
+		return fr.WriteHeaders(cs.id, true, cc.hbuf, maxFrameSize)
+	})
+	if err == nil {
+		cc.noteLocalEnd(cs)
+	}
+	return err
+}
+
+// noteLocalEnd records that END_STREAM was sent on cs.
+func (cc *http2clientConn) noteLocalEnd(cs *http2clientStream) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cs.localClosed = true
+	if cs.remoteClosed {
+		cc.forgetStreamLocked(cs)
+	}
+}
+
+// stopRequestBody closes cs's half of the stream without sending
+// the rest of the request body, after a complete response.
+func (cc *http2clientConn) stopRequestBody(cs *http2clientStream) {
+	cc.mu.Lock()
+	_, active := cc.streams[cs.id]
+	cc.forgetStreamLocked(cs)
+	cc.mu.Unlock()
+	if active {
+		cc.writeFrame(func(fr *http2Framer) error {
+			return fr.WriteRSTStream(cs.id, http2ErrCodeCancel)
+		})
+	}
+}
+
+// writeData sends p on cs as DATA frames, waiting for flow control
+// window as needed.
+func (cc *http2clientConn) writeData(cs *http2clientStream, p []byte, endStream bool) error {
+	for {
+		cc.mu.Lock()
+		for cs.abortErr == nil && !cs.remoteClosed && len(p) > 0 && (cs.sendWindow <= 0 || cc.sendWindow <= 0) {
+			cc.cond.Wait()
+		}
+		if cs.abortErr != nil {
+			err := cs.abortErr
+			cc.mu.Unlock()
+			return err
+		}
+		if cs.remoteClosed && cs.gotResponse {
+			// The response is complete; the server has no
+			// use for the rest of the body.
+			cc.mu.Unlock()
+			return errHTTP2StopBody
+		}
+		n := int32(len(p))
+		if n > cs.sendWindow {
+			n = cs.sendWindow
+		}
+		if n > cc.sendWindow {
+			n = cc.sendWindow
+		}
+		if max := int32(cc.peerMaxFrameSize); n > max {
+			n = max
+		}
+		cs.sendWindow -= n
+		cc.sendWindow -= n
+		cc.mu.Unlock()
+
+		chunk := p[:n]
+		p = p[n:]
+		end := endStream && len(p) == 0
+		err := cc.writeFrame(func(fr *http2Framer) error {
+			return fr.WriteData(cs.id, end, chunk)
+		})
+		if err != nil {
+			return err
+		}
+		if len(p) == 0 {
+			if end {
+				cc.noteLocalEnd(cs)
+			}
+			return nil
+		}
+	}
+}
+
+func (cc *http2clientConn) readLoop() {
+	var err error
+	defer func() {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		cc.mu.Lock()
+		if ga := cc.goAway; ga != nil {
+			err = *ga
+		}
+		cc.mu.Unlock()
+		cc.closeWithError(err)
+	}()
+	for {
+		var fh http2FrameHeader
+		var p []byte
+		fh, p, err = cc.framer.ReadFrame()
+		if err == nil {
+			err = cc.processFrame(fh, p)
+		}
+		if se, ok := err.(http2StreamError); ok {
+			cc.mu.Lock()
+			cs := cc.streams[se.StreamID]
+			cc.mu.Unlock()
+			if cs != nil {
+				cc.resetStream(cs, se.Code, se)
+			}
+			err = nil
+		}
+		if ce, ok := err.(http2ConnectionError); ok {
+			cc.writeFrame(func(fr *http2Framer) error {
+				return fr.WriteGoAway(0, http2ErrCode(ce), nil)
+			})
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (cc *http2clientConn) processFrame(fh http2FrameHeader, p []byte) error {
+	if cc.inHeaders && (fh.Type != http2FrameContinuation || fh.StreamID != cc.headerStream) {
+		return http2ConnectionError(http2ErrCodeProtocol)
+	}
+	switch fh.Type {
+	case http2FrameSettings:
+		return cc.processSettings(fh, p)
+	case http2FrameHeaders:
+		frag, err := http2headersFragment(fh, p)
+		if err != nil {
+			return err
+		}
+		cc.headerStream = fh.StreamID
+		cc.headerEnd = fh.has(http2FlagEndStream)
+		cc.headerBlock = append(cc.headerBlock[:0], frag...)
+		if fh.has(http2FlagEndHeaders) {
+			return cc.endHeaderBlock()
+		}
+		cc.inHeaders = true
+		return nil
+	case http2FrameContinuation:
+		if !cc.inHeaders {
+			return http2ConnectionError(http2ErrCodeProtocol)
+		}
+		cc.headerBlock = append(cc.headerBlock, p...)
+		if !fh.has(http2FlagEndHeaders) {
+			return nil
+		}
+		cc.inHeaders = false
+		return cc.endHeaderBlock()
+	case http2FrameData:
+		return cc.processData(fh, p)
+	case http2FrameWindowUpdate:
+		return cc.processWindowUpdate(fh, p)
+	case http2FrameRSTStream:
+		cc.processResetStream(fh.StreamID, http2ErrCode(uint32(p[0])<<24|uint32(p[1])<<16|uint32(p[2])<<8|uint32(p[3])))
+		return nil
+	case http2FramePing:
+		if fh.has(http2FlagAck) {
+			return nil
+		}
+		var data [8]byte
+		copy(data[:], p)
+		return cc.writeFrame(func(fr *http2Framer) error {
+			return fr.WritePing(true, data)
+		})
+	case http2FrameGoAway:
+		cc.processGoAway(http2parseGoAway(p))
+		return nil
+	case http2FramePushPromise:
+		// Push is disabled in our SETTINGS.
+		return http2ConnectionError(http2ErrCodeProtocol)
+	}
+	return nil
+}
+
+func (cc *http2clientConn) processSettings(fh http2FrameHeader, p []byte) error {
+	if fh.has(http2FlagAck) {
+		return nil
+	}
+	settings, err := http2parseSettings(p)
+	if err != nil {
+		return err
+	}
+	var tableSize *uint32
+	cc.mu.Lock()
+	for i, s := range settings {
+		switch s.ID {
+		case http2SettingHeaderTableSize:
+			tableSize = &settings[i].Val
+		case http2SettingMaxConcurrentStreams:
+			cc.maxStreams = s.Val
+		case http2SettingInitialWindowSize:
+			delta := int32(s.Val) - cc.peerInitialWindow
+			cc.peerInitialWindow = int32(s.Val)
+			for _, cs := range cc.streams {
+				if int64(cs.sendWindow)+int64(delta) > http2maxWindowSize {
+					cc.mu.Unlock()
+					return http2ConnectionError(http2ErrCodeFlowControl)
+				}
+				cs.sendWindow += delta
+			}
+		case http2SettingMaxFrameSize:
+			cc.peerMaxFrameSize = s.Val
+		}
+	}
+	cc.cond.Broadcast()
+	cc.mu.Unlock()
+
+	return cc.writeFrame(func(fr *http2Framer) error {
+		if tableSize != nil {
+			cc.henc.setMaxDynamicTableSizeLimit(*tableSize)
+		}
+		return fr.WriteSettingsAck()
+	})
+}
+
+func (cc *http2clientConn) processWindowUpdate(fh http2FrameHeader, p []byte) error {
+	inc, err := http2parseWindowUpdate(fh, p)
+	if err != nil {
+		return err
+	}
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if fh.StreamID == 0 {
+		if int64(cc.sendWindow)+int64(inc) > http2maxWindowSize {
+			return http2ConnectionError(http2ErrCodeFlowControl)
+		}
+		cc.sendWindow += int32(inc)
+	} else if cs := cc.streams[fh.StreamID]; cs != nil {
+		if int64(cs.sendWindow)+int64(inc) > http2maxWindowSize {
+			return http2StreamError{fh.StreamID, http2ErrCodeFlowControl}
+		}
+		cs.sendWindow += int32(inc)
+	}
+	cc.cond.Broadcast()
+	return nil
+}
+
+func (cc *http2clientConn) processResetStream(id uint32, code http2ErrCode) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cs := cc.streams[id]
+	if cs == nil {
+		return
+	}
+	switch {
+	case code == http2ErrCodeNo && cs.remoteClosed:
+		// The response is complete and the server doesn't
+		// want the rest of the request body.
+		cs.abortErr = errHTTP2StopBody
+		cc.forgetStreamLocked(cs)
+	case code == http2ErrCodeRefusedStream && cs.req.Body == nil:
+		cc.abortStreamLocked(cs, errHTTP2RetryRequest)
+	default:
+		cc.abortStreamLocked(cs, http2StreamError{id, code})
+	}
+}
+
+// processGoAway stops new requests on the connection and fails the
+// streams the server didn't process; requests without bodies are
+// retried by Transport.RoundTrip.
+func (cc *http2clientConn) processGoAway(ga http2GoAwayError) {
+	cc.mu.Lock()
+	cc.goAway = &ga
+	for id, cs := range cc.streams {
+		if id > ga.LastStreamID {
+			cc.abortStreamLocked(cs, ga)
+		}
+	}
+	idle := len(cc.streams) == 0
+	cc.mu.Unlock()
+	cc.t.removeHTTP2Conn(cc)
+	if idle {
+		cc.closeWithError(ga)
+	}
+}
+
+func (cc *http2clientConn) endHeaderBlock() error {
+	fields, err := cc.hdec.decode(cc.headerBlock)
+	if err != nil {
+		return http2ConnectionError(http2ErrCodeCompression)
+	}
+	endStream := cc.headerEnd
+	cc.mu.Lock()
+	cs := cc.streams[cc.headerStream]
+	cc.mu.Unlock()
+	if cs == nil {
+		// A stream we've reset.
+		return nil
+	}
+	if cs.pastHeaders {
+		return cc.processTrailers(cs, fields, endStream)
+	}
+	if trace := cs.req.Trace; trace != nil && trace.GotFirstResponseByte != nil {
+		trace.GotFirstResponseByte()
+	}
+
+	header := make(Header)
+	status := ""
+	for _, f := range fields {
+		if f.name == ":status" {
+			status = f.value
+			continue
+		}
+		if strings.HasPrefix(f.name, ":") {
+			return http2StreamError{cs.id, http2ErrCodeProtocol}
+		}
+		header.Add(CanonicalHeaderKey(f.name), f.value)
+	}
+	code, err := strconv.Atoi(status)
+	if err != nil || len(status) != 3 {
+		return http2StreamError{cs.id, http2ErrCodeProtocol}
+	}
+	if code >= 100 && code <= 199 {
+		// Informational responses, such as 100 Continue,
+		// are skipped.
+		if endStream {
+			return http2StreamError{cs.id, http2ErrCodeProtocol}
+		}
+		return nil
+	}
+	cs.pastHeaders = true
+
+	res := &Response{
+		Status:     status + " " + StatusText(code),
+		StatusCode: code,
+		Proto:      "HTTP/2.0",
+		ProtoMajor: 2,
+		ProtoMinor: 0,
+		Header:     header,
+		Request:    cs.req,
+	}
+	res.ContentLength = -1
+	if cl := header.get("Content-Length"); cl != "" {
+		if n, err := strconv.ParseInt(cl, 10, 64); err == nil && n >= 0 {
+			res.ContentLength = n
+		}
+	}
+	for _, key := range declaredTrailers(header) {
+		if res.Trailer == nil {
+			res.Trailer = make(Header)
+		}
+		res.Trailer[key] = nil
+	}
+	header.Del("Trailer")
+	cs.res = res
+
+	if endStream {
+		if cs.req.Method != "HEAD" {
+			res.ContentLength = 0
+		}
+		res.Body = eofReader
+		cc.mu.Lock()
+		cs.gotResponse = true
+		cs.remoteClosed = true
+		cs.resc <- responseAndError{res: res}
+		if cs.localClosed {
+			cc.forgetStreamLocked(cs)
+		}
+		cc.mu.Unlock()
+		return nil
+	}
+
+	cs.body = http2newPipe(func(n int) { cc.noteBodyRead(cs, n) })
+	res.Body = &http2responseBody{cs: cs}
+	if cs.requestedGzip && header.get("Content-Encoding") == "gzip" {
+		header.Del("Content-Encoding")
+		header.Del("Content-Length")
+		res.ContentLength = -1
+		res.Body = &http2gzipReader{body: res.Body}
+	}
+	cc.mu.Lock()
+	cs.gotResponse = true
+	cs.resc <- responseAndError{res: res}
+	cc.mu.Unlock()
+	return nil
+}
+
+func (cc *http2clientConn) processTrailers(cs *http2clientStream, fields []http2headerField, endStream bool) error {
+	if !endStream {
+		return http2StreamError{cs.id, http2ErrCodeProtocol}
+	}
+	trailer := make(Header)
+	for _, f := range fields {
+		if strings.HasPrefix(f.name, ":") {
+			return http2StreamError{cs.id, http2ErrCodeProtocol}
+		}
+		trailer.Add(CanonicalHeaderKey(f.name), f.value)
+	}
+	cs.trailer = trailer
+	cc.endResponseBody(cs)
+	return nil
+}
+
+// endResponseBody handles END_STREAM from the server after the
+// response header.
+func (cc *http2clientConn) endResponseBody(cs *http2clientStream) {
+	if cs.body != nil {
+		cs.body.CloseWithError(io.EOF)
+	}
+	cc.mu.Lock()
+	cs.remoteClosed = true
+	cc.cond.Broadcast()
+	if cs.localClosed {
+		cc.forgetStreamLocked(cs)
+	}
+	cc.mu.Unlock()
+}
+
+func (cc *http2clientConn) processData(fh http2FrameHeader, p []byte) error {
+	n := len(p)
+	cc.mu.Lock()
+	cs := cc.streams[fh.StreamID]
+	cc.mu.Unlock()
+	if cs == nil || cs.body == nil {
+		// A stream we've reset or that was never opened; return
+		// the connection-level flow control credit.
+		cc.mu.Lock()
+		cc.recvUnacked += int32(n)
+		cc.mu.Unlock()
+		cc.sendWindowUpdates(nil)
+		if cs != nil {
+			return http2StreamError{fh.StreamID, http2ErrCodeProtocol}
+		}
+		return nil
+	}
+	data, err := http2stripPadding(fh, p)
+	if err != nil {
+		return err
+	}
+	if pad := n - len(data); pad > 0 {
+		cc.noteBodyRead(cs, pad)
+	}
+	if len(data) > 0 {
+		if _, err := cs.body.Write(data); err != nil {
+			cc.mu.Lock()
+			cc.recvUnacked += int32(len(data))
+			cc.mu.Unlock()
+			cc.sendWindowUpdates(nil)
+		}
+	}
+	if fh.has(http2FlagEndStream) {
+		cc.endResponseBody(cs)
+	}
+	return nil
+}
+
+// noteBodyRead returns flow control credit for n bytes of cs's
+// response body that have been consumed.
+func (cc *http2clientConn) noteBodyRead(cs *http2clientStream, n int) {
+	cc.mu.Lock()
+	cc.recvUnacked += int32(n)
+	if !cs.remoteClosed && cs.abortErr == nil {
+		cs.recvUnacked += int32(n)
+	}
+	cc.mu.Unlock()
+	cc.sendWindowUpdates(cs)
+}
+
+// sendWindowUpdates sends WINDOW_UPDATE frames once half of a window
+// has been consumed.
+func (cc *http2clientConn) sendWindowUpdates(cs *http2clientStream) {
+	var connInc, streamInc int32
+	cc.mu.Lock()
+	if cc.recvUnacked >= http2transportConnWindow/2 || cc.recvUnacked > 0 && cc.closed {
+		connInc = cc.recvUnacked
+		cc.recvUnacked = 0
+	}
+	if cs != nil && cs.recvUnacked >= http2transportStreamWindow/2 && !cs.remoteClosed && cs.abortErr == nil {
+		streamInc = cs.recvUnacked
+		cs.recvUnacked = 0
+	}
+	closed := cc.closed
+	cc.mu.Unlock()
+	if closed || connInc == 0 && streamInc == 0 {
+		return
+	}
+	cc.writeFrame(func(fr *http2Framer) error {
+		if connInc > 0 {
+			if err := fr.WriteWindowUpdate(0, uint32(connInc)); err != nil {
+				return err
+			}
+		}
+		if streamInc > 0 {
+			return fr.WriteWindowUpdate(cs.id, uint32(streamInc))
+		}
+		return nil
+	})
+}
+
+// http2responseBody is the Response.Body of an HTTP/2 response.
+type http2responseBody struct {
+	cs *http2clientStream
+}
+
+func (b *http2responseBody) Read(p []byte) (n int, err error) {
+	n, err = b.cs.body.Read(p)
+	if err == io.EOF && b.cs.trailer != nil {
+		mergeSetHeader(&b.cs.res.Trailer, b.cs.trailer)
+		b.cs.trailer = nil
+	}
+	return n, err
+}
+
+func (b *http2responseBody) Close() error {
+	cs := b.cs
+	cc := cs.cc
+	cc.mu.Lock()
+	done := cs.remoteClosed || cs.abortErr != nil
+	cc.mu.Unlock()
+	if !done {
+		// Tell the server to stop sending the rest.
+		cc.resetStream(cs, http2ErrCodeCancel, errors.New("http: read on closed response body"))
+		return nil
+	}
+	if n := cs.body.BreakWithError(errors.New("http: read on closed response body")); n > 0 {
+		cc.mu.Lock()
+		cc.recvUnacked += int32(n)
+		cc.mu.Unlock()
+		cc.sendWindowUpdates(nil)
+	}
+	return nil
+}
+
+// http2gzipReader decompresses a response body lazily, so that
+// reading the gzip header doesn't happen in the connection's read
+// loop.
+type http2gzipReader struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	zerr error
+}
+
+func (gz *http2gzipReader) Read(p []byte) (n int, err error) {
+	if gz.zerr != nil {
+		return 0, gz.zerr
+	}
+	if gz.zr == nil {
+		gz.zr, err = gzip.NewReader(gz.body)
+		if err != nil {
+			gz.zerr = err
+			return 0, err
+		}
+	}
+	return gz.zr.Read(p)
+}
+
+func (gz *http2gzipReader) Close() error {
+	return gz.body.Close()
+}
@@ -0,0 +1,145 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Request construction and checking.
+
+package httptest
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// NewRequest returns a new incoming server Request, suitable for
+// passing to an http.Handler for testing.
+//
+// The target is the RFC 2616 Request-URI: it may be either a path
+// or an absolute URL. If target is an absolute URL, the host name
+// from the URL is used. Otherwise, "example.com" is used.
+//
+// The TLS field is set to a non-nil dummy value if target has
+// scheme "https".
+//
+// The Request.Proto is always HTTP/1.1.
+//
+// An empty method means "GET".
+//
+// The provided body may be nil. If the body is of type
+// *bytes.Reader, *strings.Reader, or *bytes.Buffer, the
+// Request.ContentLength is set.
+//
+// NewRequest panics on error for ease of use in testing, where a
+// panic is acceptable.
+func NewRequest(method, target string, body io.Reader) *http.Request {
+	if method == "" {
+		method = "GET"
+	}
+	req, err := http.ReadRequest(bufio.NewReader(strings.NewReader(method + " " + target + " HTTP/1.0\r\n\r\n")))
+	if err != nil {
+		panic("httptest: invalid NewRequest arguments; " + err.Error())
+	}
+
+	// HTTP/1.0 was used above to avoid needing a Host field.
+	// Change it to 1.1 here.
+	req.Proto = "HTTP/1.1"
+	req.ProtoMinor = 1
+	req.Close = false
+
+	if body != nil {
+		switch v := body.(type) {
+		case *bytes.Buffer:
+			req.ContentLength = int64(v.Len())
+		case *bytes.Reader:
+			req.ContentLength = int64(v.Len())
+		case *strings.Reader:
+			req.ContentLength = int64(v.Len())
+		default:
+			req.ContentLength = -1
+		}
+		if rc, ok := body.(io.ReadCloser); ok {
+			req.Body = rc
+		} else {
+			req.Body = ioutil.NopCloser(body)
+		}
+	}
+
+	// 192.0.2.0/24 is "TEST-NET" in RFC 5737 for use solely in
+	// documentation and example source code and should not be
+	// used publicly.
+	req.RemoteAddr = "192.0.2.1:1234"
+
+	if req.Host == "" {
+		req.Host = "example.com"
+	}
+
+	if strings.HasPrefix(target, "https://") {
+		req.TLS = &tls.ConnectionState{
+			HandshakeComplete: true,
+			ServerName:        req.Host,
+		}
+	}
+
+	return req
+}
+
+// MatchRequest reports how the request got, as received by a server,
+// differs from want, or returns nil if it doesn't.
+//
+// The method and the URL path must match. The query must match too,
+// if want has one. Every header in want.Header must have the same
+// values in got.Header; got may have more. If want has a body, that
+// is a non-zero ContentLength, both bodies must have the same
+// contents; they are read, and replaced by readers of the same
+// contents.
+//
+// want is typically built with NewRequest or http.NewRequest.
+func MatchRequest(got, want *http.Request) error {
+	if got.Method != want.Method {
+		return fmt.Errorf("method = %q; want %q", got.Method, want.Method)
+	}
+	if got.URL.Path != want.URL.Path {
+		return fmt.Errorf("path = %q; want %q", got.URL.Path, want.URL.Path)
+	}
+	if q := want.URL.RawQuery; q != "" && got.URL.Query().Encode() != want.URL.Query().Encode() {
+		return fmt.Errorf("query = %q; want %q", got.URL.RawQuery, q)
+	}
+	for k, wantv := range want.Header {
+		gotv := got.Header[k]
+		if strings.Join(gotv, "\x00") != strings.Join(wantv, "\x00") {
+			return fmt.Errorf("header %s = %q; want %q", k, gotv, wantv)
+		}
+	}
+	if want.Body == nil || want.ContentLength == 0 {
+		return nil
+	}
+	wantBody, err := readBody(&want.Body)
+	if err != nil {
+		return fmt.Errorf("reading wanted body: %v", err)
+	}
+	var gotBody []byte
+	if got.Body != nil {
+		if gotBody, err = readBody(&got.Body); err != nil {
+			return fmt.Errorf("reading body: %v", err)
+		}
+	}
+	if !bytes.Equal(gotBody, wantBody) {
+		return fmt.Errorf("body = %q; want %q", gotBody, wantBody)
+	}
+	return nil
+}
+
+// readBody reads and closes *body, replacing it with a reader of the
+// same contents.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	b, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, err
+}
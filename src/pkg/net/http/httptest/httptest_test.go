@@ -0,0 +1,124 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httptest
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNewRequest(t *testing.T) {
+	tests := []struct {
+		method, target string
+		body           io.Reader
+
+		wantMethod string
+		wantHost   string
+		wantPath   string
+		wantQuery  string
+		wantLength int64
+		wantBody   string
+		wantTLS    bool
+	}{
+		{
+			method: "GET", target: "/",
+			wantMethod: "GET", wantHost: "example.com", wantPath: "/",
+		},
+		{
+			target:     "/foo?a=1",
+			wantMethod: "GET", wantHost: "example.com", wantPath: "/foo", wantQuery: "a=1",
+		},
+		{
+			method: "POST", target: "http://foo.com/bar", body: strings.NewReader("hello"),
+			wantMethod: "POST", wantHost: "foo.com", wantPath: "/bar", wantLength: 5, wantBody: "hello",
+		},
+		{
+			method: "PUT", target: "https://foo.com/", body: bytes.NewBufferString("buffer"),
+			wantMethod: "PUT", wantHost: "foo.com", wantPath: "/", wantLength: 6, wantBody: "buffer", wantTLS: true,
+		},
+		{
+			method: "POST", target: "/", body: io.MultiReader(strings.NewReader("multi")),
+			wantMethod: "POST", wantHost: "example.com", wantPath: "/", wantLength: -1, wantBody: "multi",
+		},
+	}
+	for i, tt := range tests {
+		req := NewRequest(tt.method, tt.target, tt.body)
+		if req.Method != tt.wantMethod {
+			t.Errorf("%d. Method = %q; want %q", i, req.Method, tt.wantMethod)
+		}
+		if req.Host != tt.wantHost {
+			t.Errorf("%d. Host = %q; want %q", i, req.Host, tt.wantHost)
+		}
+		if req.URL.Path != tt.wantPath || req.URL.RawQuery != tt.wantQuery {
+			t.Errorf("%d. URL = %v; want path %q, query %q", i, req.URL, tt.wantPath, tt.wantQuery)
+		}
+		if req.RequestURI != tt.target {
+			t.Errorf("%d. RequestURI = %q; want %q", i, req.RequestURI, tt.target)
+		}
+		if !req.ProtoAtLeast(1, 1) || req.Proto != "HTTP/1.1" {
+			t.Errorf("%d. Proto = %q; want HTTP/1.1", i, req.Proto)
+		}
+		if req.ContentLength != tt.wantLength {
+			t.Errorf("%d. ContentLength = %d; want %d", i, req.ContentLength, tt.wantLength)
+		}
+		if (req.TLS != nil) != tt.wantTLS {
+			t.Errorf("%d. TLS = %v; want non-nil: %v", i, req.TLS, tt.wantTLS)
+		}
+		if req.RemoteAddr == "" {
+			t.Errorf("%d. RemoteAddr is empty", i)
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil || string(body) != tt.wantBody {
+			t.Errorf("%d. Body = %q, %v; want %q", i, body, err, tt.wantBody)
+		}
+	}
+}
+
+func TestMatchRequest(t *testing.T) {
+	got := func() *http.Request {
+		r := NewRequest("POST", "/foo?a=1&b=2", strings.NewReader("body"))
+		r.Header.Set("Content-Type", "text/plain")
+		r.Header.Set("User-Agent", "test")
+		return r
+	}
+	want := func(method, target, body, contentType string) *http.Request {
+		var b io.Reader
+		if body != "" {
+			b = strings.NewReader(body)
+		}
+		r := NewRequest(method, target, b)
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+		return r
+	}
+	tests := []struct {
+		want  *http.Request
+		match bool
+	}{
+		{want("POST", "/foo", "", ""), true},
+		{want("POST", "/foo?b=2&a=1", "body", "text/plain"), true},
+		{want("GET", "/foo", "", ""), false},
+		{want("POST", "/bar", "", ""), false},
+		{want("POST", "/foo?a=2", "", ""), false},
+		{want("POST", "/foo", "other", ""), false},
+		{want("POST", "/foo", "", "text/html"), false},
+	}
+	for i, tt := range tests {
+		r := got()
+		err := MatchRequest(r, tt.want)
+		if (err == nil) != tt.match {
+			t.Errorf("%d. MatchRequest = %v; want match: %v", i, err, tt.match)
+		}
+		// The body must still be readable.
+		if b, _ := ioutil.ReadAll(r.Body); string(b) != "body" {
+			t.Errorf("%d. body after MatchRequest = %q; want %q", i, b, "body")
+		}
+	}
+}
@@ -7,7 +7,10 @@ package httptest
 
 import (
 	"bytes"
+	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
 // ResponseRecorder is an implementation of http.ResponseWriter that
@@ -19,6 +22,8 @@ type ResponseRecorder struct {
 	Flushed   bool
 
 	wroteHeader bool
+	snapHeader  http.Header // HeaderMap as of WriteHeader
+	result      *http.Response
 }
 
 // NewRecorder returns an initialized ResponseRecorder.
@@ -55,12 +60,23 @@ func (rw *ResponseRecorder) Write(buf []byte) (int, error) {
 	return len(buf), nil
 }
 
-// WriteHeader sets rw.Code.
+// WriteHeader sets rw.Code and takes a snapshot of the headers, as
+// a server would send them.
 func (rw *ResponseRecorder) WriteHeader(code int) {
-	if !rw.wroteHeader {
-		rw.Code = code
+	if rw.wroteHeader {
+		return
 	}
+	rw.Code = code
 	rw.wroteHeader = true
+	rw.snapHeader = cloneHeader(rw.HeaderMap)
+}
+
+func cloneHeader(h http.Header) http.Header {
+	h2 := make(http.Header, len(h))
+	for k, vv := range h {
+		h2[k] = append([]string(nil), vv...)
+	}
+	return h2
 }
 
 // Flush sets rw.Flushed to true.
@@ -70,3 +86,62 @@ func (rw *ResponseRecorder) Flush() {
 	}
 	rw.Flushed = true
 }
+
+// Result returns the response generated by the handler, as a client
+// would receive it.
+//
+// The returned Response has at least its StatusCode, Header, Body
+// and, if the handler declared any, Trailer fields populated. Its
+// Header is the snapshot taken when the handler first called
+// WriteHeader or Write; changes made to HeaderMap afterwards only
+// show up as trailers, if the handler declared them with the
+// "Trailer" header. The Body reads the bytes written to rw.Body at
+// the time Result was called.
+//
+// Result must only be called after the handler has finished
+// running. It returns the same Response on every call.
+func (rw *ResponseRecorder) Result() *http.Response {
+	if rw.result != nil {
+		return rw.result
+	}
+	if rw.snapHeader == nil {
+		rw.snapHeader = cloneHeader(rw.HeaderMap)
+	}
+	res := &http.Response{
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		StatusCode:    rw.Code,
+		Header:        rw.snapHeader,
+		ContentLength: -1,
+	}
+	if res.StatusCode == 0 {
+		res.StatusCode = 200
+	}
+	res.Status = strconv.Itoa(res.StatusCode) + " " + http.StatusText(res.StatusCode)
+	var body []byte
+	if rw.Body != nil {
+		body = rw.Body.Bytes()
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if cl := res.Header.Get("Content-Length"); cl != "" {
+		if n, err := strconv.ParseInt(cl, 10, 64); err == nil && n >= 0 {
+			res.ContentLength = n
+		}
+	}
+	for _, v := range res.Header["Trailer"] {
+		for _, k := range strings.Split(v, ",") {
+			k = http.CanonicalHeaderKey(strings.TrimSpace(k))
+			vv, ok := rw.HeaderMap[k]
+			if !ok {
+				continue
+			}
+			if res.Trailer == nil {
+				res.Trailer = make(http.Header)
+			}
+			res.Trailer[k] = append([]string(nil), vv...)
+		}
+	}
+	rw.result = res
+	return res
+}
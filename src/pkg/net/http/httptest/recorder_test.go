@@ -6,6 +6,7 @@ package httptest
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 )
@@ -39,6 +40,49 @@ func TestRecorder(t *testing.T) {
 		}
 	}
 
+	hasResultStatus := func(wantCode int, wantStatus string) checkFunc {
+		return func(rec *ResponseRecorder) error {
+			res := rec.Result()
+			if res.StatusCode != wantCode || res.Status != wantStatus {
+				return fmt.Errorf("Result status = %d %q; want %d %q", res.StatusCode, res.Status, wantCode, wantStatus)
+			}
+			return nil
+		}
+	}
+	hasResultContents := func(want string) checkFunc {
+		return func(rec *ResponseRecorder) error {
+			b, err := ioutil.ReadAll(rec.Result().Body)
+			if err != nil || string(b) != want {
+				return fmt.Errorf("Result body = %q, %v; want %q", b, err, want)
+			}
+			return nil
+		}
+	}
+	hasResultHeader := func(key, want string) checkFunc {
+		return func(rec *ResponseRecorder) error {
+			if got := rec.Result().Header.Get(key); got != want {
+				return fmt.Errorf("Result header %s = %q; want %q", key, got, want)
+			}
+			return nil
+		}
+	}
+	hasResultTrailer := func(key, want string) checkFunc {
+		return func(rec *ResponseRecorder) error {
+			if got := rec.Result().Trailer.Get(key); got != want {
+				return fmt.Errorf("Result trailer %s = %q; want %q", key, got, want)
+			}
+			return nil
+		}
+	}
+	hasResultContentLength := func(want int64) checkFunc {
+		return func(rec *ResponseRecorder) error {
+			if got := rec.Result().ContentLength; got != want {
+				return fmt.Errorf("Result ContentLength = %d; want %d", got, want)
+			}
+			return nil
+		}
+	}
+
 	tests := []struct {
 		name   string
 		h      func(w http.ResponseWriter, r *http.Request)
@@ -75,6 +119,42 @@ func TestRecorder(t *testing.T) {
 			},
 			check(hasStatus(200), hasFlush(true)),
 		},
+		{
+			"result",
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Length", "2")
+				w.WriteHeader(404)
+				w.Write([]byte("hi"))
+			},
+			check(hasResultStatus(404, "404 Not Found"), hasResultContents("hi"), hasResultContentLength(2)),
+		},
+		{
+			"result without WriteHeader",
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Foo", "1")
+			},
+			check(hasResultStatus(200, "200 OK"), hasResultHeader("X-Foo", "1"), hasResultContentLength(-1)),
+		},
+		{
+			"header snapshot",
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Foo", "before")
+				w.Write([]byte("hi"))
+				w.Header().Set("X-Foo", "after")
+				w.Header().Set("X-Bar", "after")
+			},
+			check(hasResultHeader("X-Foo", "before"), hasResultHeader("X-Bar", "")),
+		},
+		{
+			"trailers",
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Trailer", "X-Checksum, X-Unset")
+				w.WriteHeader(200)
+				w.Write([]byte("hi"))
+				w.Header().Set("X-Checksum", "1234")
+			},
+			check(hasResultTrailer("X-Checksum", "1234"), hasResultHeader("X-Checksum", ""), hasResultTrailer("X-Unset", "")),
+		},
 	}
 	r, _ := http.NewRequest("GET", "http://foo.com/", nil)
 	for _, tt := range tests {
@@ -0,0 +1,210 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Implementation of ScriptedServer
+
+package httptest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// A Fault is a misbehavior of a ScriptedServer while sending a
+// response.
+type Fault int
+
+const (
+	// NoFault sends the response normally.
+	NoFault Fault = iota
+
+	// SlowBody sends the response header right away, then the
+	// body one byte at a time, waiting the Step's Delay before
+	// each byte.
+	SlowBody
+
+	// ResetBody sends the response header, with a Content-Length
+	// for the whole body, and half of the body, then resets the
+	// connection.
+	ResetBody
+
+	// BadChunking sends the body with chunked encoding, but with
+	// a malformed chunk size line after the first chunk, or in
+	// place of it if the body is shorter than two bytes.
+	BadChunking
+
+	// CloseConn closes the connection without sending a response.
+	CloseConn
+)
+
+// A Step is a response in the script of a ScriptedServer.
+type Step struct {
+	Status int         // HTTP status code; 200 if zero
+	Header http.Header // response headers; may be nil
+	Body   string      // response body
+
+	// Delay is how long to wait before responding, or with a
+	// SlowBody fault, before each byte of the body.
+	Delay time.Duration
+
+	// Fault optionally makes the server misbehave.
+	Fault Fault
+}
+
+// A ScriptedServer is a Server that answers the requests it receives
+// with a fixed sequence of responses, possibly faulty, and records
+// the requests for later inspection. It's meant to stand in for an
+// upstream server in tests of clients and proxies.
+//
+// Requests beyond the end of the script get a 500 Internal Server
+// Error response.
+type ScriptedServer struct {
+	*Server
+
+	mu       sync.Mutex
+	script   []Step
+	next     int // index in script of the next request's step
+	clock    Clock
+	requests []*http.Request
+}
+
+// NewScriptedServer starts and returns a new ScriptedServer that
+// responds with the steps of script in order, one per request.
+// The caller should call Close when finished, to shut it down.
+func NewScriptedServer(script ...Step) *ScriptedServer {
+	s := &ScriptedServer{script: script, clock: realClock{}}
+	s.Server = NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// SetClock sets the clock the server times its delays with, such as
+// a FakeClock. The default is the real time.
+func (s *ScriptedServer) SetClock(c Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clock = c
+}
+
+// Requests returns the requests received so far, in the order they
+// arrived. Their bodies have been read in full and can be read again.
+func (s *ScriptedServer) Requests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests...)
+}
+
+// Remaining returns the number of steps of the script not played
+// yet.
+func (s *ScriptedServer) Remaining() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.script) - s.next
+}
+
+func (s *ScriptedServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	r2 := new(http.Request)
+	*r2 = *r
+	r2.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, r2)
+	var st Step
+	ok := s.next < len(s.script)
+	if ok {
+		st = s.script[s.next]
+		s.next++
+	}
+	clock := s.clock
+	s.mu.Unlock()
+
+	if err != nil {
+		http.Error(w, "httptest: reading request body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		http.Error(w, "httptest: unscripted request", http.StatusInternalServerError)
+		return
+	}
+
+	if st.Fault != SlowBody && st.Delay > 0 {
+		<-clock.After(st.Delay)
+	}
+	code := st.Status
+	if code == 0 {
+		code = http.StatusOK
+	}
+	for k, vv := range st.Header {
+		w.Header()[k] = append([]string(nil), vv...)
+	}
+
+	switch st.Fault {
+	case SlowBody:
+		w.WriteHeader(code)
+		w.(http.Flusher).Flush()
+		for i := 0; i < len(st.Body); i++ {
+			if st.Delay > 0 {
+				<-clock.After(st.Delay)
+			}
+			if _, err := w.Write([]byte{st.Body[i]}); err != nil {
+				return
+			}
+			w.(http.Flusher).Flush()
+		}
+	case ResetBody:
+		w.Header().Set("Content-Length", strconv.Itoa(len(st.Body)))
+		w.WriteHeader(code)
+		w.Write([]byte(st.Body[:len(st.Body)/2]))
+		w.(http.Flusher).Flush()
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		resetConn(conn)
+	case BadChunking:
+		conn, bufrw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		fmt.Fprintf(bufrw, "HTTP/1.1 %d %s\r\n", code, http.StatusText(code))
+		w.Header().Del("Content-Length")
+		w.Header().Set("Transfer-Encoding", "chunked")
+		w.Header().Set("Connection", "close")
+		w.Header().Write(bufrw)
+		bufrw.WriteString("\r\n")
+		half := st.Body[:len(st.Body)/2]
+		if half != "" {
+			// An empty chunk would end the body cleanly.
+			fmt.Fprintf(bufrw, "%x\r\n%s\r\n", len(half), half)
+		}
+		fmt.Fprintf(bufrw, "zz bogus\r\n%s", st.Body[len(half):])
+		bufrw.Flush()
+	case CloseConn:
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		resetConn(conn)
+	default:
+		w.WriteHeader(code)
+		w.Write([]byte(st.Body))
+	}
+}
+
+// resetConn closes conn, making TCP send a reset rather than an
+// orderly shutdown if possible.
+func resetConn(conn net.Conn) {
+	if tc, ok := conn.(*net.TCPConn); ok {
+		tc.SetLinger(0)
+	}
+	conn.Close()
+}
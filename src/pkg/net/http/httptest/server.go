@@ -27,6 +27,11 @@ type Server struct {
 	// is called, existing fields are copied into the new config.
 	TLS *tls.Config
 
+	// EnableHTTP2, if set on an unstarted server before StartTLS
+	// is called, makes the server offer HTTP/2 as well as
+	// HTTP/1.1 to its clients.
+	EnableHTTP2 bool
+
 	// Config may be changed after calling NewUnstartedServer and
 	// before Start or StartTLS.
 	Config *http.Server
@@ -128,8 +133,15 @@ func (s *Server) StartTLS() {
 	if existingConfig != nil {
 		*s.TLS = *existingConfig
 	}
+	if s.EnableHTTP2 {
+		s.Config.EnableHTTP2 = true
+	}
 	if s.TLS.NextProtos == nil {
-		s.TLS.NextProtos = []string{"http/1.1"}
+		if s.EnableHTTP2 {
+			s.TLS.NextProtos = []string{"h2", "http/1.1"}
+		} else {
+			s.TLS.NextProtos = []string{"http/1.1"}
+		}
 	}
 	if len(s.TLS.Certificates) == 0 {
 		s.TLS.Certificates = []tls.Certificate{cert}
@@ -5,9 +5,13 @@
 package httptest
 
 import (
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestServer(t *testing.T) {
@@ -27,3 +31,114 @@ func TestServer(t *testing.T) {
 		t.Errorf("got %q, want hello", string(got))
 	}
 }
+
+func TestScriptedServer(t *testing.T) {
+	ts := NewScriptedServer(
+		Step{Status: 201, Header: http.Header{"X-Step": {"1"}}, Body: "first"},
+		Step{Body: "second"},
+	)
+	defer ts.Close()
+
+	for i, want := range []struct {
+		code int
+		body string
+	}{
+		{201, "first"},
+		{200, "second"},
+		{500, "httptest: unscripted request\n"},
+	} {
+		res, err := http.Post(ts.URL+"/path?n="+strconv.Itoa(i), "text/plain", strings.NewReader("request "+strconv.Itoa(i)))
+		if err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		body, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if res.StatusCode != want.code || string(body) != want.body {
+			t.Errorf("request %d: got %d %q; want %d %q", i, res.StatusCode, body, want.code, want.body)
+		}
+	}
+	if n := ts.Remaining(); n != 0 {
+		t.Errorf("Remaining = %d; want 0", n)
+	}
+
+	reqs := ts.Requests()
+	if len(reqs) != 3 {
+		t.Fatalf("got %d requests; want 3", len(reqs))
+	}
+	for i, r := range reqs {
+		want := NewRequest("POST", "/path?n="+strconv.Itoa(i), strings.NewReader("request "+strconv.Itoa(i)))
+		want.Header.Set("Content-Type", "text/plain")
+		if err := MatchRequest(r, want); err != nil {
+			t.Errorf("request %d: %v", i, err)
+		}
+	}
+}
+
+func TestScriptedServerFaults(t *testing.T) {
+	ts := NewScriptedServer(
+		Step{Body: "0123456789", Fault: ResetBody},
+		Step{Body: "0123456789", Fault: BadChunking},
+		Step{Body: "x", Fault: BadChunking},
+		Step{Fault: CloseConn},
+	)
+	defer ts.Close()
+	tr := &http.Transport{DisableKeepAlives: true}
+	defer tr.CloseIdleConnections()
+	c := &http.Client{Transport: tr}
+
+	res, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("ResetBody: %v", err)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err == nil || len(body) > 5 {
+		t.Errorf("ResetBody: read %q, %v; want at most 5 bytes and an error", body, err)
+	}
+
+	res, err = c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("BadChunking: %v", err)
+	}
+	body, err = ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err == nil || string(body) != "01234" {
+		t.Errorf("BadChunking: read %q, %v; want %q and an error", body, err, "01234")
+	}
+
+	res, err = c.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("BadChunking short body: %v", err)
+	}
+	body, err = ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err == nil || len(body) != 0 {
+		t.Errorf("BadChunking short body: read %q, %v; want nothing and an error", body, err)
+	}
+
+	if res, err := c.Get(ts.URL); err == nil {
+		res.Body.Close()
+		t.Errorf("CloseConn: got a response; want an error")
+	}
+}
+
+func TestScriptedServerSlowBody(t *testing.T) {
+	clock := NewFakeClock(time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC))
+	ts := NewScriptedServer(Step{Body: "abc", Delay: time.Second, Fault: SlowBody})
+	ts.SetClock(clock)
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	for i, want := range "abc" {
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+		b := make([]byte, 1)
+		if _, err := io.ReadFull(res.Body, b); err != nil || rune(b[0]) != want {
+			t.Fatalf("byte %d = %q, %v; want %q", i, b, err, want)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package httptrace provides mechanisms to trace the events within
+// HTTP client requests.
+//
+// A ClientTrace is attached to a request by setting the request's
+// Trace field. The Transport then calls its hooks as the request
+// makes progress.
+package httptrace
+
+import (
+	"crypto/tls"
+	"net"
+	"time"
+)
+
+// ClientTrace is a set of hooks to run at various stages of an outgoing
+// HTTP request. Any particular hook may be nil. Functions may be
+// called concurrently from different goroutines and some may be called
+// after the request has completed or failed.
+//
+// ClientTrace currently traces a single HTTP request and response
+// during a single round trip and has no hooks that span a series
+// of redirected requests.
+type ClientTrace struct {
+	// GetConn is called before a connection is created or
+	// retrieved from an idle pool. The hostPort is the
+	// "host:port" of the target or proxy. GetConn is called even
+	// if there's already an idle cached connection available.
+	GetConn func(hostPort string)
+
+	// GotConn is called after a successful connection is
+	// obtained. There is no hook for failure to obtain a
+	// connection; instead, use the error from
+	// Transport.RoundTrip.
+	GotConn func(GotConnInfo)
+
+	// GotFirstResponseByte is called when the first byte of the
+	// response headers is available.
+	GotFirstResponseByte func()
+
+	// DNSStart is called when a DNS lookup begins. The Transport
+	// only looks up names itself, and so only calls DNSStart and
+	// DNSDone, when its Dial field is nil; a custom Dial function
+	// is given the unresolved address.
+	DNSStart func(DNSStartInfo)
+
+	// DNSDone is called when a DNS lookup ends.
+	DNSDone func(DNSDoneInfo)
+
+	// ConnectStart is called when a new connection's Dial
+	// begins.
+	ConnectStart func(network, addr string)
+
+	// ConnectDone is called when a new connection's Dial
+	// completes. The provided err indicates whether the
+	// connection completed successfully.
+	ConnectDone func(network, addr string, err error)
+
+	// TLSHandshakeStart is called when the TLS handshake is started.
+	// When connecting to an HTTPS site via an HTTP proxy, the
+	// handshake happens after the CONNECT request is processed by
+	// the proxy.
+	TLSHandshakeStart func()
+
+	// TLSHandshakeDone is called after the TLS handshake with
+	// either the successful handshake's connection state, or a
+	// non-nil error on handshake failure.
+	TLSHandshakeDone func(tls.ConnectionState, error)
+
+	// WroteHeaders is called after the Transport has written
+	// the request headers.
+	WroteHeaders func()
+
+	// WroteRequest is called with the result of writing the
+	// request and any body.
+	WroteRequest func(WroteRequestInfo)
+}
+
+// DNSStartInfo is passed to ClientTrace.DNSStart.
+type DNSStartInfo struct {
+	Host string
+}
+
+// DNSDoneInfo is passed to ClientTrace.DNSDone.
+type DNSDoneInfo struct {
+	// Addrs holds the address the DNS lookup resolved to, the
+	// one that is dialed.
+	Addrs []net.IP
+
+	// Err is any error that occurred during the DNS lookup.
+	Err error
+}
+
+// WroteRequestInfo contains information provided to the WroteRequest
+// hook.
+type WroteRequestInfo struct {
+	// Err is any error encountered while writing the Request.
+	Err error
+}
+
+// GotConnInfo is the argument to the ClientTrace.GotConn function and
+// contains information about the obtained connection.
+type GotConnInfo struct {
+	// Conn is the connection that was obtained. It is owned by
+	// the http.Transport and should not be read, written or
+	// closed by users of ClientTrace.
+	Conn net.Conn
+
+	// Reused is whether this connection has been previously
+	// used for another HTTP request.
+	Reused bool
+
+	// WasIdle is whether this connection was obtained from an
+	// idle pool.
+	WasIdle bool
+
+	// IdleTime reports how long the connection was previously
+	// idle, if WasIdle is true.
+	IdleTime time.Duration
+}
@@ -7,6 +7,7 @@
 package httputil
 
 import (
+	"fmt"
 	"io"
 	"log"
 	"net"
@@ -40,6 +41,30 @@ type ReverseProxy struct {
 	// response body.
 	// If zero, no periodic flushing is done.
 	FlushInterval time.Duration
+
+	// BufferPool optionally specifies a buffer pool to
+	// get byte slices for use by io.Copy when
+	// copying HTTP response bodies.
+	BufferPool BufferPool
+
+	// ModifyResponse is an optional function that
+	// modifies the Response from the backend.
+	// If it returns an error, ErrorHandler is called
+	// with the error and the response is discarded.
+	ModifyResponse func(*http.Response) error
+
+	// ErrorHandler is an optional function that handles
+	// errors reaching the backend or errors from
+	// ModifyResponse. If nil, the error is logged and
+	// the client gets a 502 Bad Gateway response.
+	ErrorHandler func(http.ResponseWriter, *http.Request, error)
+}
+
+// A BufferPool is an interface for getting and returning temporary
+// byte slices for use by io.Copy.
+type BufferPool interface {
+	Get() []byte
+	Put([]byte)
 }
 
 func singleJoiningSlash(a, b string) string {
@@ -81,19 +106,73 @@ func copyHeader(dst, src http.Header) {
 	}
 }
 
-// Hop-by-hop headers. These are removed when sent to the backend.
+// Hop-by-hop headers. These are removed when sent to the backend
+// and when copying the backend's response to the client.
 // http://www.w3.org/Protocols/rfc2616/rfc2616-sec13.html
 var hopHeaders = []string{
 	"Connection",
 	"Keep-Alive",
 	"Proxy-Authenticate",
 	"Proxy-Authorization",
-	"Te", // canonicalized version of "TE"
+	"Proxy-Connection", // non-standard but still sent by some clients
+	"Te",               // canonicalized version of "TE"
 	"Trailers",
 	"Transfer-Encoding",
 	"Upgrade",
 }
 
+// removeConnectionHeaders removes the headers listed in the
+// "Connection" header of h, which are hop-by-hop too.
+// See RFC 7230, section 6.1.
+func removeConnectionHeaders(h http.Header) {
+	for _, f := range h["Connection"] {
+		for _, sf := range strings.Split(f, ",") {
+			if sf = strings.TrimSpace(sf); sf != "" {
+				h.Del(sf)
+			}
+		}
+	}
+}
+
+// upgradeType returns the protocol named by the "Upgrade" header of
+// h if its "Connection" header asks for an upgrade, or "".
+func upgradeType(h http.Header) string {
+	for _, f := range h["Connection"] {
+		for _, sf := range strings.Split(f, ",") {
+			if strings.EqualFold(strings.TrimSpace(sf), "Upgrade") {
+				return h.Get("Upgrade")
+			}
+		}
+	}
+	return ""
+}
+
+func defaultErrorHandler(rw http.ResponseWriter, req *http.Request, err error) {
+	log.Printf("http: proxy error: %v", err)
+	rw.WriteHeader(http.StatusBadGateway)
+}
+
+func (p *ReverseProxy) getErrorHandler() func(http.ResponseWriter, *http.Request, error) {
+	if p.ErrorHandler != nil {
+		return p.ErrorHandler
+	}
+	return defaultErrorHandler
+}
+
+// modifyResponse calls p.ModifyResponse, if any, and reports whether
+// the response should still be sent to the client.
+func (p *ReverseProxy) modifyResponse(rw http.ResponseWriter, res *http.Response, req *http.Request) bool {
+	if p.ModifyResponse == nil {
+		return true
+	}
+	if err := p.ModifyResponse(res); err != nil {
+		res.Body.Close()
+		p.getErrorHandler()(rw, req, err)
+		return false
+	}
+	return true
+}
+
 func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	transport := p.Transport
 	if transport == nil {
@@ -101,7 +180,7 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	}
 
 	outreq := new(http.Request)
-	*outreq = *req // includes shallow copies of maps, but okay
+	*outreq = *req // includes shallow copies of maps, but we copy Header below
 
 	p.Director(outreq)
 	outreq.Proto = "HTTP/1.1"
@@ -111,19 +190,20 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 	// Remove hop-by-hop headers to the backend.  Especially
 	// important is "Connection" because we want a persistent
-	// connection, regardless of what the client sent to us.  This
-	// is modifying the same underlying map from req (shallow
-	// copied above) so we only copy it if necessary.
-	copiedHeaders := false
+	// connection, regardless of what the client sent to us.
+	// The header map is shared with req, so work on a copy.
+	reqUpType := upgradeType(outreq.Header)
+	hdr := make(http.Header)
+	copyHeader(hdr, outreq.Header)
+	outreq.Header = hdr
+	removeConnectionHeaders(outreq.Header)
 	for _, h := range hopHeaders {
-		if outreq.Header.Get(h) != "" {
-			if !copiedHeaders {
-				outreq.Header = make(http.Header)
-				copyHeader(outreq.Header, req.Header)
-				copiedHeaders = true
-			}
-			outreq.Header.Del(h)
-		}
+		outreq.Header.Del(h)
+	}
+	// An upgrade is the one hop-by-hop request we pass along.
+	if reqUpType != "" {
+		outreq.Header.Set("Connection", "Upgrade")
+		outreq.Header.Set("Upgrade", reqUpType)
 	}
 
 	if clientIP, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
@@ -138,19 +218,49 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 	res, err := transport.RoundTrip(outreq)
 	if err != nil {
-		log.Printf("http: proxy error: %v", err)
-		rw.WriteHeader(http.StatusInternalServerError)
+		p.getErrorHandler()(rw, outreq, err)
+		return
+	}
+
+	if res.StatusCode == http.StatusSwitchingProtocols {
+		if p.modifyResponse(rw, res, outreq) {
+			p.handleUpgradeResponse(rw, outreq, res)
+		}
+		return
+	}
+
+	removeConnectionHeaders(res.Header)
+	for _, h := range hopHeaders {
+		res.Header.Del(h)
+	}
+	if !p.modifyResponse(rw, res, outreq) {
 		return
 	}
-	defer res.Body.Close()
 
 	copyHeader(rw.Header(), res.Header)
 
+	// Announce the backend's trailers, which are sent once the
+	// body has been copied.
+	if len(res.Trailer) > 0 {
+		keys := make([]string, 0, len(res.Trailer))
+		for k := range res.Trailer {
+			keys = append(keys, k)
+		}
+		rw.Header().Add("Trailer", strings.Join(keys, ", "))
+	}
+
 	rw.WriteHeader(res.StatusCode)
-	p.copyResponse(rw, res.Body)
+	err = p.copyResponse(rw, res.Body)
+	res.Body.Close() // before reading res.Trailer
+	if err != nil {
+		return
+	}
+	for k, vv := range res.Trailer {
+		rw.Header()[k] = vv
+	}
 }
 
-func (p *ReverseProxy) copyResponse(dst io.Writer, src io.Reader) {
+func (p *ReverseProxy) copyResponse(dst io.Writer, src io.Reader) error {
 	if p.FlushInterval != 0 {
 		if wf, ok := dst.(writeFlusher); ok {
 			mlw := &maxLatencyWriter{
@@ -164,7 +274,86 @@ func (p *ReverseProxy) copyResponse(dst io.Writer, src io.Reader) {
 		}
 	}
 
-	io.Copy(dst, src)
+	var buf []byte
+	if p.BufferPool != nil {
+		buf = p.BufferPool.Get()
+		defer p.BufferPool.Put(buf)
+	}
+	return copyBuffer(dst, src, buf)
+}
+
+// copyBuffer is like io.Copy but uses buf, if non-empty, as the
+// buffer. Read errors other than io.EOF are logged.
+func copyBuffer(dst io.Writer, src io.Reader, buf []byte) error {
+	if len(buf) == 0 {
+		buf = make([]byte, 32*1024)
+	}
+	for {
+		nr, rerr := src.Read(buf)
+		if nr > 0 {
+			if _, werr := dst.Write(buf[:nr]); werr != nil {
+				return werr
+			}
+		}
+		if rerr == io.EOF {
+			return nil
+		}
+		if rerr != nil {
+			log.Printf("httputil: ReverseProxy read error during body copy: %v", rerr)
+			return rerr
+		}
+	}
+}
+
+// handleUpgradeResponse tunnels between the client and the backend
+// after the backend agreed to switch protocols.
+func (p *ReverseProxy) handleUpgradeResponse(rw http.ResponseWriter, req *http.Request, res *http.Response) {
+	reqUpType, resUpType := upgradeType(req.Header), upgradeType(res.Header)
+	if !strings.EqualFold(reqUpType, resUpType) {
+		res.Body.Close()
+		p.getErrorHandler()(rw, req, fmt.Errorf("backend tried to switch protocol %q when %q was requested", resUpType, reqUpType))
+		return
+	}
+	backConn, ok := res.Body.(io.ReadWriteCloser)
+	if !ok {
+		res.Body.Close()
+		p.getErrorHandler()(rw, req, fmt.Errorf("internal error: 101 switching protocols response with non-writable body"))
+		return
+	}
+	defer backConn.Close()
+	hj, ok := rw.(http.Hijacker)
+	if !ok {
+		p.getErrorHandler()(rw, req, fmt.Errorf("can't switch protocols using non-Hijacker ResponseWriter type %T", rw))
+		return
+	}
+	conn, brw, err := hj.Hijack()
+	if err != nil {
+		p.getErrorHandler()(rw, req, fmt.Errorf("hijack failed on protocol switch: %v", err))
+		return
+	}
+	defer conn.Close()
+
+	copyHeader(rw.Header(), res.Header)
+	fmt.Fprintf(brw, "HTTP/1.1 %d %s\r\n", res.StatusCode, http.StatusText(res.StatusCode))
+	rw.Header().Write(brw)
+	brw.WriteString("\r\n")
+	if err := brw.Flush(); err != nil {
+		log.Printf("httputil: ReverseProxy response write error during protocol switch: %v", err)
+		return
+	}
+
+	// Copy in both directions until either side is done. The
+	// client may have sent data that the server already buffered.
+	errc := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(backConn, brw.Reader)
+		errc <- err
+	}()
+	go func() {
+		_, err := io.Copy(conn, backConn)
+		errc <- err
+	}()
+	<-errc
 }
 
 type writeFlusher interface {
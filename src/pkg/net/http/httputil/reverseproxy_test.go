@@ -7,11 +7,16 @@
 package httputil
 
 import (
+	"bufio"
+	"errors"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -195,3 +200,247 @@ func TestReverseProxyFlushInterval(t *testing.T) {
 		t.Error("maxLatencyWriter flushLoop() never exited")
 	}
 }
+
+// Tests that headers named in Connection are removed in both
+// directions.
+func TestReverseProxyConnectionHeaders(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c := r.Header.Get("X-Hop"); c != "" {
+			t.Errorf("backend got X-Hop header %q", c)
+		}
+		if c := r.Header.Get("X-End"); c != "end" {
+			t.Errorf("backend got X-End header %q; want end", c)
+		}
+		w.Header().Set("Connection", "X-Back-Hop")
+		w.Header().Set("X-Back-Hop", "hop")
+		w.Header().Set("X-Back-End", "end")
+	}))
+	defer backend.Close()
+	backendURL, err := url.Parse(backend.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frontend := httptest.NewServer(NewSingleHostReverseProxy(backendURL))
+	defer frontend.Close()
+
+	req, _ := http.NewRequest("GET", frontend.URL, nil)
+	req.Header.Set("Connection", "X-Hop")
+	req.Header.Set("X-Hop", "hop")
+	req.Header.Set("X-End", "end")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	res.Body.Close()
+	if c := res.Header.Get("X-Back-Hop"); c != "" {
+		t.Errorf("client got X-Back-Hop header %q", c)
+	}
+	if c := res.Header.Get("X-Back-End"); c != "end" {
+		t.Errorf("client got X-Back-End header %q; want end", c)
+	}
+}
+
+func TestReverseProxyModifyResponse(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Path", r.URL.Path)
+		w.Write([]byte("backend body"))
+	}))
+	defer backend.Close()
+	backendURL, err := url.Parse(backend.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyHandler := NewSingleHostReverseProxy(backendURL)
+	proxyHandler.ModifyResponse = func(res *http.Response) error {
+		if res.Header.Get("X-Path") == "/fail" {
+			return errors.New("refused")
+		}
+		res.Header.Set("X-Modified", "true")
+		return nil
+	}
+	frontend := httptest.NewServer(proxyHandler)
+	defer frontend.Close()
+
+	res, err := http.Get(frontend.URL + "/ok")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if res.StatusCode != 200 || res.Header.Get("X-Modified") != "true" || string(body) != "backend body" {
+		t.Errorf("got status %d, X-Modified %q, body %q", res.StatusCode, res.Header.Get("X-Modified"), body)
+	}
+
+	res, err = http.Get(frontend.URL + "/fail")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	body, _ = ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadGateway || len(body) != 0 {
+		t.Errorf("got status %d, body %q; want 502 and no body", res.StatusCode, body)
+	}
+}
+
+func TestReverseProxyErrorHandler(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	backendURL, err := url.Parse(backend.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Close() // so that every proxied request fails
+
+	proxyHandler := NewSingleHostReverseProxy(backendURL)
+	var handlerErr error
+	proxyHandler.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		handlerErr = err
+		w.WriteHeader(http.StatusTeapot)
+	}
+	frontend := httptest.NewServer(proxyHandler)
+	defer frontend.Close()
+
+	res, err := http.Get(frontend.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusTeapot {
+		t.Errorf("got status %d; want %d", res.StatusCode, http.StatusTeapot)
+	}
+	if handlerErr == nil {
+		t.Error("ErrorHandler got nil error")
+	}
+}
+
+type countingPool struct {
+	mu       sync.Mutex
+	get, put int
+}
+
+func (p *countingPool) Get() []byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.get++
+	return make([]byte, 3)
+}
+
+func (p *countingPool) Put([]byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.put++
+}
+
+func TestReverseProxyBufferPool(t *testing.T) {
+	const msg = "a body longer than the pool's buffers"
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(msg))
+	}))
+	defer backend.Close()
+	backendURL, err := url.Parse(backend.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyHandler := NewSingleHostReverseProxy(backendURL)
+	pool := new(countingPool)
+	proxyHandler.BufferPool = pool
+	frontend := httptest.NewServer(proxyHandler)
+	defer frontend.Close()
+
+	res, err := http.Get(frontend.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if string(body) != msg {
+		t.Errorf("got body %q; want %q", body, msg)
+	}
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.get != 1 || pool.put != 1 {
+		t.Errorf("pool had %d Gets and %d Puts; want 1 each", pool.get, pool.put)
+	}
+}
+
+func TestReverseProxyTrailers(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Trailer", "X-Sum")
+		w.Write([]byte("body"))
+		w.Header().Set("X-Sum", "42")
+	}))
+	defer backend.Close()
+	backendURL, err := url.Parse(backend.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frontend := httptest.NewServer(NewSingleHostReverseProxy(backendURL))
+	defer frontend.Close()
+
+	res, err := http.Get(frontend.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if string(body) != "body" {
+		t.Errorf("got body %q; want %q", body, "body")
+	}
+	if got := res.Trailer.Get("X-Sum"); got != "42" {
+		t.Errorf("got trailer X-Sum %q; want 42", got)
+	}
+}
+
+func TestReverseProxyWebSocket(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if upgradeType(r.Header) != "websocket" {
+			t.Errorf("backend got Connection %q, Upgrade %q", r.Header.Get("Connection"), r.Header.Get("Upgrade"))
+			http.Error(w, "unexpected request", 400)
+			return
+		}
+		conn, brw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		io.WriteString(conn, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: WebSocket\r\n\r\n")
+		line, err := brw.ReadString('\n')
+		if err != nil {
+			t.Errorf("backend read: %v", err)
+			return
+		}
+		io.WriteString(conn, "backend got "+line)
+	}))
+	defer backend.Close()
+	backendURL, err := url.Parse(backend.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frontend := httptest.NewServer(NewSingleHostReverseProxy(backendURL))
+	defer frontend.Close()
+
+	conn, err := net.Dial("tcp", frontend.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	io.WriteString(conn, "GET / HTTP/1.1\r\nHost: example.com\r\nConnection: keep-alive, Upgrade\r\nUpgrade: websocket\r\n\r\n")
+	br := bufio.NewReader(conn)
+	req, _ := http.NewRequest("GET", "/", nil)
+	res, err := http.ReadResponse(br, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusSwitchingProtocols || upgradeType(res.Header) != "WebSocket" {
+		t.Fatalf("got status %d, header %v; want a switch to WebSocket", res.StatusCode, res.Header)
+	}
+	io.WriteString(conn, "hello\n")
+	line, err := br.ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if line != "backend got hello\n" {
+		t.Errorf("got %q; want %q", line, "backend got hello\n")
+	}
+}
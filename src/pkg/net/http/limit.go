@@ -0,0 +1,175 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Request limiting handlers.
+
+package http
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MaxBytesHandler returns a Handler that runs h with its request body
+// limited by MaxBytesReader to n bytes.
+func MaxBytesHandler(h Handler, n int64) Handler {
+	return HandlerFunc(func(w ResponseWriter, r *Request) {
+		r2 := *r
+		r2.Body = MaxBytesReader(w, r.Body, n)
+		h.ServeHTTP(w, &r2)
+	})
+}
+
+// MaxInFlightHandler returns a Handler that runs h for at most n
+// requests at a time. Requests arriving while n are in progress get
+// a 503 Service Unavailable response.
+func MaxInFlightHandler(h Handler, n int) Handler {
+	sem := make(chan bool, n)
+	return HandlerFunc(func(w ResponseWriter, r *Request) {
+		select {
+		case sem <- true:
+		default:
+			Error(w, "503 too many requests in progress", StatusServiceUnavailable)
+			return
+		}
+		defer func() { <-sem }()
+		h.ServeHTTP(w, r)
+	})
+}
+
+// A RateLimiter is a Handler that limits the rate of the requests
+// each client makes to another Handler, using a token bucket per
+// client. Each request takes a token from its client's bucket; the
+// buckets hold up to Burst tokens and refill at Rate tokens per
+// second. Requests finding their bucket empty get a 429 Too Many
+// Requests response, with a Retry-After header telling the client
+// when a token will be available.
+type RateLimiter struct {
+	// Handler is the handler serving the requests within the
+	// limit.
+	Handler Handler
+
+	// Rate is the number of requests per second allowed for
+	// each client over time. If Rate is zero or negative, the
+	// buckets never refill: each client may make Burst requests
+	// in all, and later ones get a 429 response without a
+	// Retry-After header.
+	Rate float64
+
+	// Burst is the number of requests a client may make at once.
+	// If less than 1, 1 is used.
+	Burst int
+
+	// Key optionally returns the client of a request. If nil,
+	// clients are identified by the IP address of the request's
+	// RemoteAddr.
+	Key func(*Request) string
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	sweepAt int              // bucket count at which to forget full buckets
+	now     func() time.Time // for tests; time.Now if nil
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time // when tokens was last updated
+}
+
+// RateLimitHandler returns a RateLimiter that lets each client IP
+// make rate requests per second to h, with bursts of up to burst
+// requests.
+func RateLimitHandler(h Handler, rate float64, burst int) Handler {
+	return &RateLimiter{Handler: h, Rate: rate, Burst: burst}
+}
+
+func (l *RateLimiter) ServeHTTP(w ResponseWriter, r *Request) {
+	var key string
+	if l.Key != nil {
+		key = l.Key(r)
+	} else {
+		key = remoteIP(r.RemoteAddr)
+	}
+	if wait, ok := l.take(key); !ok {
+		if wait >= 0 {
+			secs := int64(math.Ceil(wait.Seconds()))
+			if secs < 1 {
+				secs = 1
+			}
+			w.Header().Set("Retry-After", strconv.FormatInt(secs, 10))
+		}
+		Error(w, "429 too many requests", statusTooManyRequests)
+		return
+	}
+	l.Handler.ServeHTTP(w, r)
+}
+
+func (l *RateLimiter) burst() float64 {
+	if l.Burst < 1 {
+		return 1
+	}
+	return float64(l.Burst)
+}
+
+// take takes a token from the bucket of client key. If the bucket is
+// empty, it returns how long until it has a token again, or a
+// negative duration if it never will.
+func (l *RateLimiter) take(key string) (wait time.Duration, ok bool) {
+	now := time.Now
+	if l.now != nil {
+		now = l.now
+	}
+	t := now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.buckets == nil {
+		l.buckets = make(map[string]*tokenBucket)
+	}
+	b := l.buckets[key]
+	if b == nil {
+		if len(l.buckets) >= l.sweepAt {
+			l.sweepLocked(t)
+		}
+		b = &tokenBucket{tokens: l.burst(), last: t}
+		l.buckets[key] = b
+	}
+	l.refill(b, t)
+	if b.tokens < 1 {
+		if l.Rate <= 0 {
+			return -1, false
+		}
+		wait := (1 - b.tokens) / l.Rate * float64(time.Second)
+		if wait >= math.MaxInt64 {
+			return -1, false
+		}
+		return time.Duration(wait), false
+	}
+	b.tokens--
+	return 0, true
+}
+
+func (l *RateLimiter) refill(b *tokenBucket, t time.Time) {
+	if t.After(b.last) && l.Rate > 0 {
+		b.tokens += t.Sub(b.last).Seconds() * l.Rate
+		if max := l.burst(); b.tokens > max {
+			b.tokens = max
+		}
+	}
+	b.last = t
+}
+
+// sweepLocked forgets the buckets that have refilled, as they're no
+// different from new ones. l.mu must be held.
+func (l *RateLimiter) sweepLocked(t time.Time) {
+	for key, b := range l.buckets {
+		l.refill(b, t)
+		if b.tokens >= l.burst() {
+			delete(l.buckets, key)
+		}
+	}
+	l.sweepAt = 2*len(l.buckets) + 1024
+}
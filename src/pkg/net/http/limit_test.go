@@ -0,0 +1,177 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http_test
+
+import (
+	"io"
+	"io/ioutil"
+	. "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMaxBytesHandler(t *testing.T) {
+	h := MaxBytesHandler(HandlerFunc(func(w ResponseWriter, r *Request) {
+		if _, err := ioutil.ReadAll(r.Body); err != nil {
+			Error(w, err.Error(), StatusRequestEntityTooLarge)
+			return
+		}
+		io.WriteString(w, "ok")
+	}), 10)
+	for _, tt := range []struct {
+		body string
+		code int
+	}{
+		{"short", StatusOK},
+		{"just nine", StatusOK},
+		{"longer than ten", StatusRequestEntityTooLarge},
+	} {
+		req, _ := NewRequest("POST", "http://foo/", strings.NewReader(tt.body))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != tt.code {
+			t.Errorf("body %q: code = %d; want %d", tt.body, rec.Code, tt.code)
+		}
+	}
+}
+
+func TestMaxInFlightHandler(t *testing.T) {
+	started := make(chan bool)
+	release := make(chan bool)
+	h := MaxInFlightHandler(HandlerFunc(func(w ResponseWriter, r *Request) {
+		started <- true
+		<-release
+	}), 2)
+	req, _ := NewRequest("GET", "http://foo/", nil)
+
+	done := make(chan int)
+	for i := 0; i < 2; i++ {
+		go func() {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			done <- rec.Code
+		}()
+		<-started
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != StatusServiceUnavailable {
+		t.Errorf("third request code = %d; want %d", rec.Code, StatusServiceUnavailable)
+	}
+	close(release)
+	for i := 0; i < 2; i++ {
+		if code := <-done; code != StatusOK {
+			t.Errorf("in flight request code = %d; want %d", code, StatusOK)
+		}
+	}
+
+	// The slots are free again.
+	go func() { <-started }()
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != StatusOK {
+		t.Errorf("later request code = %d; want %d", rec.Code, StatusOK)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := &RateLimiter{
+		Handler: HandlerFunc(func(w ResponseWriter, r *Request) {}),
+		Rate:    0.5,
+		Burst:   2,
+	}
+	SetRateLimiterClock(l, func() time.Time { return now })
+
+	get := func(remoteAddr string) *httptest.ResponseRecorder {
+		req, _ := NewRequest("GET", "http://foo/", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		l.ServeHTTP(rec, req)
+		return rec
+	}
+	check := func(step, remoteAddr string, wantCode int, wantRetry string) {
+		rec := get(remoteAddr)
+		if rec.Code != wantCode {
+			t.Errorf("%s: code = %d; want %d", step, rec.Code, wantCode)
+		}
+		if got := rec.HeaderMap.Get("Retry-After"); got != wantRetry {
+			t.Errorf("%s: Retry-After = %q; want %q", step, got, wantRetry)
+		}
+	}
+
+	check("burst 1", "1.2.3.4:1000", StatusOK, "")
+	check("burst 2", "1.2.3.4:1001", StatusOK, "")
+	check("over burst", "1.2.3.4:1002", 429, "2")
+	check("other client", "5.6.7.8:1000", StatusOK, "")
+
+	now = now.Add(time.Second)
+	check("half refilled", "1.2.3.4:1000", 429, "1")
+	now = now.Add(time.Second)
+	check("refilled", "1.2.3.4:1000", StatusOK, "")
+	check("empty again", "1.2.3.4:1000", 429, "2")
+
+	now = now.Add(time.Minute)
+	check("full after a while 1", "1.2.3.4:1000", StatusOK, "")
+	check("full after a while 2", "1.2.3.4:1000", StatusOK, "")
+	check("full after a while 3", "1.2.3.4:1000", 429, "2")
+}
+
+// Tests that a RateLimiter with no Rate doesn't send a Retry-After
+// header, since no token will ever arrive.
+func TestRateLimiterZeroRate(t *testing.T) {
+	now := time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, rate := range []float64{0, -1} {
+		l := &RateLimiter{
+			Handler: HandlerFunc(func(w ResponseWriter, r *Request) {}),
+			Rate:    rate,
+			Burst:   2,
+		}
+		SetRateLimiterClock(l, func() time.Time { return now })
+		for i, want := range []int{StatusOK, StatusOK, 429, 429} {
+			if i == 3 {
+				now = now.Add(time.Hour)
+			}
+			req, _ := NewRequest("GET", "http://foo/", nil)
+			req.RemoteAddr = "1.2.3.4:1000"
+			rec := httptest.NewRecorder()
+			l.ServeHTTP(rec, req)
+			if rec.Code != want {
+				t.Errorf("Rate %v, request %d: code = %d; want %d", rate, i, rec.Code, want)
+			}
+			if v, ok := rec.HeaderMap["Retry-After"]; ok {
+				t.Errorf("Rate %v, request %d: Retry-After = %q; want none", rate, i, v)
+			}
+		}
+	}
+}
+
+func TestRateLimiterKey(t *testing.T) {
+	h := &RateLimiter{
+		Handler: HandlerFunc(func(w ResponseWriter, r *Request) {}),
+		Rate:    1e-6,
+		Key:     func(r *Request) string { return r.Header.Get("X-Api-Key") },
+	}
+	for _, tt := range []struct {
+		key  string
+		code int
+	}{
+		{"a", StatusOK},
+		{"b", StatusOK},
+		{"a", 429},
+		{"b", 429},
+		{"c", StatusOK},
+	} {
+		req, _ := NewRequest("GET", "http://foo/", nil)
+		req.Header.Set("X-Api-Key", tt.key)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != tt.code {
+			t.Errorf("key %q: code = %d; want %d", tt.key, rec.Code, tt.code)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Patterns for ServeMux routing.
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// A pattern is something that can be matched against an HTTP request.
+// It has an optional method, an optional host, and a path.
+type pattern struct {
+	str    string // the original string
+	method string // "" matches any method
+	host   string // "" matches any host
+
+	// The path is stored as a list of segments. A pattern that ends
+	// in a slash, like "/a/", ends in an anonymous multi wildcard
+	// segment, like "/a/{...}" would. A pattern ending in "{$}"
+	// ends in a dollar segment, which matches only a trailing slash.
+	segments []segment
+}
+
+type segment struct {
+	s      string // literal, or wildcard name; "" for an anonymous multi
+	wild   bool
+	multi  bool // wildcard that matches the rest of the path
+	dollar bool // "{$}"
+}
+
+func (p *pattern) String() string { return p.str }
+
+func (p *pattern) lastSegment() segment {
+	return p.segments[len(p.segments)-1]
+}
+
+// parsePattern parses a string into a pattern. The string's syntax is
+//
+//	[METHOD] [HOST]/[PATH]
+//
+// where METHOD is an HTTP method, HOST is a host name and PATH is a
+// sequence of slash-separated segments. A segment is either a
+// literal or a wildcard: "{NAME}" matches a single path segment and
+// "{NAME...}" matches the rest of the path; it must come last. The
+// special wildcard "{$}" matches only the end of the path after a
+// trailing slash. A segment that isn't a wildcard of one of these
+// forms is a literal, even if it contains braces.
+func parsePattern(s string) (*pattern, error) {
+	if s == "" {
+		return nil, errors.New("empty pattern")
+	}
+	p := &pattern{str: s}
+	rest := s
+	if i := strings.IndexAny(rest, " \t"); i >= 0 {
+		p.method = rest[:i]
+		rest = strings.TrimLeft(rest[i:], " \t")
+		for _, c := range p.method {
+			if !isToken(c) {
+				return nil, fmt.Errorf("invalid method %q", p.method)
+			}
+		}
+	}
+
+	i := strings.IndexByte(rest, '/')
+	if i < 0 {
+		return nil, errors.New("host/path missing /")
+	}
+	p.host = rest[:i]
+	rest = rest[i:]
+	if strings.IndexByte(p.host, '{') >= 0 {
+		return nil, errors.New("host contains '{' (missing initial '/'?)")
+	}
+	// A non-CONNECT request's path is always cleaned before
+	// matching, so an unclean pattern never matches. Such patterns
+	// were accepted before wildcards existed and still are.
+
+	seenNames := make(map[string]bool)
+	for len(rest) > 0 {
+		rest = rest[1:] // drop the slash
+		if rest == "" {
+			// Trailing slash.
+			p.segments = append(p.segments, segment{wild: true, multi: true})
+			break
+		}
+		var seg string
+		if i := strings.IndexByte(rest, '/'); i >= 0 {
+			seg, rest = rest[:i], rest[i:]
+		} else {
+			seg, rest = rest, ""
+		}
+		if !isWildcardSegment(seg) {
+			p.segments = append(p.segments, segment{s: seg})
+			continue
+		}
+		name := seg[1 : len(seg)-1]
+		if name == "$" {
+			if rest != "" {
+				return nil, errors.New("{$} not at end")
+			}
+			p.segments = append(p.segments, segment{s: "/", dollar: true})
+			break
+		}
+		name, multi := strings.TrimSuffix(name, "..."), strings.HasSuffix(name, "...")
+		if multi && rest != "" {
+			return nil, fmt.Errorf("{...} wildcard %q not at end", seg)
+		}
+		if seenNames[name] {
+			return nil, fmt.Errorf("duplicate wildcard name %q", name)
+		}
+		seenNames[name] = true
+		p.segments = append(p.segments, segment{s: name, wild: true, multi: multi})
+	}
+	return p, nil
+}
+
+// isWildcardSegment reports whether the path segment seg is a
+// wildcard: "{$}", or a Go identifier in braces, optionally followed
+// by "...". Other segments, such as "{x" or "a{b}", are literals, as
+// they were before patterns had wildcards.
+func isWildcardSegment(seg string) bool {
+	if len(seg) < 2 || seg[0] != '{' || seg[len(seg)-1] != '}' {
+		return false
+	}
+	name := seg[1 : len(seg)-1]
+	return name == "$" || isValidWildcardName(strings.TrimSuffix(name, "..."))
+}
+
+// isValidWildcardName reports whether s is a Go identifier.
+func isValidWildcardName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		if !unicode.IsLetter(c) && c != '_' && (i == 0 || !unicode.IsDigit(c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchMethod reports whether p matches a request with the given
+// method. A GET pattern also matches HEAD requests.
+func (p *pattern) matchMethod(method string) bool {
+	return p.method == "" || p.method == method || p.method == "GET" && method == "HEAD"
+}
+
+// matchPath reports whether p matches path, returning the values of
+// its named wildcards in order. The match is exact unless a multi
+// wildcard matched a non-empty remainder of the path.
+func (p *pattern) matchPath(path string) (matches []string, exact, ok bool) {
+	rest := path
+	for _, seg := range p.segments {
+		if rest == "" || rest[0] != '/' {
+			return nil, false, false
+		}
+		switch {
+		case seg.multi:
+			if seg.s != "" {
+				matches = append(matches, rest[1:])
+			}
+			return matches, rest == "/", true
+		case seg.dollar:
+			return matches, true, rest == "/"
+		}
+		i := strings.IndexByte(rest[1:], '/') + 1
+		if i == 0 {
+			i = len(rest)
+		}
+		s := rest[1:i]
+		rest = rest[i:]
+		if seg.wild {
+			if s == "" {
+				return nil, false, false
+			}
+			matches = append(matches, s)
+		} else if s != seg.s {
+			return nil, false, false
+		}
+	}
+	return matches, true, rest == ""
+}
+
+// wildcardIndex returns the position in a match of the wildcard
+// with the given name, or -1.
+func (p *pattern) wildcardIndex(name string) int {
+	i := 0
+	for _, seg := range p.segments {
+		if seg.wild && seg.s != "" {
+			if seg.s == name {
+				return i
+			}
+			i++
+		}
+	}
+	return -1
+}
+
+// A relationship describes how the sets of requests matched by two
+// patterns compare.
+type relationship int
+
+const (
+	equivalent   relationship = iota // both match the same requests
+	moreGeneral                      // p1 matches everything p2 does and more
+	moreSpecific                     // p2 matches everything p1 does and more
+	disjoint                         // no request matches both
+	overlaps                         // some requests match both, but neither is more specific
+)
+
+// conflictsWith reports whether p1 and p2 match some request in
+// common without one being more specific than the other, so that
+// neither could take precedence.
+func (p1 *pattern) conflictsWith(p2 *pattern) bool {
+	if p1.host != p2.host {
+		// Host-specific patterns take precedence over ones
+		// without a host, and different hosts don't overlap.
+		return false
+	}
+	rel := p1.comparePathsAndMethods(p2)
+	return rel == equivalent || rel == overlaps
+}
+
+func (p1 *pattern) comparePathsAndMethods(p2 *pattern) relationship {
+	mrel := p1.compareMethods(p2)
+	if mrel == disjoint {
+		return disjoint
+	}
+	return combineRelationships(mrel, p1.comparePaths(p2))
+}
+
+func (p1 *pattern) compareMethods(p2 *pattern) relationship {
+	switch {
+	case p1.method == p2.method:
+		return equivalent
+	case p1.method == "":
+		return moreGeneral
+	case p2.method == "":
+		return moreSpecific
+	case p1.method == "GET" && p2.method == "HEAD":
+		return moreGeneral
+	case p2.method == "GET" && p1.method == "HEAD":
+		return moreSpecific
+	}
+	return disjoint
+}
+
+func (p1 *pattern) comparePaths(p2 *pattern) relationship {
+	if len(p1.segments) != len(p2.segments) && !p1.lastSegment().multi && !p2.lastSegment().multi {
+		return disjoint
+	}
+	segs1, segs2 := p1.segments, p2.segments
+	rel := equivalent
+	for ; len(segs1) > 0 && len(segs2) > 0; segs1, segs2 = segs1[1:], segs2[1:] {
+		rel = combineRelationships(rel, compareSegments(segs1[0], segs2[0]))
+		if rel == disjoint {
+			return rel
+		}
+	}
+	switch {
+	case len(segs1) == 0 && len(segs2) == 0:
+		return rel
+	case len(segs1) == 0 && p1.lastSegment().multi:
+		return combineRelationships(rel, moreGeneral)
+	case len(segs2) == 0 && p2.lastSegment().multi:
+		return combineRelationships(rel, moreSpecific)
+	}
+	return disjoint
+}
+
+func compareSegments(s1, s2 segment) relationship {
+	switch {
+	case s1.multi && s2.multi:
+		return equivalent
+	case s1.multi:
+		return moreGeneral
+	case s2.multi:
+		return moreSpecific
+	case s1.dollar || s2.dollar:
+		// {$} only matches the empty segment after a trailing
+		// slash, which no literal or single wildcard matches.
+		if s1.dollar && s2.dollar {
+			return equivalent
+		}
+		return disjoint
+	case s1.wild && s2.wild:
+		return equivalent
+	case s1.wild:
+		return moreGeneral
+	case s2.wild:
+		return moreSpecific
+	case s1.s == s2.s:
+		return equivalent
+	}
+	return disjoint
+}
+
+// combineRelationships returns the relationship of two patterns
+// given the relationships of two of their parts, such as their
+// methods and paths.
+func combineRelationships(r1, r2 relationship) relationship {
+	switch {
+	case r1 == disjoint || r2 == disjoint:
+		return disjoint
+	case r1 == equivalent:
+		return r2
+	case r2 == equivalent:
+		return r1
+	case r1 == overlaps || r2 == overlaps:
+		return overlaps
+	case r1 == r2:
+		return r1
+	}
+	return overlaps
+}
+
+// describeConflict explains why p1 and p2 conflict.
+func describeConflict(p1, p2 *pattern) string {
+	if p1.comparePathsAndMethods(p2) == equivalent {
+		return "they match the same requests"
+	}
+	return "both match some requests, but neither is more specific than the other"
+}
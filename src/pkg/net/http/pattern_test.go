@@ -0,0 +1,170 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePattern(t *testing.T) {
+	lit := func(s string) segment { return segment{s: s} }
+	wild := func(s string) segment { return segment{s: s, wild: true} }
+	multi := func(s string) segment { return segment{s: s, wild: true, multi: true} }
+	dollar := segment{s: "/", dollar: true}
+
+	tests := []struct {
+		in   string
+		want pattern
+	}{
+		{"/", pattern{segments: []segment{multi("")}}},
+		{"/a", pattern{segments: []segment{lit("a")}}},
+		{"/a/", pattern{segments: []segment{lit("a"), multi("")}}},
+		{"/{$}", pattern{segments: []segment{dollar}}},
+		{"/a/{$}", pattern{segments: []segment{lit("a"), dollar}}},
+		{"GET /users/{id}", pattern{method: "GET", segments: []segment{lit("users"), wild("id")}}},
+		{"POST\t example.com/b/{x}/c/{rest...}", pattern{
+			method:   "POST",
+			host:     "example.com",
+			segments: []segment{lit("b"), wild("x"), lit("c"), multi("rest")},
+		}},
+		{"example.com/", pattern{host: "example.com", segments: []segment{multi("")}}},
+
+		// Segments that aren't wildcards are literals, braces and
+		// all, and unclean paths are allowed, as they were before
+		// patterns had wildcards.
+		{"/{x", pattern{segments: []segment{lit("{x")}}},
+		{"/a{x}", pattern{segments: []segment{lit("a{x}")}}},
+		{"/x}", pattern{segments: []segment{lit("x}")}}},
+		{"/{}", pattern{segments: []segment{lit("{}")}}},
+		{"/{1x}", pattern{segments: []segment{lit("{1x}")}}},
+		{"/{x.y}", pattern{segments: []segment{lit("{x.y}")}}},
+		{"/a/../b", pattern{segments: []segment{lit("a"), lit(".."), lit("b")}}},
+		{"/a//b", pattern{segments: []segment{lit("a"), lit(""), lit("b")}}},
+	}
+	for _, test := range tests {
+		got, err := parsePattern(test.in)
+		if err != nil {
+			t.Errorf("parsePattern(%q): %v", test.in, err)
+			continue
+		}
+		test.want.str = test.in
+		if !reflect.DeepEqual(*got, test.want) {
+			t.Errorf("parsePattern(%q) = %#v; want %#v", test.in, *got, test.want)
+		}
+	}
+}
+
+func TestParsePatternError(t *testing.T) {
+	tests := []struct {
+		in      string
+		wantErr string
+	}{
+		{"", "empty pattern"},
+		{"a", "missing /"},
+		{"GE:T /", "invalid method"},
+		{"/{x}/{x}", "duplicate wildcard name"},
+		{"/{x...}/a", "not at end"},
+		{"/{$}/a", "not at end"},
+		{"{x}.com/", "host contains '{'"},
+	}
+	for _, test := range tests {
+		_, err := parsePattern(test.in)
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Errorf("parsePattern(%q) error = %v; want error containing %q", test.in, err, test.wantErr)
+		}
+	}
+}
+
+func TestPatternMatchPath(t *testing.T) {
+	tests := []struct {
+		pat     string
+		path    string
+		ok      bool
+		exact   bool
+		matches []string
+	}{
+		{"/", "/", true, true, nil},
+		{"/", "/a/b", true, false, nil},
+		{"/a", "/a", true, true, nil},
+		{"/a", "/a/", false, false, nil},
+		{"/a/", "/a", false, false, nil},
+		{"/a/", "/a/", true, true, nil},
+		{"/a/", "/a/b/c", true, false, nil},
+		{"/{$}", "/", true, true, nil},
+		{"/{$}", "/a", false, false, nil},
+		{"/a/{$}", "/a/", true, true, nil},
+		{"/a/{$}", "/a/b", false, false, nil},
+		{"/a/{x}", "/a/b", true, true, []string{"b"}},
+		{"/a/{x}", "/a/", false, false, nil},
+		{"/a/{x}", "/a/b/", false, false, nil},
+		{"/{x}/b/{y}", "/a/b/c", true, true, []string{"a", "c"}},
+		{"/a/{rest...}", "/a/", true, true, []string{""}},
+		{"/a/{rest...}", "/a/b/c/", true, false, []string{"b/c/"}},
+		{"/a/{rest...}", "/a", false, false, nil},
+		{"/", "", false, false, nil},
+	}
+	for _, test := range tests {
+		p, err := parsePattern(test.pat)
+		if err != nil {
+			t.Fatal(err)
+		}
+		matches, exact, ok := p.matchPath(test.path)
+		if ok != test.ok || ok && (exact != test.exact || !reflect.DeepEqual(matches, test.matches)) {
+			t.Errorf("%q.matchPath(%q) = %q, %v, %v; want %q, %v, %v",
+				test.pat, test.path, matches, exact, ok, test.matches, test.exact, test.ok)
+		}
+	}
+}
+
+func TestPatternCompare(t *testing.T) {
+	tests := []struct {
+		p1, p2 string
+		want   relationship
+	}{
+		{"/a", "/a", equivalent},
+		{"/a", "/b", disjoint},
+		{"/", "/a", moreGeneral},
+		{"/a/", "/a/b/", moreGeneral},
+		{"/a/", "/a", disjoint},
+		{"/a/{x}", "/a/b", moreGeneral},
+		{"/a/{x}", "/a/{y}", equivalent},
+		{"/a/{x}", "/a/{$}", disjoint},
+		{"/a/{$}", "/a/", moreSpecific},
+		{"/a/{x...}", "/a/", equivalent},
+		{"/{x}/b", "/a/{y}", overlaps},
+		{"/users/{id}", "/{resource}/latest", overlaps},
+		{"GET /a", "/a", moreSpecific},
+		{"HEAD /a", "GET /a", moreSpecific},
+		{"GET /a", "POST /a", disjoint},
+		{"GET /", "/a", overlaps},
+		{"GET /a/", "POST /a/b", disjoint},
+	}
+	for _, test := range tests {
+		p1, err := parsePattern(test.p1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p2, err := parsePattern(test.p2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := p1.comparePathsAndMethods(p2); got != test.want {
+			t.Errorf("%q vs %q = %v; want %v", test.p1, test.p2, got, test.want)
+		}
+		// The relationship must be symmetric.
+		inverse := test.want
+		switch inverse {
+		case moreGeneral:
+			inverse = moreSpecific
+		case moreSpecific:
+			inverse = moreGeneral
+		}
+		if got := p2.comparePathsAndMethods(p1); got != inverse {
+			t.Errorf("%q vs %q = %v; want %v", test.p2, test.p1, got, inverse)
+		}
+	}
+}
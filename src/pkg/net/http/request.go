@@ -17,6 +17,7 @@ import (
 	"io/ioutil"
 	"mime"
 	"mime/multipart"
+	"net/http/httptrace"
 	"net/textproto"
 	"net/url"
 	"strconv"
@@ -150,12 +151,23 @@ type Request struct {
 	// The HTTP client ignores MultipartForm and uses Body instead.
 	MultipartForm *multipart.Form
 
-	// Trailer maps trailer keys to values.  Like for Header, if the
-	// response has multiple trailer lines with the same key, they will be
-	// concatenated, delimited by commas.
-	// For server requests, Trailer is only populated after Body has been
-	// closed or fully consumed.
-	// Trailer support is only partially complete.
+	// Trailer specifies additional headers that are sent after the
+	// request body.
+	//
+	// For server requests, the Trailer map initially contains only
+	// the trailer keys, with nil values. (The client declares which
+	// trailers it will later send.) While the handler is reading
+	// from Body, it must not reference Trailer. After reading from
+	// Body returns EOF, Trailer can be read again and will contain
+	// non-nil values, if they were sent by the client.
+	//
+	// For client requests, Trailer must be initialized to a map
+	// containing the trailer keys to later send. The values may be
+	// nil or their final values. The ContentLength must be 0 or -1,
+	// to send a chunked request. After the HTTP request is sent the
+	// map values can be updated while the request body is read.
+	// Once the body returns EOF, the caller must not mutate
+	// Trailer.
 	Trailer Header
 
 	// RemoteAddr allows HTTP servers and other software to record
@@ -181,6 +193,16 @@ type Request struct {
 	// otherwise it leaves the field nil.
 	// This field is ignored by the HTTP client.
 	TLS *tls.ConnectionState
+
+	// Trace, if non-nil, receives the Transport's events while
+	// it sends the request and reads the response headers.
+	// This field is ignored by the HTTP server.
+	Trace *httptrace.ClientTrace
+
+	// pat is the ServeMux pattern that matched the request, and
+	// matches holds the values of its wildcards.
+	pat     *pattern
+	matches []string
 }
 
 // ProtoAtLeast reports whether the HTTP protocol used
@@ -190,6 +212,22 @@ func (r *Request) ProtoAtLeast(major, minor int) bool {
 		r.ProtoMajor == major && r.ProtoMinor >= minor
 }
 
+// PathValue returns the value of the named path wildcard in the
+// ServeMux pattern that matched the request. It returns the empty
+// string if the request was not matched against a pattern or there
+// is no such wildcard in the pattern. The value is taken from the
+// decoded URL.Path, so it is unescaped, and a {NAME} wildcard never
+// matches a segment containing an escaped slash ("%2F").
+func (r *Request) PathValue(name string) string {
+	if r.pat == nil {
+		return ""
+	}
+	if i := r.pat.wildcardIndex(name); i >= 0 && i < len(r.matches) {
+		return r.matches[i]
+	}
+	return ""
+}
+
 // UserAgent returns the client's User-Agent, if sent in the request.
 func (r *Request) UserAgent() string {
 	return r.Header.Get("User-Agent")
@@ -299,7 +337,7 @@ const defaultUserAgent = "Go 1.1 package http"
 // hasn't been set to "identity", Write adds "Transfer-Encoding:
 // chunked" to the header. Body is closed after it is sent.
 func (r *Request) Write(w io.Writer) error {
-	return r.write(w, false, nil)
+	return r.write(w, false, nil, nil)
 }
 
 // WriteProxy is like Write but writes the request in the form
@@ -309,11 +347,12 @@ func (r *Request) Write(w io.Writer) error {
 // In either case, WriteProxy also writes a Host header, using
 // either r.Host or r.URL.Host.
 func (r *Request) WriteProxy(w io.Writer) error {
-	return r.write(w, true, nil)
+	return r.write(w, true, nil, nil)
 }
 
-// extraHeaders may be nil
-func (req *Request) write(w io.Writer, usingProxy bool, extraHeaders Header) error {
+// extraHeaders and trace may be nil. trace is only set when the
+// Transport is writing the request.
+func (req *Request) write(w io.Writer, usingProxy bool, extraHeaders Header, trace *httptrace.ClientTrace) error {
 	host := req.Host
 	if host == "" {
 		if req.URL == nil {
@@ -382,6 +421,9 @@ func (req *Request) write(w io.Writer, usingProxy bool, extraHeaders Header) err
 	}
 
 	io.WriteString(w, "\r\n")
+	if trace != nil && trace.WroteHeaders != nil {
+		trace.WroteHeaders()
+	}
 
 	// Write body and trailer
 	err = tw.WriteBody(w)
@@ -49,6 +49,10 @@ type Response struct {
 	//
 	// The Body is automatically dechunked if the server replied
 	// with a "chunked" Transfer-Encoding.
+	//
+	// For a 101 Switching Protocols response from the Transport,
+	// Body also implements io.Writer and is the connection itself,
+	// for use with the new protocol.
 	Body io.ReadCloser
 
 	// ContentLength records the length of the associated content.  The
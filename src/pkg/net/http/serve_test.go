@@ -272,6 +272,186 @@ func TestMuxRedirectLeadingSlashes(t *testing.T) {
 	}
 }
 
+func TestServeMuxPatterns(t *testing.T) {
+	mux := NewServeMux()
+	for _, pattern := range []string{
+		"/",
+		"/{$}",
+		"/users/{id}",
+		"GET /users/{id}",
+		"/users/{id}/posts/{post}",
+		"/files/{path...}",
+		"/static/",
+		"/static/css/",
+		"DELETE /items/{id}",
+		"example.com/users/{id}",
+	} {
+		pattern := pattern
+		mux.HandleFunc(pattern, func(w ResponseWriter, r *Request) {
+			w.Header().Set("Pattern", pattern)
+			fmt.Fprintf(w, "id=%s post=%s path=%s", r.PathValue("id"), r.PathValue("post"), r.PathValue("path"))
+		})
+	}
+
+	tests := []struct {
+		method, host, path string
+		code               int
+		pattern            string // or the Location header for redirects
+		body               string
+	}{
+		{"GET", "", "/", 200, "/{$}", "id= post= path="},
+		{"GET", "", "/other", 200, "/", ""},
+		{"GET", "", "/users/42", 200, "GET /users/{id}", "id=42 post= path="},
+		{"HEAD", "", "/users/42", 200, "GET /users/{id}", ""},
+		{"PUT", "", "/users/42", 200, "/users/{id}", "id=42 post= path="},
+		{"GET", "", "/users/42/posts/7", 200, "/users/{id}/posts/{post}", "id=42 post=7 path="},
+		{"GET", "", "/files/a/b/c.txt", 200, "/files/{path...}", "id= post= path=a/b/c.txt"},
+		{"GET", "", "/files", 301, "/files/", ""},
+		{"GET", "", "/static/img/x.png", 200, "/static/", ""},
+		{"GET", "", "/static/css/x.css", 200, "/static/css/", ""},
+		{"GET", "", "/static", 301, "/static/", ""},
+		{"GET", "example.com", "/users/42", 200, "example.com/users/{id}", "id=42 post= path="},
+		{"GET", "example.com", "/other", 200, "/", ""},
+	}
+	for _, test := range tests {
+		req := &Request{
+			Method: test.method,
+			Host:   test.host,
+			URL:    &url.URL{Path: test.path},
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != test.code {
+			t.Errorf("%s %s%s: code = %d; want %d", test.method, test.host, test.path, rec.Code, test.code)
+			continue
+		}
+		got := rec.HeaderMap.Get("Pattern")
+		if test.code == 301 {
+			got = rec.HeaderMap.Get("Location")
+		}
+		if got != test.pattern {
+			t.Errorf("%s %s%s: matched %q; want %q", test.method, test.host, test.path, got, test.pattern)
+		}
+		if test.body != "" && rec.Body.String() != test.body {
+			t.Errorf("%s %s%s: body = %q; want %q", test.method, test.host, test.path, rec.Body.String(), test.body)
+		}
+	}
+}
+
+func TestServeMuxHandlerPattern(t *testing.T) {
+	mux := NewServeMux()
+	mux.HandleFunc("GET /a/{x}", func(ResponseWriter, *Request) {})
+	mux.HandleFunc("/tree/", func(ResponseWriter, *Request) {})
+	tests := []struct {
+		method, path, want string
+	}{
+		{"GET", "/a/b", "GET /a/{x}"},
+		{"GET", "/a/./b", "GET /a/{x}"}, // redirect to the clean path
+		{"GET", "/tree", "/tree/"},      // redirect to the subtree
+		{"GET", "/nope", ""},
+		{"POST", "/a/b", ""}, // method not allowed
+	}
+	for _, test := range tests {
+		req := &Request{Method: test.method, URL: &url.URL{Path: test.path}}
+		if h, pattern := mux.Handler(req); h == nil || pattern != test.want {
+			t.Errorf("Handler(%s %s) = %v, %q; want pattern %q", test.method, test.path, h, pattern, test.want)
+		}
+	}
+}
+
+func TestServeMuxMethodNotAllowed(t *testing.T) {
+	mux := NewServeMux()
+	for _, pattern := range []string{"GET /items/{id}", "DELETE /items/{id}", "PUT /items/{id}"} {
+		mux.HandleFunc(pattern, func(ResponseWriter, *Request) {})
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, &Request{Method: "POST", URL: &url.URL{Path: "/items/1"}})
+	if rec.Code != StatusMethodNotAllowed {
+		t.Errorf("code = %d; want %d", rec.Code, StatusMethodNotAllowed)
+	}
+	if got, want := rec.HeaderMap.Get("Allow"), "DELETE, GET, HEAD, PUT"; got != want {
+		t.Errorf("Allow = %q; want %q", got, want)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, &Request{Method: "POST", URL: &url.URL{Path: "/other"}})
+	if rec.Code != StatusNotFound {
+		t.Errorf("unmatched path: code = %d; want %d", rec.Code, StatusNotFound)
+	}
+}
+
+func TestServeMuxRegistrationPanics(t *testing.T) {
+	tests := []struct {
+		existing, pattern string
+		wantPanic         string
+	}{
+		{"", "", "invalid pattern"},
+		{"", "/{x}/{x}", "invalid pattern"},
+		{"/a", "/a", "multiple registrations"},
+		{"/a/{x}", "/a/{y}", "multiple registrations"},
+		{"/users/{id}", "/{resource}/latest", "conflicts with"},
+		{"GET /", "/index.html", "conflicts with"},
+	}
+	for _, test := range tests {
+		mux := NewServeMux()
+		if test.existing != "" {
+			mux.HandleFunc(test.existing, func(ResponseWriter, *Request) {})
+		}
+		func() {
+			defer func() {
+				r := recover()
+				if s, _ := r.(string); !strings.Contains(s, test.wantPanic) {
+					t.Errorf("registering %q after %q: panic %v; want panic containing %q",
+						test.pattern, test.existing, r, test.wantPanic)
+				}
+			}()
+			mux.HandleFunc(test.pattern, func(ResponseWriter, *Request) {})
+		}()
+	}
+
+	// Patterns where one is more specific than the other, or
+	// with different hosts, don't conflict.
+	mux := NewServeMux()
+	for _, pattern := range []string{"/", "/a/", "/a/{x}", "/a/b", "/a/{$}", "GET /a/b", "HEAD /a/b", "example.com/a/b"} {
+		mux.HandleFunc(pattern, func(ResponseWriter, *Request) {})
+	}
+}
+
+// Tests that patterns that were valid before patterns had wildcards
+// can still be registered, with braces matched literally.
+func TestServeMuxLiteralPatterns(t *testing.T) {
+	mux := NewServeMux()
+	for _, pattern := range []string{"/old/{brace", "/a{b}c/", "/x}", "/{}", "/x/../y"} {
+		pattern := pattern
+		mux.HandleFunc(pattern, func(w ResponseWriter, r *Request) {
+			w.Header().Set("Pattern", pattern)
+		})
+	}
+	tests := []struct {
+		path, want string
+	}{
+		{"/old/{brace", "/old/{brace"},
+		{"/a{b}c/d", "/a{b}c/"},
+		{"/x}", "/x}"},
+		{"/{}", "/{}"},
+		{"/other", ""},
+	}
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, &Request{Method: "GET", URL: &url.URL{Path: test.path}})
+		if got := rec.HeaderMap.Get("Pattern"); got != test.want {
+			t.Errorf("%s: matched %q; want %q", test.path, got, test.want)
+		}
+	}
+}
+
+func TestPathValueUnmatched(t *testing.T) {
+	req, _ := NewRequest("GET", "http://example.com/a", nil)
+	if v := req.PathValue("x"); v != "" {
+		t.Errorf("PathValue on unrouted request = %q; want empty", v)
+	}
+}
+
 func TestServerTimeouts(t *testing.T) {
 	defer afterTest(t)
 	reqNum := 0
@@ -1412,6 +1592,346 @@ For:
 	ts.Close()
 }
 
+// eofFuncReader calls itself when read, and returns io.EOF.
+type eofFuncReader func()
+
+func (f eofFuncReader) Read([]byte) (int, error) {
+	f()
+	return 0, io.EOF
+}
+
+// trailerHandler echoes the request body, and sends back the X-Sum
+// request trailer in its own trailers.
+func trailerHandler(t *testing.T) Handler {
+	return HandlerFunc(func(w ResponseWriter, r *Request) {
+		if _, ok := r.Trailer["X-Sum"]; !ok {
+			t.Errorf("request Trailer = %v; want declared X-Sum key", r.Trailer)
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Trailer", "X-Request-Sum, X-Status")
+		w.Write(body)
+		w.(Flusher).Flush()
+		// Declared trailers can be set after the header is sent.
+		w.Header().Set("X-Request-Sum", r.Trailer.Get("X-Sum"))
+		w.Header().Set("X-Status", "done")
+	})
+}
+
+func testTrailers(t *testing.T, c *Client, url string) {
+	const body = "some body"
+	req, _ := NewRequest("POST", url, nil)
+	req.Trailer = Header{"X-Sum": nil}
+	req.Body = ioutil.NopCloser(io.MultiReader(
+		strings.NewReader(body),
+		eofFuncReader(func() { req.Trailer.Set("X-Sum", "1234") }),
+	))
+	res, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if _, ok := res.Trailer["X-Status"]; !ok {
+		t.Errorf("before reading body, Trailer = %v; want declared X-Status key", res.Trailer)
+	}
+	if h := res.Header.Get("Trailer"); h != "" {
+		t.Errorf("Trailer header = %q; want it moved to Response.Trailer", h)
+	}
+	got, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != body {
+		t.Errorf("body = %q; want %q", got, body)
+	}
+	want := Header{"X-Request-Sum": {"1234"}, "X-Status": {"done"}}
+	if !reflect.DeepEqual(res.Trailer, want) {
+		t.Errorf("Trailer = %v; want %v", res.Trailer, want)
+	}
+}
+
+func TestTrailers(t *testing.T) {
+	defer afterTest(t)
+	ts := httptest.NewServer(trailerHandler(t))
+	defer ts.Close()
+	testTrailers(t, &Client{}, ts.URL)
+}
+
+// Tests that declared trailers make the server use chunked encoding
+// even when it could have set a Content-Length, and that HTTP/1.0
+// clients, which can't receive trailers, still get the body.
+func TestServerTrailersWire(t *testing.T) {
+	handler := HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Header().Set("Trailer", "X-Foo")
+		io.WriteString(w, "body")
+		w.Header().Set("X-Foo", "bar")
+	})
+	for _, test := range []struct {
+		proto string
+		want  string
+	}{
+		{"HTTP/1.1", "4\r\nbody\r\n0\r\nX-Foo: bar\r\n\r\n"},
+		{"HTTP/1.0", "body"},
+	} {
+		conn := &rwTestConn{
+			Reader: strings.NewReader("GET / " + test.proto + "\r\nHost: foo\r\n\r\n"),
+			Writer: new(bytes.Buffer),
+			closec: make(chan bool, 1),
+		}
+		ln := &oneConnListener{conn: conn}
+		go Serve(ln, handler)
+		<-conn.closec
+		res := conn.Writer.(*bytes.Buffer).String()
+		if !strings.HasSuffix(res, "\r\n\r\n"+test.want) {
+			t.Errorf("%s response = %q; want body %q", test.proto, res, test.want)
+		}
+	}
+}
+
+func TestServerConnState(t *testing.T) {
+	defer afterTest(t)
+	handler := map[string]func(w ResponseWriter, r *Request){
+		"/": func(w ResponseWriter, r *Request) {
+			io.WriteString(w, "Hello.")
+		},
+		"/close": func(w ResponseWriter, r *Request) {
+			w.Header().Set("Connection", "close")
+			io.WriteString(w, "Hello.")
+		},
+		"/hijack": func(w ResponseWriter, r *Request) {
+			c, _, _ := w.(Hijacker).Hijack()
+			c.Write([]byte("HTTP/1.0 200 OK\r\nConnection: close\r\n\r\nHello."))
+			c.Close()
+		},
+	}
+	ts := httptest.NewUnstartedServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+		handler[r.URL.Path](w, r)
+	}))
+	defer ts.Close()
+
+	var mu sync.Mutex // guard stateLog and connID
+	var stateLog = map[int][]ConnState{}
+	var connID = map[net.Conn]int{}
+
+	ts.Config.ConnState = func(c net.Conn, state ConnState) {
+		if c == nil {
+			t.Errorf("nil conn seen in state %s", state)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		id, ok := connID[c]
+		if !ok {
+			id = len(connID) + 1
+			connID[c] = id
+		}
+		stateLog[id] = append(stateLog[id], state)
+	}
+	ts.Start()
+
+	tr := &Transport{}
+	defer tr.CloseIdleConnections()
+	c := &Client{Transport: tr}
+
+	mustGet := func(url string, headers ...string) {
+		req, err := NewRequest("GET", url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for len(headers) > 0 {
+			req.Header.Add(headers[0], headers[1])
+			headers = headers[2:]
+		}
+		res, err := c.Do(req)
+		if err != nil {
+			t.Errorf("Error fetching %s: %v", url, err)
+			return
+		}
+		_, err = ioutil.ReadAll(res.Body)
+		defer res.Body.Close()
+		if err != nil {
+			t.Errorf("Error reading %s: %v", url, err)
+		}
+	}
+
+	mustGet(ts.URL + "/")
+	mustGet(ts.URL + "/close")
+
+	mustGet(ts.URL + "/")
+	mustGet(ts.URL+"/", "Connection", "close")
+
+	mustGet(ts.URL + "/hijack")
+
+	want := map[int][]ConnState{
+		1: {StateNew, StateActive, StateIdle, StateActive, StateClosed},
+		2: {StateNew, StateActive, StateIdle, StateActive, StateClosed},
+		3: {StateNew, StateActive, StateHijacked},
+	}
+	logString := func(m map[int][]ConnState) string {
+		var b bytes.Buffer
+		for id := 1; id <= len(m); id++ {
+			fmt.Fprintf(&b, "Conn %d: ", id)
+			for _, s := range m[id] {
+				fmt.Fprintf(&b, "%s ", s)
+			}
+			b.WriteString("\n")
+		}
+		return b.String()
+	}
+
+	for i := 0; i < 5; i++ {
+		time.Sleep(time.Duration(i) * 50 * time.Millisecond)
+		mu.Lock()
+		match := reflect.DeepEqual(stateLog, want)
+		mu.Unlock()
+		if match {
+			return
+		}
+	}
+
+	mu.Lock()
+	t.Errorf("Unexpected events.\nGot log: %s\n   Want: %s\n", logString(stateLog), logString(want))
+	mu.Unlock()
+}
+
+// Tests that a client trickling in its request headers is cut off
+// after ReadHeaderTimeout, while a slow body is allowed up to
+// ReadTimeout.
+func TestServerReadHeaderTimeout(t *testing.T) {
+	defer afterTest(t)
+	ts := httptest.NewUnstartedServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading body: %v", err)
+		}
+		w.Write(body)
+	}))
+	ts.Config.ReadHeaderTimeout = 100 * time.Millisecond
+	ts.Config.ReadTimeout = 5 * time.Second
+	ts.Start()
+	defer ts.Close()
+
+	conn, err := net.Dial("tcp", ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	t1 := time.Now()
+	io.WriteString(conn, "GET / HTTP/1.1\r\nHost: foo\r\n")
+	n, err := conn.Read(make([]byte, 1))
+	if n != 0 || err != io.EOF {
+		t.Errorf("Read = %v, %v; want 0, EOF", n, err)
+	}
+	if d := time.Since(t1); d > 3*time.Second {
+		t.Errorf("connection closed after %v; want about 100ms", d)
+	}
+
+	conn, err = net.Dial("tcp", ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	io.WriteString(conn, "POST / HTTP/1.1\r\nHost: foo\r\nContent-Length: 5\r\nConnection: close\r\n\r\n")
+	time.Sleep(300 * time.Millisecond)
+	io.WriteString(conn, "hello")
+	res, err := ReadResponse(bufio.NewReader(conn), &Request{Method: "POST"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	if string(body) != "hello" {
+		t.Errorf("body = %q; want %q", body, "hello")
+	}
+}
+
+func TestServerIdleTimeout(t *testing.T) {
+	defer afterTest(t)
+	ts := httptest.NewUnstartedServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+		io.WriteString(w, "hi")
+	}))
+	ts.Config.IdleTimeout = 200 * time.Millisecond
+	ts.Start()
+	defer ts.Close()
+
+	conn, err := net.Dial("tcp", ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+	for i := 0; i < 2; i++ {
+		io.WriteString(conn, "GET / HTTP/1.1\r\nHost: foo\r\n\r\n")
+		res, err := ReadResponse(br, &Request{Method: "GET"})
+		if err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		// Well within the idle timeout.
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	t1 := time.Now()
+	n, err := br.Read(make([]byte, 1))
+	if n != 0 || err != io.EOF {
+		t.Errorf("Read = %v, %v; want 0, EOF", n, err)
+	}
+	if d := time.Since(t1); d > 3*time.Second {
+		t.Errorf("idle connection closed after %v; want about 150ms", d)
+	}
+}
+
+func TestServerMaxConnsPerIP(t *testing.T) {
+	defer afterTest(t)
+	ts := httptest.NewUnstartedServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+		io.WriteString(w, "hi")
+	}))
+	ts.Config.MaxConnsPerIP = 1
+	ts.Start()
+	defer ts.Close()
+
+	first, err := net.Dial("tcp", ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Close()
+	io.WriteString(first, "GET / HTTP/1.1\r\nHost: foo\r\n\r\n")
+	res, err := ReadResponse(bufio.NewReader(first), &Request{Method: "GET"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	second, err := net.Dial("tcp", ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+	io.WriteString(second, "GET / HTTP/1.1\r\nHost: foo\r\n\r\n")
+	if n, err := second.Read(make([]byte, 1)); n != 0 || err == nil {
+		t.Errorf("second connection Read = %v, %v; want connection closed", n, err)
+	}
+
+	// Once the first connection is gone, the IP may connect again.
+	first.Close()
+	tr := &Transport{}
+	defer tr.CloseIdleConnections()
+	c := &Client{Transport: tr}
+	for i := 0; ; i++ {
+		res, err := c.Get(ts.URL)
+		if err == nil {
+			res.Body.Close()
+			break
+		}
+		if i == 20 {
+			t.Fatalf("Get after closing the first connection: %v", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
 func TestCloseNotifierChanLeak(t *testing.T) {
 	defer afterTest(t)
 	req := reqBytes("GET / HTTP/1.0\nHost: golang.org")
@@ -18,9 +18,11 @@ import (
 	"net/url"
 	"path"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -49,7 +51,11 @@ type Handler interface {
 type ResponseWriter interface {
 	// Header returns the header map that will be sent by WriteHeader.
 	// Changing the header after a call to WriteHeader (or Write) has
-	// no effect.
+	// no effect unless the modified headers were declared as
+	// trailers by setting the "Trailer" header before the call to
+	// WriteHeader. Trailers are sent after the body, which requires
+	// chunked encoding for HTTP/1.1, so they are dropped for
+	// HTTP/1.0 clients.
 	Header() Header
 
 	// Write writes the data to the connection as part of an HTTP reply.
@@ -112,6 +118,7 @@ type conn struct {
 	bufswr     *switchReader        // the *switchReader io.Reader source of buf
 	bufsww     *switchWriter        // the *switchWriter io.Writer dest of buf
 	tlsState   *tls.ConnectionState // or nil when not using TLS
+	limitIP    string               // IP counted against Server.MaxConnsPerIP, or ""
 
 	mu           sync.Mutex // guards the following
 	clientGone   bool       // if client has disconnected mid-request
@@ -139,6 +146,7 @@ func (c *conn) hijack() (rwc net.Conn, buf *bufio.ReadWriter, err error) {
 	buf = c.buf
 	c.rwc = nil
 	c.buf = nil
+	c.setState(rwc, StateHijacked)
 	return
 }
 
@@ -275,10 +283,14 @@ func (cw *chunkWriter) close() {
 		cw.writeHeader(nil)
 	}
 	if cw.chunking {
-		// zero EOF chunk, trailer key/value pairs (currently
-		// unsupported in Go's server), followed by a blank
-		// line.
-		cw.res.conn.buf.WriteString("0\r\n\r\n")
+		// zero EOF chunk, trailer key/value pairs, followed by a
+		// blank line.
+		bw := cw.res.conn.buf
+		bw.WriteString("0\r\n")
+		if trailers := trailerValues(cw.res.handlerHeader, cw.res.trailers); trailers != nil {
+			trailers.Write(bw)
+		}
+		bw.WriteString("\r\n")
 	}
 }
 
@@ -321,6 +333,11 @@ type response struct {
 
 	handlerDone bool // set true when the handler exits
 
+	// trailers are the trailer keys the handler declared in the
+	// Trailer header, set by chunkWriter.writeHeader. Their values
+	// are sent from handlerHeader after the body.
+	trailers []string
+
 	// Buffers for Date and Content-Length
 	dateBuf [len(TimeFormat)]byte
 	clenBuf [10]byte
@@ -498,6 +515,10 @@ func (srv *Server) maxHeaderBytes() int {
 	return DefaultMaxHeaderBytes
 }
 
+func (srv *Server) initialLimitedReaderSize() int64 {
+	return int64(srv.maxHeaderBytes()) + 4096 // bufio slop
+}
+
 // wrapper around io.ReaderCloser which on first read, sends an
 // HTTP/1.1 100 Continue header
 type expectContinueReader struct {
@@ -558,24 +579,40 @@ func (c *conn) readRequest() (w *response, err error) {
 		return nil, ErrHijacked
 	}
 
+	// The header must arrive within ReadHeaderTimeout, the whole
+	// request within ReadTimeout.
+	var hdrDeadline, wholeReqDeadline time.Time // zero means none
+	t0 := time.Now()
+	if d := c.server.readHeaderTimeout(); d != 0 {
+		hdrDeadline = t0.Add(d)
+	}
 	if d := c.server.ReadTimeout; d != 0 {
-		c.rwc.SetReadDeadline(time.Now().Add(d))
+		wholeReqDeadline = t0.Add(d)
 	}
+	c.rwc.SetReadDeadline(hdrDeadline)
 	if d := c.server.WriteTimeout; d != 0 {
 		defer func() {
 			c.rwc.SetWriteDeadline(time.Now().Add(d))
 		}()
 	}
 
-	c.lr.N = int64(c.server.maxHeaderBytes()) + 4096 /* bufio slop */
+	c.lr.N = c.server.initialLimitedReaderSize()
 	var req *Request
-	if req, err = ReadRequest(c.buf.Reader); err != nil {
+	req, err = ReadRequest(c.buf.Reader)
+	if err == nil || c.lr.N != c.server.initialLimitedReaderSize() {
+		// If we read any bytes off the wire, we're active.
+		c.setState(c.rwc, StateActive)
+	}
+	if err != nil {
 		if c.lr.N == 0 {
 			return nil, errTooLarge
 		}
 		return nil, err
 	}
 	c.lr.N = noLimit
+	if !hdrDeadline.Equal(wholeReqDeadline) {
+		c.rwc.SetReadDeadline(wholeReqDeadline)
+	}
 
 	req.RemoteAddr = c.remoteAddr
 	req.TLS = c.tlsState
@@ -731,11 +768,14 @@ func (cw *chunkWriter) writeHeader(p []byte) {
 	}
 	var setHeader extraHeader
 
+	w.trailers = declaredTrailers(header)
+
 	// If the handler is done but never sent a Content-Length
 	// response header and this is our first (and last) write, set
 	// it, even to zero. This helps HTTP/1.0 clients keep their
-	// "keep-alive" connections alive.
-	if w.handlerDone && header.get("Content-Length") == "" && w.req.Method != "HEAD" {
+	// "keep-alive" connections alive. Responses with trailers are
+	// chunked instead.
+	if w.handlerDone && len(w.trailers) == 0 && header.get("Content-Length") == "" && w.req.Method != "HEAD" {
 		w.contentLength = int64(len(p))
 		setHeader.contentLength = strconv.AppendInt(cw.res.clenBuf[:0], int64(len(p)), 10)
 	}
@@ -761,7 +801,7 @@ func (cw *chunkWriter) writeHeader(p []byte) {
 		w.closeAfterReply = true
 	}
 
-	if header.get("Connection") == "close" {
+	if header.get("Connection") == "close" || !w.conn.server.doKeepAlives() {
 		w.closeAfterReply = true
 	}
 
@@ -854,6 +894,38 @@ func (cw *chunkWriter) writeHeader(p []byte) {
 	w.conn.buf.Write(crlf)
 }
 
+// declaredTrailers returns the trailer keys declared by the Trailer
+// header in h.
+func declaredTrailers(h Header) (keys []string) {
+	for _, v := range h["Trailer"] {
+		foreachHeaderElement(v, func(k string) {
+			k = CanonicalHeaderKey(k)
+			switch k {
+			case "Transfer-Encoding", "Trailer", "Content-Length":
+				// Bogus; these can't be trailers.
+				return
+			}
+			keys = append(keys, k)
+		})
+	}
+	return
+}
+
+// trailerValues returns the values in h of the trailer keys, or nil
+// if none are set.
+func trailerValues(h Header, keys []string) Header {
+	var trailers Header
+	for _, k := range keys {
+		if vv := h[k]; len(vv) > 0 {
+			if trailers == nil {
+				trailers = make(Header)
+			}
+			trailers[k] = vv
+		}
+	}
+	return trailers
+}
+
 // statusLines is a cache of Status-Line strings, keyed by code (for
 // HTTP/1.1) or negative code (for HTTP/1.0). This is faster than a
 // map keyed by struct of two fields. This map's max size is bounded
@@ -1057,8 +1129,18 @@ func validNPN(proto string) bool {
 	return true
 }
 
+func (c *conn) setState(nc net.Conn, state ConnState) {
+	if state == StateClosed || state == StateHijacked {
+		c.server.releaseConnIP(c.limitIP)
+	}
+	if hook := c.server.ConnState; hook != nil {
+		hook(nc, state)
+	}
+}
+
 // Serve a new connection.
 func (c *conn) serve() {
+	origConn := c.rwc // copy it before it's set nil on Close or Hijack
 	defer func() {
 		if err := recover(); err != nil {
 			const size = 4096
@@ -1068,6 +1150,7 @@ func (c *conn) serve() {
 		}
 		if !c.hijacked() {
 			c.close()
+			c.setState(origConn, StateClosed)
 		}
 	}()
 
@@ -1086,6 +1169,7 @@ func (c *conn) serve() {
 		if proto := c.tlsState.NegotiatedProtocol; validNPN(proto) {
 			if fn := c.server.TLSNextProto[proto]; fn != nil {
 				h := initNPNRequest{tlsConn, serverHandler{c.server}}
+				c.setState(c.rwc, StateActive)
 				fn(c.server, tlsConn, h)
 			}
 			return
@@ -1148,6 +1232,16 @@ func (c *conn) serve() {
 			}
 			break
 		}
+		c.setState(c.rwc, StateIdle)
+
+		// Wait at most IdleTimeout for the next request to
+		// start.
+		if d := c.server.idleTimeout(); d != 0 {
+			c.rwc.SetReadDeadline(time.Now().Add(d))
+			if _, err := c.buf.Peek(1); err != nil {
+				break
+			}
+		}
 	}
 }
 
@@ -1324,55 +1418,81 @@ func RedirectHandler(url string, code int) Handler {
 // patterns and calls the handler for the pattern that
 // most closely matches the URL.
 //
-// Patterns name fixed, rooted paths, like "/favicon.ico",
-// or rooted subtrees, like "/images/" (note the trailing slash).
-// Longer patterns take precedence over shorter ones, so that
-// if there are handlers registered for both "/images/"
-// and "/images/thumbnails/", the latter handler will be
-// called for paths beginning "/images/thumbnails/" and the
-// former will receive requests for any other paths in the
-// "/images/" subtree.
+// A pattern has the form
+//
+//	[METHOD ][HOST]/[PATH]
+//
+// All three parts are optional; "/" is a valid pattern.
 //
-// Patterns may optionally begin with a host name, restricting matches to
+// A pattern with a method matches only requests with that method,
+// except that "GET" also matches "HEAD". A pattern with no method
+// matches every method.
+//
+// Patterns may optionally include a host name, restricting matches to
 // URLs on that host only.  Host-specific patterns take precedence over
 // general patterns, so that a handler might register for the two patterns
 // "/codesearch" and "codesearch.google.com/" without also taking over
 // requests for "http://www.google.com/".
 //
+// The path may contain wildcards of the form {NAME} or {NAME...}.
+// A {NAME} wildcard matches a single path segment; a {NAME...}
+// wildcard, which must come last, matches the rest of the path.
+// The segments matched by wildcards are available to the handler
+// through Request.PathValue. For example, "GET /users/{id}" matches
+// a GET request for "/users/42", and "/files/{path...}" matches any
+// path under "/files/". A segment that isn't a wildcard of these
+// forms, such as "{x" or "a{b}", is matched literally.
+//
+// Patterns are matched against the decoded URL.Path, so an escaped
+// slash in a request path separates segments just like a slash does:
+// "/items/{id}" doesn't match "/items/a%2Fb", and "/files/{path...}"
+// can't tell "/files/a%2Fb" from "/files/a/b".
+//
+// A path ending in a slash, like "/images/", names a rooted subtree
+// and matches any path beginning with it, as if it ended in an
+// anonymous {...} wildcard. The special wildcard {$} matches only
+// the end of the path, so "/{$}" matches just "/" while "/" matches
+// every path.
+//
+// When more than one pattern matches a request, the most specific
+// one wins: a pattern is more specific than another if it matches a
+// strict subset of the other's requests. So if handlers are registered
+// for both "/images/" and "/images/thumbnails/", the latter will be
+// called for paths beginning "/images/thumbnails/" and the former
+// will receive requests for any other paths in the "/images/"
+// subtree, and "GET /users/{id}" is preferred to "/users/{id}" for a
+// GET request. Two patterns that match some requests in common but
+// where neither is more specific, such as "/users/{id}" and
+// "/{resource}/latest", conflict, and registering the second one
+// panics.
+//
+// If a subtree has been registered and a request is received naming
+// the subtree root without its trailing slash, ServeMux redirects
+// that request to the subtree root (adding the trailing slash).
+// If a pattern matches the path of a request but not its method,
+// ServeMux replies with "405 Method Not Allowed" and an Allow header
+// listing the methods that would match.
+//
 // ServeMux also takes care of sanitizing the URL request path,
 // redirecting any request containing . or .. elements to an
 // equivalent .- and ..-free URL.
 type ServeMux struct {
 	mu    sync.RWMutex
-	m     map[string]muxEntry
+	es    []muxEntry
 	hosts bool // whether any patterns contain hostnames
 }
 
 type muxEntry struct {
-	explicit bool
-	h        Handler
-	pattern  string
+	h   Handler
+	pat *pattern
 }
 
 // NewServeMux allocates and returns a new ServeMux.
-func NewServeMux() *ServeMux { return &ServeMux{m: make(map[string]muxEntry)} }
+func NewServeMux() *ServeMux { return new(ServeMux) }
 
 // DefaultServeMux is the default ServeMux used by Serve.
 var DefaultServeMux = NewServeMux()
 
-// Does path match pattern?
-func pathMatch(pattern, path string) bool {
-	if len(pattern) == 0 {
-		// should not happen
-		return false
-	}
-	n := len(pattern)
-	if pattern[n-1] != '/' {
-		return pattern == path
-	}
-	return len(path) >= n && path[0:n] == pattern
-}
-
 // Return the canonical path for p, eliminating . and .. elements.
 func cleanPath(p string) string {
 	if p == "" {
@@ -1390,23 +1510,68 @@ func cleanPath(p string) string {
 	return np
 }
 
-// Find a handler on a handler map given a path string
-// Most-specific (longest) pattern wins
-func (mux *ServeMux) match(path string) (h Handler, pattern string) {
-	var n = 0
-	for k, v := range mux.m {
-		if !pathMatch(k, path) {
+// match finds the most specific pattern on host (or on no host if
+// host is "") that matches method and path. It reports whether the
+// match is exact, that is, whether the path matched without a
+// trailing-slash subtree matching more of it.
+func (mux *ServeMux) match(host, method, path string) (e *muxEntry, matches []string, exact bool) {
+	for i := range mux.es {
+		c := &mux.es[i]
+		if c.pat.host != host || !c.pat.matchMethod(method) {
 			continue
 		}
-		if h == nil || len(k) > n {
-			n = len(k)
-			h = v.h
-			pattern = v.pattern
+		m, x, ok := c.pat.matchPath(path)
+		if !ok {
+			continue
 		}
+		// Patterns that match the same request never conflict,
+		// so one of them is more specific than the other.
+		if e == nil || c.pat.comparePathsAndMethods(e.pat) == moreSpecific {
+			e, matches, exact = c, m, x
+		}
+	}
+	return
+}
+
+// lookup is like match, but host-specific patterns take precedence
+// over generic ones.
+func (mux *ServeMux) lookup(host, method, path string) (e *muxEntry, matches []string, exact bool) {
+	if mux.hosts {
+		e, matches, exact = mux.match(host, method, path)
+	}
+	if e == nil {
+		e, matches, exact = mux.match("", method, path)
 	}
 	return
 }
 
+// allowedMethods returns the methods of the patterns that match
+// host and path, for a 405 response's Allow header.
+func (mux *ServeMux) allowedMethods(host, path string) []string {
+	seen := make(map[string]bool)
+	var methods []string
+	for _, e := range mux.es {
+		if e.pat.host != "" && e.pat.host != host {
+			continue
+		}
+		if _, _, ok := e.pat.matchPath(path); !ok {
+			continue
+		}
+		ms := []string{e.pat.method}
+		if e.pat.method == "GET" {
+			ms = append(ms, "HEAD")
+		}
+		for _, m := range ms {
+			if !seen[m] {
+				seen[m] = true
+				methods = append(methods, m)
+			}
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
 // Handler returns the handler to use for the given request,
 // consulting r.Method, r.Host, and r.URL.Path. It always returns
 // a non-nil handler. If the path is not in its canonical form, the
@@ -1419,34 +1584,63 @@ func (mux *ServeMux) match(path string) (h Handler, pattern string) {
 //
 // If there is no registered handler that applies to the request,
 // Handler returns a ``page not found'' handler and an empty pattern.
+// If patterns match the request's path but not its method, the
+// handler replies with ``method not allowed''.
 func (mux *ServeMux) Handler(r *Request) (h Handler, pattern string) {
+	h, pat, _ := mux.findHandler(r)
+	if pat != nil {
+		pattern = pat.String()
+	}
+	return
+}
+
+// findHandler is the main implementation of Handler. It also
+// returns the values of the wildcards matched by the pattern.
+func (mux *ServeMux) findHandler(r *Request) (h Handler, pat *pattern, matches []string) {
+	path := r.URL.Path
 	if r.Method != "CONNECT" {
-		if p := cleanPath(r.URL.Path); p != r.URL.Path {
-			_, pattern = mux.handler(r.Host, p)
-			return RedirectHandler(p, StatusMovedPermanently), pattern
+		if p := cleanPath(path); p != path {
+			_, pat, _ = mux.handler(r.Host, r.Method, p)
+			return RedirectHandler(p, StatusMovedPermanently), pat, nil
 		}
 	}
-
-	return mux.handler(r.Host, r.URL.Path)
+	return mux.handler(r.Host, r.Method, path)
 }
 
-// handler is the main implementation of Handler.
+// handler finds the handler for a request.
 // The path is known to be in canonical form, except for CONNECT methods.
-func (mux *ServeMux) handler(host, path string) (h Handler, pattern string) {
+func (mux *ServeMux) handler(host, method, path string) (h Handler, pat *pattern, matches []string) {
 	mux.mu.RLock()
 	defer mux.mu.RUnlock()
 
-	// Host-specific pattern takes precedence over generic ones
-	if mux.hosts {
-		h, pattern = mux.match(host + path)
-	}
-	if h == nil {
-		h, pattern = mux.match(path)
+	e, matches, exact := mux.lookup(host, method, path)
+
+	// If the path names a subtree root without its trailing slash,
+	// redirect to the subtree, unless a pattern matches the path
+	// exactly.
+	if !exact && path != "" && !strings.HasSuffix(path, "/") {
+		tree := path + "/"
+		if te, _, texact := mux.lookup(host, method, tree); te != nil && texact {
+			return RedirectHandler(tree, StatusMovedPermanently), te.pat, nil
+		}
 	}
-	if h == nil {
-		h, pattern = NotFoundHandler(), ""
+
+	if e == nil {
+		if allow := mux.allowedMethods(host, path); len(allow) > 0 {
+			return methodNotAllowedHandler(allow), nil, nil
+		}
+		return NotFoundHandler(), nil, nil
 	}
-	return
+	return e.h, e.pat, matches
+}
+
+// methodNotAllowedHandler returns a handler that replies with 405
+// and the given methods in the Allow header.
+func methodNotAllowedHandler(allow []string) Handler {
+	return HandlerFunc(func(w ResponseWriter, r *Request) {
+		w.Header().Set("Allow", strings.Join(allow, ", "))
+		Error(w, "405 method not allowed", StatusMethodNotAllowed)
+	})
 }
 
 // ServeHTTP dispatches the request to the handler whose
@@ -1457,47 +1651,41 @@ func (mux *ServeMux) ServeHTTP(w ResponseWriter, r *Request) {
 		w.WriteHeader(StatusBadRequest)
 		return
 	}
-	h, _ := mux.Handler(r)
+	h, pat, matches := mux.findHandler(r)
+	r.pat, r.matches = pat, matches
 	h.ServeHTTP(w, r)
 }
 
 // Handle registers the handler for the given pattern.
-// If a handler already exists for pattern, Handle panics.
+// If the pattern is invalid, or a handler already exists for the
+// pattern or for one it conflicts with, Handle panics.
 func (mux *ServeMux) Handle(pattern string, handler Handler) {
 	mux.mu.Lock()
 	defer mux.mu.Unlock()
 
-	if pattern == "" {
-		panic("http: invalid pattern " + pattern)
+	pat, err := parsePattern(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("http: invalid pattern %q: %v", pattern, err))
 	}
 	if handler == nil {
 		panic("http: nil handler")
 	}
-	if mux.m[pattern].explicit {
-		panic("http: multiple registrations for " + pattern)
+	for _, e := range mux.es {
+		if !pat.conflictsWith(e.pat) {
+			continue
+		}
+		if pat.comparePathsAndMethods(e.pat) == equivalent {
+			panic("http: multiple registrations for " + pattern)
+		}
+		panic(fmt.Sprintf("http: pattern %q conflicts with pattern %q: %s",
+			pattern, e.pat, describeConflict(pat, e.pat)))
 	}
 
-	mux.m[pattern] = muxEntry{explicit: true, h: handler, pattern: pattern}
+	mux.es = append(mux.es, muxEntry{h: handler, pat: pat})
 
-	if pattern[0] != '/' {
+	if pat.host != "" {
 		mux.hosts = true
 	}
-
-	// Helpful behavior:
-	// If pattern is /tree/, insert an implicit permanent redirect for /tree.
-	// It can be overridden by an explicit registration.
-	n := len(pattern)
-	if n > 0 && pattern[n-1] == '/' && !mux.m[pattern[0:n-1]].explicit {
-		// If pattern contains a host name, strip it and use remaining
-		// path for redirect.
-		path := pattern
-		if pattern[0] != '/' {
-			// In pattern, at least the last character is a '/', so
-			// strings.Index can't be -1.
-			path = pattern[strings.Index(pattern, "/"):]
-		}
-		mux.m[pattern[0:n-1]] = muxEntry{h: RedirectHandler(path, StatusMovedPermanently), pattern: pattern}
-	}
 }
 
 // HandleFunc registers the handler function for the given pattern.
@@ -1535,14 +1723,130 @@ type Server struct {
 	MaxHeaderBytes int           // maximum size of request headers, DefaultMaxHeaderBytes if 0
 	TLSConfig      *tls.Config   // optional TLS config, used by ListenAndServeTLS
 
+	// ReadHeaderTimeout is the amount of time allowed to read
+	// request headers. The connection's read deadline is reset
+	// after reading the headers, so the Handler may enforce its
+	// own deadline on the body. If zero, ReadTimeout is used.
+	// Slow clients can't tie up a connection by trickling in
+	// their headers, even if ReadTimeout is long or zero.
+	ReadHeaderTimeout time.Duration
+
+	// IdleTimeout is the maximum amount of time to wait for the
+	// next request on a keep-alive connection. If zero,
+	// ReadTimeout is used. If both are zero, there is no
+	// timeout.
+	IdleTimeout time.Duration
+
+	// MaxConnsPerIP, if positive, limits the number of
+	// simultaneous connections accepted from a single client IP
+	// address. Further connections from that address are closed
+	// as soon as they are accepted, until some of its existing
+	// connections are closed or hijacked.
+	MaxConnsPerIP int
+
 	// TLSNextProto optionally specifies a function to take over
-	// ownership of the provided TLS connection when an NPN
+	// ownership of the provided TLS connection when an NPN or ALPN
 	// protocol upgrade has occurred.  The map key is the protocol
 	// name negotiated. The Handler argument should be used to
 	// handle HTTP requests and will initialize the Request's TLS
 	// and RemoteAddr if not already set.  The connection is
 	// automatically closed when the function returns.
+	//
+	// If TLSNextProto is nil and EnableHTTP2 is set, HTTP/2
+	// support is enabled for the "h2" protocol.
 	TLSNextProto map[string]func(*Server, *tls.Conn, Handler)
+
+	// EnableHTTP2 enables HTTP/2 support when TLSNextProto is
+	// nil. RFC 7540 requires HTTP/2 connections to use TLS 1.2
+	// and a cipher suite with ephemeral key exchange and AEAD
+	// encryption, which crypto/tls doesn't implement yet, so
+	// HTTP/2 is off by default. Clients that negotiate "h2"
+	// without meeting the requirements get an
+	// INADEQUATE_SECURITY error.
+	EnableHTTP2 bool
+
+	// ConnState specifies an optional callback function that is
+	// called when a client connection changes state. See the
+	// ConnState type and associated constants for details.
+	ConnState func(net.Conn, ConnState)
+
+	disableKeepAlives int32     // accessed atomically.
+	nextProtoOnce     sync.Once // guards initialization of TLSNextProto in Serve
+
+	mu      sync.Mutex
+	h2conns map[*http2serverConn]bool
+	ipConns map[string]int // open connections per client IP, for MaxConnsPerIP
+}
+
+// A ConnState represents the state of a client connection to a server.
+// It's used by the optional Server.ConnState hook.
+type ConnState int
+
+const (
+	// StateNew represents a new connection that is expected to
+	// send a request immediately. Connections begin at this
+	// state and then transition to either StateActive or
+	// StateClosed.
+	StateNew ConnState = iota
+
+	// StateActive represents a connection that has read 1 or more
+	// bytes of a request. The Server.ConnState hook for
+	// StateActive fires before the request has entered a handler
+	// and doesn't fire again until the request has been
+	// handled. After the request is handled, the state
+	// transitions to StateClosed, StateHijacked, or StateIdle.
+	// A connection handed to a TLSNextProto function, such as
+	// an HTTP/2 connection, stays in StateActive until it is
+	// closed.
+	StateActive
+
+	// StateIdle represents a connection that has finished
+	// handling a request and is in the keep-alive state, waiting
+	// for a new request. Connections transition from StateIdle
+	// to either StateActive or StateClosed.
+	StateIdle
+
+	// StateHijacked represents a hijacked connection.
+	// This is a terminal state. It does not transition to StateClosed.
+	StateHijacked
+
+	// StateClosed represents a closed connection.
+	// This is a terminal state. Hijacked connections do not
+	// transition to StateClosed.
+	StateClosed
+)
+
+var stateName = map[ConnState]string{
+	StateNew:      "new",
+	StateActive:   "active",
+	StateIdle:     "idle",
+	StateHijacked: "hijacked",
+	StateClosed:   "closed",
+}
+
+func (c ConnState) String() string {
+	return stateName[c]
+}
+
+func (s *Server) doKeepAlives() bool {
+	return atomic.LoadInt32(&s.disableKeepAlives) == 0
+}
+
+// SetKeepAlivesEnabled controls whether HTTP keep-alives are enabled.
+// By default, keep-alives are always enabled. Only very
+// resource-constrained environments or servers in the process of
+// shutting down should disable them.
+//
+// Disabling keep-alives makes HTTP/1 connections close after their
+// next response and sends a graceful GOAWAY on HTTP/2 connections,
+// letting their active streams complete.
+func (s *Server) SetKeepAlivesEnabled(v bool) {
+	if v {
+		atomic.StoreInt32(&s.disableKeepAlives, 0)
+		return
+	}
+	atomic.StoreInt32(&s.disableKeepAlives, 1)
+	s.shutdownHTTP2Conns()
 }
 
 // serverHandler delegates to either the server's Handler or
@@ -1562,6 +1866,61 @@ func (sh serverHandler) ServeHTTP(rw ResponseWriter, req *Request) {
 	handler.ServeHTTP(rw, req)
 }
 
+func (srv *Server) readHeaderTimeout() time.Duration {
+	if srv.ReadHeaderTimeout != 0 {
+		return srv.ReadHeaderTimeout
+	}
+	return srv.ReadTimeout
+}
+
+func (srv *Server) idleTimeout() time.Duration {
+	if srv.IdleTimeout != 0 {
+		return srv.IdleTimeout
+	}
+	return srv.ReadTimeout
+}
+
+// acquireConnIP counts the new connection rwc against its client
+// IP's MaxConnsPerIP limit. It returns the IP to pass to
+// releaseConnIP once the connection is done, or "" if connections
+// aren't limited, and reports whether rwc is within the limit.
+func (srv *Server) acquireConnIP(rwc net.Conn) (ip string, ok bool) {
+	if srv.MaxConnsPerIP <= 0 {
+		return "", true
+	}
+	ip = remoteIP(rwc.RemoteAddr().String())
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if srv.ipConns[ip] >= srv.MaxConnsPerIP {
+		return "", false
+	}
+	if srv.ipConns == nil {
+		srv.ipConns = make(map[string]int)
+	}
+	srv.ipConns[ip]++
+	return ip, true
+}
+
+func (srv *Server) releaseConnIP(ip string) {
+	if ip == "" {
+		return
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if srv.ipConns[ip]--; srv.ipConns[ip] <= 0 {
+		delete(srv.ipConns, ip)
+	}
+}
+
+// remoteIP returns the IP address part of the network address addr,
+// or addr itself if it has no port.
+func remoteIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
 // ListenAndServe listens on the TCP network address srv.Addr and then
 // calls Serve to handle requests on incoming connections.  If
 // srv.Addr is blank, ":http" is used.
@@ -1582,6 +1941,7 @@ func (srv *Server) ListenAndServe() error {
 // then call srv.Handler to reply to them.
 func (srv *Server) Serve(l net.Listener) error {
 	defer l.Close()
+	srv.setupHTTP2()
 	var tempDelay time.Duration // how long to sleep on accept failure
 	for {
 		rw, e := l.Accept()
@@ -1602,10 +1962,18 @@ func (srv *Server) Serve(l net.Listener) error {
 			return e
 		}
 		tempDelay = 0
+		ip, ok := srv.acquireConnIP(rw)
+		if !ok {
+			rw.Close()
+			continue
+		}
 		c, err := srv.newConn(rw)
 		if err != nil {
+			srv.releaseConnIP(ip)
 			continue
 		}
+		c.limitIP = ip
+		c.setState(c.rwc, StateNew) // before Serve can return
 		go c.serve()
 	}
 }
@@ -1683,6 +2051,10 @@ func ListenAndServeTLS(addr string, certFile string, keyFile string, handler Han
 // certificate authority, the certFile should be the concatenation
 // of the server's certificate followed by the CA's certificate.
 //
+// Unless srv.TLSConfig sets NextProtos, the server offers HTTP/1.1 to
+// clients, and HTTP/2 as well if srv.EnableHTTP2 is set, as described
+// by the TLSNextProto field.
+//
 // If srv.Addr is blank, ":https" is used.
 func (srv *Server) ListenAndServeTLS(certFile, keyFile string) error {
 	addr := srv.Addr
@@ -1693,8 +2065,12 @@ func (srv *Server) ListenAndServeTLS(certFile, keyFile string) error {
 	if srv.TLSConfig != nil {
 		*config = *srv.TLSConfig
 	}
+	srv.setupHTTP2()
 	if config.NextProtos == nil {
 		config.NextProtos = []string{"http/1.1"}
+		if srv.TLSNextProto[http2NextProtoTLS] != nil {
+			config.NextProtos = []string{http2NextProtoTLS, "http/1.1"}
+		}
 	}
 
 	var err error
@@ -12,6 +12,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/textproto"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -49,7 +50,12 @@ func newTransferWriter(r interface{}) (t *transferWriter, err error) {
 		t.Trailer = rr.Trailer
 		atLeastHTTP11 = rr.ProtoAtLeast(1, 1)
 		if t.Body != nil && len(t.TransferEncoding) == 0 && atLeastHTTP11 {
-			if t.ContentLength == 0 {
+			if t.ContentLength == 0 && len(t.Trailer) > 0 {
+				// Trailers can only be sent with a chunked
+				// body, so don't bother checking whether it's
+				// empty.
+				t.ContentLength = -1
+			} else if t.ContentLength == 0 {
 				// Test to see if it's actually zero or just unset.
 				var buf [1]byte
 				n, _ := io.ReadFull(t.Body, buf[:])
@@ -157,23 +163,21 @@ func (t *transferWriter) WriteHeader(w io.Writer) (err error) {
 
 	// Write Trailer header
 	if t.Trailer != nil {
-		// TODO: At some point, there should be a generic mechanism for
-		// writing long headers, using HTTP line splitting
-		io.WriteString(w, "Trailer: ")
-		needComma := false
+		keys := make([]string, 0, len(t.Trailer))
 		for k := range t.Trailer {
 			k = CanonicalHeaderKey(k)
 			switch k {
 			case "Transfer-Encoding", "Trailer", "Content-Length":
 				return &badStringError{"invalid Trailer key", k}
 			}
-			if needComma {
-				io.WriteString(w, ",")
-			}
-			io.WriteString(w, k)
-			needComma = true
+			keys = append(keys, k)
+		}
+		if len(keys) > 0 {
+			sort.Strings(keys)
+			// TODO: At some point, there should be a generic mechanism for
+			// writing long headers, using HTTP line splitting
+			_, err = io.WriteString(w, "Trailer: "+strings.Join(keys, ",")+"\r\n")
 		}
-		_, err = io.WriteString(w, "\r\n")
 	}
 
 	return
@@ -214,9 +218,15 @@ func (t *transferWriter) WriteBody(w io.Writer) (err error) {
 			t.ContentLength, ncopy)
 	}
 
-	// TODO(petar): Place trailer writer code here.
 	if chunked(t.TransferEncoding) {
-		// Last chunk, empty trailer
+		// The trailer values may have been set while the body
+		// was read, so they're only looked at now.
+		if t.Trailer != nil {
+			if err = t.Trailer.Write(w); err != nil {
+				return err
+			}
+		}
+		// Last chunk's trailer terminator
 		_, err = io.WriteString(w, "\r\n")
 	}
 
@@ -497,7 +507,7 @@ func fixTrailer(header Header, te []string) (Header, error) {
 		case "Transfer-Encoding", "Trailer", "Content-Length":
 			return nil, &badStringError{"bad trailer key", key}
 		}
-		trailer.Del(key)
+		trailer[key] = nil
 	}
 	if len(trailer) == 0 {
 		return nil, nil
@@ -609,13 +619,25 @@ func (b *body) readTrailer() error {
 	}
 	switch rr := b.hdr.(type) {
 	case *Request:
-		rr.Trailer = Header(hdr)
+		mergeSetHeader(&rr.Trailer, Header(hdr))
 	case *Response:
-		rr.Trailer = Header(hdr)
+		mergeSetHeader(&rr.Trailer, Header(hdr))
 	}
 	return nil
 }
 
+// mergeSetHeader sets the values of src in *dst, so that a Trailer
+// map declared up front gets its values in place.
+func mergeSetHeader(dst *Header, src Header) {
+	if *dst == nil {
+		*dst = src
+		return
+	}
+	for k, vv := range src {
+		(*dst)[k] = vv
+	}
+}
+
 func (b *body) Close() error {
 	if b.closed {
 		return nil
@@ -19,6 +19,7 @@ import (
 	"io"
 	"log"
 	"net"
+	"net/http/httptrace"
 	"net/url"
 	"os"
 	"strings"
@@ -48,6 +49,11 @@ type Transport struct {
 	reqConn    map[*Request]*persistConn
 	altMu      sync.RWMutex
 	altProto   map[string]RoundTripper // nil or map of URI scheme => RoundTripper
+	h2mu       sync.Mutex
+	h2conns    map[string][]*http2clientConn // keyed by connectMethod.key()
+	h2refused  map[string]bool               // authorities not to offer HTTP/2 to
+
+	nextProtoOnce sync.Once // guards initialization of TLSNextProto
 
 	// Proxy specifies a function to return a proxy for a given
 	// Request. If the function returns a non-nil error, the
@@ -89,6 +95,26 @@ type Transport struct {
 	// time does not include the time to read the response body.
 	ResponseHeaderTimeout time.Duration
 
+	// TLSNextProto specifies how the Transport switches to an
+	// alternate protocol (such as HTTP/2) after a TLS connection
+	// negotiates it using ALPN or NPN. The map key is the
+	// protocol name, and the function is called with the
+	// request's authority and the TLS connection; the returned
+	// RoundTripper then handles the requests sent on that
+	// connection. If TLSNextProto is nil and EnableHTTP2 is
+	// set, HTTP/2 is enabled.
+	TLSNextProto map[string]func(authority string, c *tls.Conn) RoundTripper
+
+	// EnableHTTP2 enables HTTP/2 when TLSNextProto is nil.
+	// HTTP/2 is never enabled otherwise, as RFC 7540 requires
+	// HTTP/2 connections to use TLS 1.2 and a cipher suite with
+	// ephemeral key exchange and AEAD encryption, which
+	// crypto/tls doesn't implement yet. If a server picks HTTP/2
+	// for a connection that doesn't meet the requirements, the
+	// Transport refuses it and uses HTTP/1.1 for that server from
+	// then on.
+	EnableHTTP2 bool
+
 	// TODO: tunable on global max cached connections
 	// TODO: tunable on timeout on cached connections
 }
@@ -170,22 +196,32 @@ func (t *Transport) RoundTrip(req *Request) (resp *Response, err error) {
 	if req.URL.Host == "" {
 		return nil, errors.New("http: no Host in request URL")
 	}
+	t.setupHTTP2()
 	treq := &transportRequest{Request: req}
 	cm, err := t.connectMethodForRequest(treq)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the cached or newly-created connection to either the
-	// host (for http or https), the http proxy, or the http proxy
-	// pre-CONNECTed to https server.  In any case, we'll be ready
-	// to send it requests.
-	pconn, err := t.getConn(cm)
-	if err != nil {
-		return nil, err
+	for {
+		// Get the cached or newly-created connection to either the
+		// host (for http or https), the http proxy, or the http proxy
+		// pre-CONNECTed to https server.  In any case, we'll be ready
+		// to send it requests.
+		pconn, err := t.getConn(req, cm)
+		if err != nil {
+			return nil, err
+		}
+		if pconn.alt == nil {
+			return pconn.roundTrip(treq)
+		}
+		resp, err = pconn.alt.RoundTrip(req)
+		if err != errHTTP2RetryRequest {
+			return resp, err
+		}
+		// The HTTP/2 connection went away before the server
+		// processed req; try again on another one.
 	}
-
-	return pconn.roundTrip(treq)
 }
 
 // RegisterProtocol registers a new protocol with scheme.
@@ -214,6 +250,7 @@ func (t *Transport) RegisterProtocol(scheme string, rt RoundTripper) {
 // a "keep-alive" state. It does not interrupt any connections currently
 // in use.
 func (t *Transport) CloseIdleConnections() {
+	t.closeIdleHTTP2Conns()
 	t.idleMu.Lock()
 	m := t.idleConn
 	t.idleConn = nil
@@ -230,14 +267,17 @@ func (t *Transport) CloseIdleConnections() {
 }
 
 // CancelRequest cancels an in-flight request by closing its
-// connection.
+// connection. Requests sent over HTTP/2 are canceled by resetting
+// their stream instead.
 func (t *Transport) CancelRequest(req *Request) {
 	t.reqMu.Lock()
 	pc := t.reqConn[req]
 	t.reqMu.Unlock()
 	if pc != nil {
 		pc.conn.Close()
+		return
 	}
+	t.cancelHTTP2Request(req)
 }
 
 //
@@ -283,6 +323,10 @@ func (cm *connectMethod) proxyAuth() string {
 // If pconn is no longer needed or not in a good state, putIdleConn
 // returns false.
 func (t *Transport) putIdleConn(pconn *persistConn) bool {
+	if pconn.alt != nil {
+		// Pooled separately, in t.h2conns.
+		return false
+	}
 	if t.DisableKeepAlives || t.MaxIdleConnsPerHost < 0 {
 		pconn.close()
 		return false
@@ -322,6 +366,7 @@ func (t *Transport) putIdleConn(pconn *persistConn) bool {
 		pconn.close()
 		return false
 	}
+	pconn.idleAt = time.Now()
 	for _, exist := range t.idleConn[key] {
 		if exist == pconn {
 			log.Fatalf("dup idle pconn %p in freelist", pconn)
@@ -393,19 +438,73 @@ func (t *Transport) setReqConn(r *Request, pc *persistConn) {
 	}
 }
 
-func (t *Transport) dial(network, addr string) (c net.Conn, err error) {
+func (t *Transport) dial(network, addr string, trace *httptrace.ClientTrace) (c net.Conn, err error) {
+	if t.Dial != nil || trace == nil || trace.DNSStart == nil && trace.DNSDone == nil {
+		return t.dialTrace(network, addr, trace)
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil || net.ParseIP(host) != nil {
+		return t.dialTrace(network, addr, trace)
+	}
+
+	// Resolve the name here so that the lookup can be traced, but
+	// the way net.Dial does, so that the same address is dialed.
+	if trace.DNSStart != nil {
+		trace.DNSStart(httptrace.DNSStartInfo{Host: host})
+	}
+	raddr, err := net.ResolveTCPAddr(network, addr)
+	if trace.DNSDone != nil {
+		info := httptrace.DNSDoneInfo{Err: err}
+		if err == nil {
+			info.Addrs = []net.IP{raddr.IP}
+		}
+		trace.DNSDone(info)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t.dialTrace(network, raddr.String(), trace)
+}
+
+// dialTrace dials addr, calling trace's connect hooks if trace is
+// non-nil.
+func (t *Transport) dialTrace(network, addr string, trace *httptrace.ClientTrace) (c net.Conn, err error) {
+	if trace != nil && trace.ConnectStart != nil {
+		trace.ConnectStart(network, addr)
+	}
 	if t.Dial != nil {
-		return t.Dial(network, addr)
+		c, err = t.Dial(network, addr)
+	} else {
+		c, err = net.Dial(network, addr)
+	}
+	if trace != nil && trace.ConnectDone != nil {
+		trace.ConnectDone(network, addr, err)
 	}
-	return net.Dial(network, addr)
+	return c, err
 }
 
 // getConn dials and creates a new persistConn to the target as
 // specified in the connectMethod.  This includes doing a proxy CONNECT
 // and/or setting up TLS.  If this doesn't return an error, the persistConn
 // is ready to write requests to.
-func (t *Transport) getConn(cm *connectMethod) (*persistConn, error) {
+func (t *Transport) getConn(req *Request, cm *connectMethod) (*persistConn, error) {
+	trace := req.Trace
+	if trace != nil && trace.GetConn != nil {
+		trace.GetConn(cm.addr())
+	}
+	if cm.targetScheme == "https" {
+		if cc := t.getHTTP2Conn(cm.key()); cc != nil {
+			traceGotConn(trace, httptrace.GotConnInfo{Conn: cc.tconn, Reused: true})
+			return &persistConn{t: t, cacheKey: cm.key(), alt: cc}, nil
+		}
+	}
 	if pc := t.getIdleConn(cm); pc != nil {
+		traceGotConn(trace, httptrace.GotConnInfo{
+			Conn:     pc.conn,
+			Reused:   true,
+			WasIdle:  true,
+			IdleTime: time.Since(pc.idleAt),
+		})
 		return pc, nil
 	}
 
@@ -415,7 +514,7 @@ func (t *Transport) getConn(cm *connectMethod) (*persistConn, error) {
 	}
 	dialc := make(chan dialRes)
 	go func() {
-		pc, err := t.dialConn(cm)
+		pc, err := t.dialConn(cm, trace)
 		dialc <- dialRes{pc, err}
 	}()
 
@@ -423,6 +522,18 @@ func (t *Transport) getConn(cm *connectMethod) (*persistConn, error) {
 	select {
 	case v := <-dialc:
 		// Our dial finished.
+		if v.err == nil {
+			conn := v.pc.conn
+			if cc, ok := v.pc.alt.(*http2clientConn); ok {
+				conn = cc.tconn
+			}
+			// Other alternate RoundTrippers, such as the one
+			// left by a failed HTTP/2 setup, have no connection
+			// to report.
+			if conn != nil {
+				traceGotConn(trace, httptrace.GotConnInfo{Conn: conn})
+			}
+		}
 		return v.pc, v.err
 	case pc := <-idleConnCh:
 		// Another request finished first and its net.Conn
@@ -435,12 +546,19 @@ func (t *Transport) getConn(cm *connectMethod) (*persistConn, error) {
 				t.putIdleConn(v.pc)
 			}
 		}()
+		traceGotConn(trace, httptrace.GotConnInfo{Conn: pc.conn, Reused: true})
 		return pc, nil
 	}
 }
 
-func (t *Transport) dialConn(cm *connectMethod) (*persistConn, error) {
-	conn, err := t.dial("tcp", cm.addr())
+func traceGotConn(trace *httptrace.ClientTrace, info httptrace.GotConnInfo) {
+	if trace != nil && trace.GotConn != nil {
+		trace.GotConn(info)
+	}
+}
+
+func (t *Transport) dialConn(cm *connectMethod, trace *httptrace.ClientTrace) (*persistConn, error) {
+	conn, err := t.dial("tcp", cm.addr(), trace)
 	if err != nil {
 		if cm.proxyURL != nil {
 			err = fmt.Errorf("http: error connecting to proxy %s: %v", cm.proxyURL, err)
@@ -510,16 +628,39 @@ func (t *Transport) dialConn(cm *connectMethod) (*persistConn, error) {
 				cfg = &clone
 			}
 		}
-		conn = tls.Client(conn, cfg)
-		if err = conn.(*tls.Conn).Handshake(); err != nil {
+		if len(cfg.NextProtos) == 0 {
+			if protos := t.tlsNextProtos(cm.targetAddr); protos != nil {
+				clone := *cfg
+				clone.NextProtos = protos
+				cfg = &clone
+			}
+		}
+		tlsConn := tls.Client(conn, cfg)
+		if trace != nil && trace.TLSHandshakeStart != nil {
+			trace.TLSHandshakeStart()
+		}
+		err = tlsConn.Handshake()
+		if trace != nil && trace.TLSHandshakeDone != nil {
+			trace.TLSHandshakeDone(tlsConn.ConnectionState(), err)
+		}
+		if err != nil {
 			return nil, err
 		}
 		if !cfg.InsecureSkipVerify {
-			if err = conn.(*tls.Conn).VerifyHostname(cfg.ServerName); err != nil {
+			if err = tlsConn.VerifyHostname(cfg.ServerName); err != nil {
 				return nil, err
 			}
 		}
-		pconn.conn = conn
+		if s := tlsConn.ConnectionState(); s.NegotiatedProtocolIsMutual {
+			if next, ok := t.TLSNextProto[s.NegotiatedProtocol]; ok {
+				alt := next(cm.targetAddr, tlsConn)
+				if cc, ok := alt.(*http2clientConn); ok {
+					t.addHTTP2Conn(cm.key(), cc)
+				}
+				return &persistConn{t: t, cacheKey: cm.key(), alt: alt}, nil
+			}
+		}
+		pconn.conn = tlsConn
 	}
 
 	pconn.br = bufio.NewReader(pconn.conn)
@@ -649,6 +790,12 @@ type persistConn struct {
 	writech  chan writeRequest   // written by roundTrip; read by writeLoop
 	closech  chan struct{}       // broadcast close when readLoop (TCP connection) closes
 	isProxy  bool
+	idleAt   time.Time // when it was last added to the idle pool; guarded by Transport.idleMu
+
+	// alt, if non-nil, handles the requests for this connection
+	// instead, after TLSNextProto switched it to another protocol.
+	// The other fields are then unused.
+	alt RoundTripper
 
 	lk                   sync.Mutex // guards following 3 fields
 	numExpectedResponses int
@@ -698,6 +845,9 @@ func (pc *persistConn) readLoop() {
 		pc.lk.Unlock()
 
 		rc := <-pc.reqch
+		if trace := rc.req.Trace; err == nil && trace != nil && trace.GotFirstResponseByte != nil {
+			trace.GotFirstResponseByte()
+		}
 
 		var resp *Response
 		if err == nil {
@@ -711,6 +861,16 @@ func (pc *persistConn) readLoop() {
 				resp, err = ReadResponse(pc.br, rc.req)
 			}
 		}
+		if err == nil && resp.StatusCode == StatusSwitchingProtocols {
+			// The connection now speaks another protocol and
+			// belongs to the caller, who uses it through the
+			// response body. It's never reused or closed here.
+			pc.markBroken()
+			resp.Body = &readWriteCloserBody{br: pc.br, ReadWriteCloser: pc.conn}
+			pc.t.setReqConn(rc.req, nil)
+			rc.ch <- responseAndError{resp, nil}
+			return
+		}
 		hasBody := resp != nil && rc.req.Method != "HEAD" && resp.ContentLength != 0
 
 		if err != nil {
@@ -793,13 +953,16 @@ func (pc *persistConn) writeLoop() {
 				wr.ch <- errors.New("http: can't write HTTP request on broken connection")
 				continue
 			}
-			err := wr.req.Request.write(pc.bw, pc.isProxy, wr.req.extra)
+			err := wr.req.Request.write(pc.bw, pc.isProxy, wr.req.extra, wr.req.Trace)
 			if err == nil {
 				err = pc.bw.Flush()
 			}
 			if err != nil {
 				pc.markBroken()
 			}
+			if trace := wr.req.Trace; trace != nil && trace.WroteRequest != nil {
+				trace.WroteRequest(httptrace.WroteRequestInfo{Err: err})
+			}
 			wr.ch <- err
 		case <-pc.closech:
 			return
@@ -807,6 +970,27 @@ func (pc *persistConn) writeLoop() {
 	}
 }
 
+// readWriteCloserBody is the Response.Body of a 101 Switching
+// Protocols response. It reads whatever the connection's bufio.Reader
+// has already buffered before reading from the connection itself.
+type readWriteCloserBody struct {
+	br *bufio.Reader // nil once drained
+	io.ReadWriteCloser
+}
+
+func (b *readWriteCloserBody) Read(p []byte) (n int, err error) {
+	if b.br != nil {
+		if n := b.br.Buffered(); n > 0 {
+			if len(p) > n {
+				p = p[:n]
+			}
+			return b.br.Read(p)
+		}
+		b.br = nil
+	}
+	return b.ReadWriteCloser.Read(p)
+}
+
 type responseAndError struct {
 	res *Response
 	err error
@@ -11,6 +11,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"crypto/rand"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -18,9 +19,12 @@ import (
 	"net/http"
 	. "net/http"
 	"net/http/httptest"
+	"net/http/httptrace"
 	"net/url"
 	"os"
+	"reflect"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -1648,6 +1652,167 @@ func (cr countCloseReader) Close() error {
 }
 
 // rgz is a gzip quine that uncompresses to itself.
+// traceRecorder records the names of the ClientTrace events it sees.
+type traceRecorder struct {
+	mu     sync.Mutex
+	events []string
+	conn   httptrace.GotConnInfo
+	tls    *tls.ConnectionState
+}
+
+func (r *traceRecorder) add(format string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, fmt.Sprintf(format, args...))
+}
+
+func (r *traceRecorder) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GetConn: func(hostPort string) { r.add("GetConn") },
+		GotConn: func(info httptrace.GotConnInfo) {
+			r.add("GotConn")
+			r.mu.Lock()
+			r.conn = info
+			r.mu.Unlock()
+		},
+		DNSStart:     func(info httptrace.DNSStartInfo) { r.add("DNSStart %s", info.Host) },
+		DNSDone:      func(info httptrace.DNSDoneInfo) { r.add("DNSDone %v", info.Err) },
+		ConnectStart: func(network, addr string) { r.add("ConnectStart") },
+		ConnectDone:  func(network, addr string, err error) { r.add("ConnectDone %v", err) },
+		TLSHandshakeStart: func() {
+			r.add("TLSHandshakeStart")
+		},
+		TLSHandshakeDone: func(cs tls.ConnectionState, err error) {
+			r.add("TLSHandshakeDone %v", err)
+			r.mu.Lock()
+			r.tls = &cs
+			r.mu.Unlock()
+		},
+		WroteHeaders:         func() { r.add("WroteHeaders") },
+		WroteRequest:         func(info httptrace.WroteRequestInfo) { r.add("WroteRequest %v", info.Err) },
+		GotFirstResponseByte: func() { r.add("GotFirstResponseByte") },
+	}
+}
+
+// get does a traced GET of url with c, and returns the events seen,
+// sorted to remove the nondeterminism between WroteRequest and
+// GotFirstResponseByte.
+func (r *traceRecorder) get(t *testing.T, c *Client, url string) []string {
+	r.mu.Lock()
+	r.events = nil
+	r.mu.Unlock()
+	req, _ := NewRequest("GET", url, nil)
+	req.Trace = r.trace()
+	res, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	events := append([]string(nil), r.events...)
+	sort.Strings(events)
+	return events
+}
+
+func TestTransportTrace(t *testing.T) {
+	defer afterTest(t)
+	ts := httptest.NewServer(HandlerFunc(func(w ResponseWriter, r *Request) {
+		io.WriteString(w, "hello")
+	}))
+	defer ts.Close()
+	tr := &Transport{}
+	defer tr.CloseIdleConnections()
+	c := &Client{Transport: tr}
+
+	// Use a name rather than the IP address, so that there's a
+	// lookup to trace.
+	_, port, _ := net.SplitHostPort(ts.Listener.Addr().String())
+	url := "http://localhost:" + port + "/"
+
+	var r traceRecorder
+	got := r.get(t, c, url)
+	want := []string{
+		"ConnectDone <nil>",
+		"ConnectStart",
+		"DNSDone <nil>",
+		"DNSStart localhost",
+		"GetConn",
+		"GotConn",
+		"GotFirstResponseByte",
+		"WroteHeaders",
+		"WroteRequest <nil>",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("first request events:\n got %q\nwant %q", got, want)
+	}
+	if r.conn.Reused || r.conn.WasIdle || r.conn.Conn == nil {
+		t.Errorf("first request GotConnInfo = %+v; want a new connection", r.conn)
+	}
+
+	got = r.get(t, c, url)
+	want = []string{
+		"GetConn",
+		"GotConn",
+		"GotFirstResponseByte",
+		"WroteHeaders",
+		"WroteRequest <nil>",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("second request events:\n got %q\nwant %q", got, want)
+	}
+	if !r.conn.Reused || !r.conn.WasIdle {
+		t.Errorf("second request GotConnInfo = %+v; want an idle, reused connection", r.conn)
+	}
+}
+
+func TestTransportTraceTLS(t *testing.T) {
+	defer afterTest(t)
+	ts := httptest.NewTLSServer(HandlerFunc(func(w ResponseWriter, r *Request) {}))
+	defer ts.Close()
+	tr := &Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	defer tr.CloseIdleConnections()
+
+	var r traceRecorder
+	got := r.get(t, &Client{Transport: tr}, ts.URL)
+	want := []string{
+		"ConnectDone <nil>",
+		"ConnectStart",
+		"GetConn",
+		"GotConn",
+		"GotFirstResponseByte",
+		"TLSHandshakeDone <nil>",
+		"TLSHandshakeStart",
+		"WroteHeaders",
+		"WroteRequest <nil>",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events:\n got %q\nwant %q", got, want)
+	}
+	if r.tls == nil || !r.tls.HandshakeComplete {
+		t.Errorf("TLSHandshakeDone state = %+v; want a complete handshake", r.tls)
+	}
+}
+
+// Request.Write is not a Transport round trip, so it must not
+// report trace events.
+func TestRequestWriteNoTrace(t *testing.T) {
+	var r traceRecorder
+	req, _ := NewRequest("GET", "http://example.com/", nil)
+	req.Trace = r.trace()
+	var buf bytes.Buffer
+	if err := req.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := req.WriteProxy(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.events) != 0 {
+		t.Errorf("events = %q; want none", r.events)
+	}
+}
+
 var rgz = []byte{
 	0x1f, 0x8b, 0x08, 0x08, 0x00, 0x00, 0x00, 0x00,
 	0x00, 0x00, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
@@ -150,12 +150,12 @@ notetsleep(Note *n, int64 ns, int64 deadline, int64 now)
 	if(runtime·atomicload((uint32*)&n->key) != 0)
 		return true;
 
-	deadline = runtime·nanotime() + ns;
+	deadline = runtime·monotime() + ns;
 	for(;;) {
 		runtime·futexsleep((uint32*)&n->key, 0, ns);
 		if(runtime·atomicload((uint32*)&n->key) != 0)
 			break;
-		now = runtime·nanotime();
+		now = runtime·monotime();
 		if(now >= deadline)
 			break;
 		ns = deadline - now;
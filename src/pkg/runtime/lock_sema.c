@@ -184,7 +184,7 @@ notetsleep(Note *n, int64 ns, int64 deadline, M *mp)
 		return true;
 	}
 
-	deadline = runtime·nanotime() + ns;
+	deadline = runtime·monotime() + ns;
 	for(;;) {
 		// Registered.  Sleep.
 		if(runtime·semasleep(ns) >= 0) {
@@ -194,7 +194,7 @@ notetsleep(Note *n, int64 ns, int64 deadline, M *mp)
 		}
 
 		// Interrupted or timed out.  Still registered.  Semaphore not acquired.
-		ns = deadline - runtime·nanotime();
+		ns = deadline - runtime·monotime();
 		if(ns <= 0)
 			break;
 		// Deadline hasn't arrived.  Keep sleeping.
@@ -25,6 +25,8 @@
 #pragma dynimport runtime·GetThreadContext GetThreadContext "kernel32.dll"
 #pragma dynimport runtime·LoadLibrary LoadLibraryW "kernel32.dll"
 #pragma dynimport runtime·LoadLibraryA LoadLibraryA "kernel32.dll"
+#pragma dynimport runtime·QueryPerformanceCounter QueryPerformanceCounter "kernel32.dll"
+#pragma dynimport runtime·QueryPerformanceFrequency QueryPerformanceFrequency "kernel32.dll"
 #pragma dynimport runtime·ResumeThread ResumeThread "kernel32.dll"
 #pragma dynimport runtime·SetConsoleCtrlHandler SetConsoleCtrlHandler "kernel32.dll"
 #pragma dynimport runtime·SetEvent SetEvent "kernel32.dll"
@@ -57,6 +59,8 @@ extern void *runtime·GetSystemTimeAsFileTime;
 extern void *runtime·GetThreadContext;
 extern void *runtime·LoadLibrary;
 extern void *runtime·LoadLibraryA;
+extern void *runtime·QueryPerformanceCounter;
+extern void *runtime·QueryPerformanceFrequency;
 extern void *runtime·ResumeThread;
 extern void *runtime·SetConsoleCtrlHandler;
 extern void *runtime·SetEvent;
@@ -68,6 +72,9 @@ extern void *runtime·timeBeginPeriod;
 extern void *runtime·WaitForSingleObject;
 extern void *runtime·WriteFile;
 
+// Performance counter ticks per second, set by osinit.
+static int64 qpcfreq;
+
 static int32
 getproccount(void)
 {
@@ -89,6 +96,7 @@ runtime·osinit(void)
 		(uintptr)0, (uintptr)0, (uintptr)DUPLICATE_SAME_ACCESS);
 	runtime·stdcall(runtime·SetConsoleCtrlHandler, 2, runtime·ctrlhandler, (uintptr)1);
 	runtime·stdcall(runtime·timeBeginPeriod, 1, (uintptr)1);
+	runtime·stdcall(runtime·QueryPerformanceFrequency, 1, &qpcfreq);
 	runtime·ncpu = getproccount();
 
 	kernel32 = runtime·stdcall(runtime·LoadLibraryA, 1, "kernel32.dll");
@@ -264,6 +272,17 @@ runtime·nanotime(void)
 	return (filetime - 116444736000000000LL) * 100LL;
 }
 
+#pragma textflag 7
+int64
+runtime·monotime(void)
+{
+	int64 count;
+
+	runtime·stdcall(runtime·QueryPerformanceCounter, 1, &count);
+	// Split the conversion to nanoseconds to avoid overflow.
+	return count/qpcfreq*1000000000LL + count%qpcfreq*1000000000LL/qpcfreq;
+}
+
 void
 time·now(int64 sec, int32 usec)
 {
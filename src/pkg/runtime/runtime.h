@@ -835,6 +835,7 @@ G*	runtime·newproc1(FuncVal*, byte*, int32, int32, void*);
 bool	runtime·sigsend(int32 sig);
 int32	runtime·callers(int32, uintptr*, int32);
 int64	runtime·nanotime(void);
+int64	runtime·monotime(void);
 void	runtime·dopanic(int32);
 void	runtime·startpanic(void);
 void	runtime·unwindstack(G*, byte*);
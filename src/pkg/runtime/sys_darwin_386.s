@@ -210,6 +210,59 @@ TEXT runtime·nanotime(SB),7,$0
 	MOVL	DX, 4(DI)
 	RET
 
+// int64 monotime(void) so really
+// void monotime(int64 *nsec)
+// Like now, but without the gtod terms: the commpage nanotime,
+// which is what mach_absolute_time reports, never steps.
+TEXT runtime·monotime(SB),7,$20
+	MOVL	$0xffff0000, BP /* comm page base */
+	MOVL	cpu_capabilities(BP), AX
+	TESTL	$0x4000, AX
+	JNZ	mtslow
+mtloop:
+	MOVL	nt_generation(BP), CX
+	TESTL	CX, CX
+	JZ	mtloop
+	RDTSC
+	MOVL	nt_tsc_base(BP), SI
+	MOVL	(nt_tsc_base+4)(BP), DI
+	MOVL	SI, 0(SP)
+	MOVL	DI, 4(SP)
+	MOVL	nt_scale(BP), SI
+	MOVL	SI, 8(SP)
+	MOVL	nt_ns_base(BP), SI
+	MOVL	(nt_ns_base+4)(BP), DI
+	MOVL	SI, 12(SP)
+	MOVL	DI, 16(SP)
+	CMPL	nt_generation(BP), CX
+	JNE	mtloop
+
+	//	((tsc - nt_tsc_base) * nt_scale) >> 32 + nt_ns_base
+	// computed as in now.
+	SUBL	0(SP), AX
+	SBBL	4(SP), DX
+	MOVL	DX, CX
+	MOVL	$0, DX
+	MULL	8(SP)
+	MOVL	DX, SI
+	MOVL	CX, AX
+	MOVL	$0, DX
+	MULL	8(SP)
+	ADDL	SI, AX
+	ADCL	$0, DX
+	ADDL	12(SP), AX
+	ADCL	16(SP), DX
+	JMP	mtret
+mtslow:
+	// The slow CPU math is unimplemented, as in now;
+	// settle for the wall clock.
+	CALL	runtime·now(SB)
+mtret:
+	MOVL	ret+0(FP), DI
+	MOVL	AX, 0(DI)
+	MOVL	DX, 4(DI)
+	RET
+
 TEXT runtime·sigprocmask(SB),7,$0
 	MOVL	$329, AX  // pthread_sigmask (on OS X, sigprocmask==entire process)
 	INT	$0x80
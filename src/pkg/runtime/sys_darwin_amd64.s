@@ -148,6 +148,31 @@ systime:
 	ADDQ	DX, AX
 	RET
 
+// int64 monotime(void)
+// Same as nanotime but without the gtod terms: the commpage nanotime,
+// which is what mach_absolute_time reports, never steps.
+TEXT runtime·monotime(SB), 7, $0
+	MOVQ	$0x7fffffe00000, BP	/* comm page base */
+mtloop:
+	MOVL	nt_generation(BP), R9
+	TESTL	R9, R9
+	JZ	mtloop
+	RDTSC
+	MOVQ	nt_tsc_base(BP), R10
+	MOVL	nt_scale(BP), R11
+	MOVQ	nt_ns_base(BP), R12
+	CMPL	nt_generation(BP), R9
+	JNE	mtloop
+
+	//	((tsc - nt_tsc_base) * nt_scale) >> 32 + nt_ns_base
+	SHLQ	$32, DX
+	ADDQ	DX, AX
+	SUBQ	R10, AX
+	MULQ	R11
+	SHRQ	$32, AX:DX
+	ADDQ	R12, AX
+	RET
+
 // func now() (sec int64, nsec int32)
 TEXT time·now(SB),7,$0
 	CALL	runtime·nanotime(SB)
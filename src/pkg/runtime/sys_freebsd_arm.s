@@ -139,6 +139,28 @@ TEXT runtime·nanotime(SB), 7, $32
 	MOVW R1, 4(R3)
 	RET
 
+// int64 monotime(void) so really
+// void monotime(int64 *nsec)
+TEXT runtime·monotime(SB), 7, $32
+	MOVW $4, R0 // CLOCK_MONOTONIC
+	MOVW $8(R13), R1
+	SWI $232 // clock_gettime
+
+	MOVW 8(R13), R0 // sec.low
+	MOVW 12(R13), R4 // sec.high
+	MOVW 16(R13), R2 // nsec
+
+	MOVW $1000000000, R3
+	MULLU R0, R3, (R1, R0)
+	MUL R3, R4
+	ADD.S R2, R0
+	ADC R4, R1
+
+	MOVW 0(FP), R3
+	MOVW R0, 0(R3)
+	MOVW R1, 4(R3)
+	RET
+
 TEXT runtime·sigaction(SB),7,$-8
 	MOVW 0(FP), R0		// arg 1 sig
 	MOVW 4(FP), R1		// arg 2 act
@@ -141,6 +141,31 @@ TEXT runtime·nanotime(SB), 7, $32
 	MOVL	DX, 4(DI)
 	RET
 
+// int64 monotime(void) so really
+// void monotime(int64 *nsec)
+// Like nanotime, but reads CLOCK_MONOTONIC, which is not
+// affected by adjustments to the system clock.
+TEXT runtime·monotime(SB), 7, $32
+	MOVL	$265, AX			// syscall - clock_gettime
+	MOVL	$1, BX				// CLOCK_MONOTONIC
+	LEAL	8(SP), CX
+	MOVL	$0, DX
+	CALL	*runtime·_vdso(SB)
+	MOVL	8(SP), AX	// sec
+	MOVL	12(SP), BX	// nsec
+
+	// sec is in AX, nsec in BX
+	// convert to DX:AX nsec
+	MOVL	$1000000000, CX
+	MULL	CX
+	ADDL	BX, AX
+	ADCL	$0, DX
+
+	MOVL	ret+0(FP), DI
+	MOVL	AX, 0(DI)
+	MOVL	DX, 4(DI)
+	RET
+
 TEXT runtime·rtsigprocmask(SB),7,$0
 	MOVL	$175, AX		// syscall entry
 	MOVL	4(SP), BX
@@ -159,6 +159,34 @@ fallback_gtod_nt:
 	ADDQ	DX, AX
 	RET
 
+// int64 monotime(void)
+// Like nanotime, but reads CLOCK_MONOTONIC, which is not
+// affected by adjustments to the system clock.
+TEXT runtime·monotime(SB),7,$16
+	MOVQ	runtime·__vdso_clock_gettime_sym(SB), AX
+	CMPQ	AX, $0
+	JEQ	fallback_mt
+	MOVL	$1, DI // CLOCK_MONOTONIC
+	LEAQ	0(SP), SI
+	CALL	AX
+	MOVQ	0(SP), AX	// sec
+	MOVQ	8(SP), DX	// nsec
+	// sec is in AX, nsec in DX
+	// return nsec in AX
+	IMULQ	$1000000000, AX
+	ADDQ	DX, AX
+	RET
+fallback_mt:
+	MOVL	$1, DI // CLOCK_MONOTONIC
+	LEAQ	0(SP), SI
+	MOVL	$228, AX // syscall entry - clock_gettime
+	SYSCALL
+	MOVQ	0(SP), AX	// sec
+	MOVQ	8(SP), DX	// nsec
+	IMULQ	$1000000000, AX
+	ADDQ	DX, AX
+	RET
+
 TEXT runtime·rtsigprocmask(SB),7,$0-32
 	MOVL	8(SP), DI
 	MOVQ	16(SP), SI
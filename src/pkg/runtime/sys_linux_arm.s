@@ -193,6 +193,30 @@ TEXT runtime·nanotime(SB),7,$32
 	MOVW	R1, 4(R3)
 	RET
 
+// int64 monotime(void) so really
+// void monotime(int64 *nsec)
+// Like nanotime, but reads CLOCK_MONOTONIC, which is not
+// affected by adjustments to the system clock.
+TEXT runtime·monotime(SB),7,$32
+	MOVW	$1, R0  // CLOCK_MONOTONIC
+	MOVW	$8(R13), R1  // timespec
+	MOVW	$SYS_clock_gettime, R7
+	SWI	$0
+	
+	MOVW	8(R13), R0  // sec
+	MOVW	12(R13), R2  // nsec
+	
+	MOVW	$1000000000, R3
+	MULLU	R0, R3, (R1, R0)
+	MOVW	$0, R4
+	ADD.S	R2, R0
+	ADC	R4, R1
+
+	MOVW	0(FP), R3
+	MOVW	R0, 0(R3)
+	MOVW	R1, 4(R3)
+	RET
+
 // int32 futex(int32 *uaddr, int32 op, int32 val,
 //	struct timespec *timeout, int32 *uaddr2, int32 val2);
 TEXT runtime·futex(SB),7,$0
@@ -150,6 +150,31 @@ TEXT runtime·nanotime(SB),7,$32
 	MOVL	DX, 4(DI)
 	RET
 
+// int64 monotime(void) so really
+// void monotime(int64 *nsec)
+TEXT runtime·monotime(SB),7,$32
+	LEAL	12(SP), BX
+	MOVL	$3, 4(SP)		// arg 1 - clock_id CLOCK_MONOTONIC
+	MOVL	BX, 8(SP)		// arg 2 - tp
+	MOVL	$427, AX		// sys_clock_gettime
+	INT	$0x80
+
+	MOVL	16(SP), CX		// sec - h32
+	IMULL	$1000000000, CX
+
+	MOVL	12(SP), AX		// sec - l32
+	MOVL	$1000000000, BX
+	MULL	BX			// result in dx:ax
+
+	MOVL	20(SP), BX		// nsec
+	ADDL	BX, AX
+	ADCL	CX, DX			// add high bits with carry
+
+	MOVL	ret+0(FP), DI
+	MOVL	AX, 0(DI)
+	MOVL	DX, 4(DI)
+	RET
+
 TEXT runtime·getcontext(SB),7,$-4
 	MOVL	$307, AX		// sys_getcontext
 	INT	$0x80
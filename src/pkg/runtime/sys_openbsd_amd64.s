@@ -178,6 +178,20 @@ TEXT runtime·nanotime(SB),7,$24
 	ADDQ	DX, AX
 	RET
 
+TEXT runtime·monotime(SB),7,$24
+	MOVQ	$3, DI			// arg 1 - clock_id CLOCK_MONOTONIC
+	LEAQ	8(SP), SI		// arg 2 - tp
+	MOVL	$232, AX		// sys_clock_gettime
+	SYSCALL
+	MOVL	8(SP), AX		// sec
+	MOVQ	16(SP), DX		// nsec
+
+	// sec is in AX, nsec in DX
+	// return nsec in AX
+	IMULQ	$1000000000, AX
+	ADDQ	DX, AX
+	RET
+
 TEXT runtime·sigaction(SB),7,$-8
 	MOVL	8(SP), DI		// arg 1 - signum
 	MOVQ	16(SP), SI		// arg 2 - nsa
@@ -17,3 +17,13 @@ func ForceUSPacificForTesting() {
 	ResetLocalOnceForTest()
 	localOnce.Do(initTestingZone)
 }
+
+// GetMono returns the monotonic clock reading recorded in t, or 0.
+func GetMono(t *Time) int64 {
+	return t.mono
+}
+
+// SetMono sets the monotonic clock reading recorded in t.
+func SetMono(t *Time, m int64) {
+	t.mono = m
+}
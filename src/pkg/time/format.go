@@ -396,8 +396,27 @@ func formatNano(b []byte, nanosec uint, n int, trim bool) []byte {
 
 // String returns the time formatted using the format string
 //	"2006-01-02 15:04:05.999999999 -0700 MST"
+//
+// If the time has a monotonic clock reading, the returned string
+// includes a final field "m=±<value>", where value is the monotonic
+// clock reading formatted as a decimal number of seconds.
 func (t Time) String() string {
-	return t.Format("2006-01-02 15:04:05.999999999 -0700 MST")
+	s := t.Format("2006-01-02 15:04:05.999999999 -0700 MST")
+	if t.mono != 0 {
+		m := t.mono
+		sign := byte('+')
+		if m < 0 {
+			sign = '-'
+			m = -m
+		}
+		buf := make([]byte, 0, 24)
+		buf = append(buf, " m="...)
+		buf = append(buf, sign)
+		buf = appendUint(buf, uint(m/1e9), 0)
+		buf = formatNano(buf, uint(m%1e9), 9, false)
+		s += string(buf)
+	}
+	return s
 }
 
 // Format returns a textual representation of the time value formatted
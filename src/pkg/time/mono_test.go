@@ -0,0 +1,167 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"strings"
+	"testing"
+	. "time"
+)
+
+func TestHasMonotonicClock(t *testing.T) {
+	yes := func(expr string, tt Time) {
+		if GetMono(&tt) == 0 {
+			t.Errorf("%s: missing monotonic clock reading", expr)
+		}
+	}
+	no := func(expr string, tt Time) {
+		if GetMono(&tt) != 0 {
+			t.Errorf("%s: unexpected monotonic clock reading", expr)
+		}
+	}
+
+	yes("Now()", Now())
+	yes("Now().Add(1)", Now().Add(1))
+	no("Unix(1, 0)", Unix(1, 0))
+	no("Date(2009, 11, 23, 0, 0, 0, 0, UTC)", Date(2009, 11, 23, 0, 0, 0, 0, UTC))
+	no("Now().Round(0)", Now().Round(0))
+	no("Now().Round(Second)", Now().Round(Second))
+	no("Now().Truncate(0)", Now().Truncate(0))
+	no("Now().Truncate(Second)", Now().Truncate(Second))
+	no("Now().In(UTC)", Now().In(UTC))
+	no("Now().UTC()", Now().UTC())
+	no("Now().Local()", Now().Local())
+	no("Now().AddDate(1, 0, 0)", Now().AddDate(1, 0, 0))
+
+	tm := Now()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(tm); err != nil {
+		t.Fatal(err)
+	}
+	var gobTm Time
+	SetMono(&gobTm, 12345)
+	if err := gob.NewDecoder(&buf).Decode(&gobTm); err != nil {
+		t.Fatal(err)
+	}
+	no("gob round trip", gobTm)
+
+	b, err := json.Marshal(tm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jsonTm Time
+	if err := json.Unmarshal(b, &jsonTm); err != nil {
+		t.Fatal(err)
+	}
+	no("json round trip", jsonTm)
+}
+
+func TestMonotonicAdd(t *testing.T) {
+	tm := Unix(1486057371, 123456789)
+	SetMono(&tm, 123456789)
+	t2 := tm.Add(1e8)
+	if m := GetMono(&t2); m != 223456789 {
+		t.Errorf("t.Add(1e8) mono = %d, want 223456789", m)
+	}
+	if t2.Nanosecond() != 223456789 {
+		t.Errorf("t.Add(1e8) wall nsec = %d, want 223456789", t2.Nanosecond())
+	}
+
+	t3 := tm.Add(-9e18)
+	if m := GetMono(&t3); m != 123456789-9e18 {
+		t.Errorf("t.Add(-9e18) mono = %d, want %d", m, int64(123456789-9e18))
+	}
+
+	SetMono(&tm, -5e18)
+	t4 := tm.Add(-9e18) // overflows the monotonic reading
+	if m := GetMono(&t4); m != 0 {
+		t.Errorf("t.Add(-9e18) after overflow: mono = %d, want 0", m)
+	}
+	if want := Unix(1486057371-9e9, 123456789); !t4.Equal(want) {
+		t.Errorf("t.Add(-9e18) after overflow = %v, want %v", t4, want)
+	}
+}
+
+func TestMonotonicSub(t *testing.T) {
+	// t1 and t2 disagree on wall and monotonic order:
+	// the monotonic readings must win when both are present.
+	t1 := Unix(1483228799, 995e6)
+	SetMono(&t1, 123456789)
+	t2 := Unix(1483228799, 5e6)
+	SetMono(&t2, 123456789+10e6)
+
+	if d := t2.Sub(t1); d != 10*Millisecond {
+		t.Errorf("t2.Sub(t1) = %v, want 10ms", d)
+	}
+	if d := t1.Sub(t2); d != -10*Millisecond {
+		t.Errorf("t1.Sub(t2) = %v, want -10ms", d)
+	}
+	if !t1.Before(t2) || t1.After(t2) || t1.Equal(t2) {
+		t.Errorf("monotonic comparisons of t1 and t2 are wrong")
+	}
+
+	// Without a monotonic reading on either side, the wall clock is used.
+	w1 := t1.Round(0)
+	if d := t2.Sub(w1); d != -990*Millisecond {
+		t.Errorf("t2.Sub(t1.Round(0)) = %v, want -990ms", d)
+	}
+	if !w1.After(t2) || w1.Before(t2) {
+		t.Errorf("wall clock comparisons of t1.Round(0) and t2 are wrong")
+	}
+
+	// Equal times with different wall clocks but the same monotonic reading.
+	t3 := t1.Add(0)
+	SetMono(&t3, GetMono(&t1))
+	if !t1.Equal(t3) {
+		t.Errorf("t1.Equal(t3) = false, want true")
+	}
+
+	// Sub saturates when the monotonic difference overflows.
+	t4, t5 := Unix(0, 0), Unix(0, 0)
+	SetMono(&t4, 1<<62+1<<61)
+	SetMono(&t5, -(1<<62 + 1<<61))
+	if d := t4.Sub(t5); d != Duration(1<<63-1) {
+		t.Errorf("t4.Sub(t5) = %d, want maxDuration", d)
+	}
+	if d := t5.Sub(t4); d != Duration(-1<<63) {
+		t.Errorf("t5.Sub(t4) = %d, want minDuration", d)
+	}
+}
+
+func TestMonotonicString(t *testing.T) {
+	tm := Date(2009, 11, 23, 0, 0, 0, 0, UTC)
+	if s := tm.String(); strings.Contains(s, "m=") {
+		t.Errorf("String without monotonic reading = %q, want no m=", s)
+	}
+	for _, tt := range []struct {
+		mono int64
+		want string
+	}{
+		{1, "m=+0.000000001"},
+		{123456789, "m=+0.123456789"},
+		{3e9 + 5, "m=+3.000000005"},
+		{-1500000000, "m=-1.500000000"},
+	} {
+		SetMono(&tm, tt.mono)
+		want := "2009-11-23 00:00:00 +0000 UTC " + tt.want
+		if s := tm.String(); s != want {
+			t.Errorf("String with mono %d = %q, want %q", tt.mono, s, want)
+		}
+	}
+}
+
+func TestMonotonicSince(t *testing.T) {
+	start := Now()
+	Sleep(10 * Millisecond)
+	if d := Since(start); d < 10*Millisecond {
+		t.Errorf("Since after Sleep(10ms) = %v, want >= 10ms", d)
+	}
+	if !start.Before(Now()) {
+		t.Errorf("start.Before(Now()) = false, want true")
+	}
+}
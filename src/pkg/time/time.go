@@ -5,6 +5,47 @@
 // Package time provides functionality for measuring and displaying time.
 //
 // The calendrical calculations always assume a Gregorian calendar.
+//
+// Monotonic Clocks
+//
+// Operating systems provide both a "wall clock," which is subject to
+// changes for clock synchronization, and a "monotonic clock," which is
+// not. The general rule is that the wall clock is for telling time and
+// the monotonic clock is for measuring time. Rather than split the API,
+// in this package the Time returned by Now contains both a wall clock
+// reading and a monotonic clock reading; later time-telling operations
+// use the wall clock reading, but later time-measuring operations,
+// specifically comparisons and subtractions, use the monotonic clock
+// reading.
+//
+// For example, this code always computes a positive elapsed time of
+// approximately 20 milliseconds, even if the wall clock is changed during
+// the operation being timed:
+//
+//	start := time.Now()
+//	... operation that takes 20 milliseconds ...
+//	t := time.Now()
+//	elapsed := t.Sub(start)
+//
+// Other idioms, such as time.Since(start) and t.Before(deadline), are
+// similarly robust against wall clock resets.
+//
+// If Times t and u both contain monotonic clock readings, the operations
+// t.After(u), t.Before(u), t.Equal(u), and t.Sub(u) are carried out using
+// the monotonic clock readings alone, ignoring the wall clock readings.
+// If either t or u contains no monotonic clock reading, these operations
+// fall back to using the wall clock readings.
+//
+// t.Add(d) preserves the monotonic clock reading. Because t.In, t.Local,
+// and t.UTC are used for their effect on the interpretation of the wall
+// time, they strip any monotonic clock reading from their results, as do
+// t.AddDate, t.Round, and t.Truncate, which are wall time computations.
+// The canonical way to strip a monotonic clock reading is to use
+// t = t.Round(0).
+//
+// The monotonic clock reading exists only in Time values. It is not part
+// of the gob or JSON encodings of a Time, and String reports it only as
+// a debugging aid.
 package time
 
 import "errors"
@@ -20,6 +61,14 @@ import "errors"
 // The Sub method subtracts two instants, producing a Duration.
 // The Add method adds a Time and a Duration, producing a Time.
 //
+// In addition to the required wall clock reading, a Time may contain an
+// optional reading of the current process's monotonic clock, to provide
+// additional precision for comparison or subtraction.
+// See the "Monotonic Clocks" section in the package documentation for details.
+// Because the monotonic clock reading has no meaning outside the current
+// process, t == u comparisons may report false for Times denoting the same
+// instant; use the Equal method instead.
+//
 // The zero value of type Time is January 1, year 1, 00:00:00.000000000 UTC.
 // As this time is unlikely to come up in practice, the IsZero method gives
 // a simple way of detecting a time that has not been initialized explicitly.
@@ -54,15 +103,31 @@ type Time struct {
 	// Only the zero Time has a nil Location.
 	// In that case it is interpreted to mean UTC.
 	loc *Location
+
+	// mono holds a monotonic clock reading, in nanoseconds since
+	// the process started, as recorded by Now. It is zero if the
+	// Time has no monotonic clock reading.
+	mono int64
+}
+
+// stripMono strips the monotonic clock reading in t.
+func (t *Time) stripMono() {
+	t.mono = 0
 }
 
 // After reports whether the time instant t is after u.
 func (t Time) After(u Time) bool {
+	if t.mono != 0 && u.mono != 0 {
+		return t.mono > u.mono
+	}
 	return t.sec > u.sec || t.sec == u.sec && t.nsec > u.nsec
 }
 
 // Before reports whether the time instant t is before u.
 func (t Time) Before(u Time) bool {
+	if t.mono != 0 && u.mono != 0 {
+		return t.mono < u.mono
+	}
 	return t.sec < u.sec || t.sec == u.sec && t.nsec < u.nsec
 }
 
@@ -72,6 +137,9 @@ func (t Time) Before(u Time) bool {
 // This comparison is different from using t == u, which also compares
 // the locations.
 func (t Time) Equal(u Time) bool {
+	if t.mono != 0 && u.mono != 0 {
+		return t.mono == u.mono
+	}
 	return t.sec == u.sec && t.nsec == u.nsec
 }
 
@@ -621,6 +689,15 @@ func (t Time) Add(d Duration) Time {
 		nsec += 1e9
 	}
 	t.nsec = uintptr(nsec)
+	if t.mono != 0 {
+		te := t.mono + int64(d)
+		if d < 0 && te > t.mono || d > 0 && te < t.mono || te == 0 {
+			// The monotonic reading overflowed; drop it.
+			t.stripMono()
+		} else {
+			t.mono = te
+		}
+	}
 	return t
 }
 
@@ -629,6 +706,17 @@ func (t Time) Add(d Duration) Time {
 // will be returned.
 // To compute t-d for a duration d, use t.Add(-d).
 func (t Time) Sub(u Time) Duration {
+	if t.mono != 0 && u.mono != 0 {
+		te, ue := t.mono, u.mono
+		d := Duration(te - ue)
+		switch {
+		case d < 0 && te > ue:
+			return maxDuration // t - u is positive out of range
+		case d > 0 && te < ue:
+			return minDuration // t - u is negative out of range
+		}
+		return d
+	}
 	d := Duration(t.sec-u.sec)*Second + Duration(int32(t.nsec)-int32(u.nsec))
 	// Check for overflow or underflow.
 	switch {
@@ -780,25 +868,40 @@ func daysIn(m Month, year int) int {
 // Provided by package runtime.
 func now() (sec int64, nsec int32)
 
+// runtimeNano returns the current value of the runtime's monotonic clock.
+// Provided by package runtime.
+func runtimeNano() int64
+
+// startNano is the monotonic clock reading at process start, less one,
+// so that readings recorded by Now are always positive and a zero mono
+// field can mean "no reading".
+var startNano = runtimeNano() - 1
+
 // Now returns the current local time.
 func Now() Time {
 	sec, nsec := now()
-	return Time{sec + unixToInternal, uintptr(nsec), Local}
+	mono := runtimeNano() - startNano
+	return Time{sec + unixToInternal, uintptr(nsec), Local, mono}
 }
 
-// UTC returns t with the location set to UTC.
+// UTC returns t with the location set to UTC
+// and any monotonic clock reading stripped.
 func (t Time) UTC() Time {
 	t.loc = UTC
+	t.stripMono()
 	return t
 }
 
-// Local returns t with the location set to local time.
+// Local returns t with the location set to local time
+// and any monotonic clock reading stripped.
 func (t Time) Local() Time {
 	t.loc = Local
+	t.stripMono()
 	return t
 }
 
-// In returns t with the location information set to loc.
+// In returns t with the location information set to loc
+// and any monotonic clock reading stripped.
 //
 // In panics if loc is nil.
 func (t Time) In(loc *Location) Time {
@@ -806,6 +909,7 @@ func (t Time) In(loc *Location) Time {
 		panic("time: missing Location in call to Time.In")
 	}
 	t.loc = loc
+	t.stripMono()
 	return t
 }
 
@@ -911,6 +1015,7 @@ func (t *Time) GobDecode(buf []byte) error {
 	} else {
 		t.loc = FixedZone("", offset)
 	}
+	t.stripMono()
 
 	return nil
 }
@@ -945,7 +1050,7 @@ func Unix(sec int64, nsec int64) Time {
 			sec--
 		}
 	}
-	return Time{sec + unixToInternal, uintptr(nsec), Local}
+	return Time{sec + unixToInternal, uintptr(nsec), Local, 0}
 }
 
 func isLeap(year int) bool {
@@ -1054,12 +1159,13 @@ func Date(year int, month Month, day, hour, min, sec, nsec int, loc *Location) T
 		unix -= int64(offset)
 	}
 
-	return Time{unix + unixToInternal, uintptr(nsec), loc}
+	return Time{unix + unixToInternal, uintptr(nsec), loc, 0}
 }
 
 // Truncate returns the result of rounding t down to a multiple of d (since the zero time).
-// If d <= 0, Truncate returns t unchanged.
+// If d <= 0, Truncate returns t stripped of any monotonic clock reading but otherwise unchanged.
 func (t Time) Truncate(d Duration) Time {
+	t.stripMono()
 	if d <= 0 {
 		return t
 	}
@@ -1069,8 +1175,9 @@ func (t Time) Truncate(d Duration) Time {
 
 // Round returns the result of rounding t to the nearest multiple of d (since the zero time).
 // The rounding behavior for halfway values is to round up.
-// If d <= 0, Round returns t unchanged.
+// If d <= 0, Round returns t stripped of any monotonic clock reading but otherwise unchanged.
 func (t Time) Round(d Duration) Time {
+	t.stripMono()
 	if d <= 0 {
 		return t
 	}
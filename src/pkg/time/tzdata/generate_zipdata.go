@@ -0,0 +1,93 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// This program generates zzipdata.go from $GOROOT/lib/time/zoneinfo.zip.
+// The zone files are copied into a new zip archive, deflating each one,
+// and the archive is written out as a string constant.
+//
+// Run it from the time/tzdata directory:
+//
+//	go run generate_zipdata.go
+
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+)
+
+func main() {
+	src := filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip")
+	if len(os.Args) > 1 {
+		src = os.Args[1]
+	}
+	r, err := zip.OpenReader(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		h := &zip.FileHeader{
+			Name:   f.Name,
+			Method: zip.Deflate,
+		}
+		h.SetModTime(f.ModTime())
+		dst, err := w.CreateHeader(h)
+		if err != nil {
+			log.Fatal(err)
+		}
+		rc, err := f.Open()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if _, err := io.Copy(dst, rc); err != nil {
+			log.Fatal(err)
+		}
+		rc.Close()
+	}
+	if err := w.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintln(&out, "// generated by generate_zipdata.go; DO NOT EDIT")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "package tzdata")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "// zipdata is a zip archive of the zoneinfo files, each one deflated.")
+	fmt.Fprintln(&out, "const zipdata = \"\" +")
+	data := buf.Bytes()
+	for len(data) > 0 {
+		n := 64
+		if n > len(data) {
+			n = len(data)
+		}
+		line := string(data[:n])
+		data = data[n:]
+		sep := " +"
+		if len(data) == 0 {
+			sep = ""
+		}
+		fmt.Fprintf(&out, "\t%s%s\n", strconv.Quote(line), sep)
+	}
+	if err := ioutil.WriteFile("zzipdata.go", out.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
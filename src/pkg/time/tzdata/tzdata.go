@@ -0,0 +1,75 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tzdata provides an embedded copy of the time zone database.
+// If this package is imported anywhere in the program, then if the time
+// package cannot find zoneinfo files on the system, it will use this
+// embedded information.
+//
+// Importing this package increases the size of a program by about
+// 300 KB. The zone files are stored compressed and each one is
+// decompressed only when it is loaded.
+//
+// This package should normally be imported by a program's main package,
+// not by a library. Libraries normally shouldn't decide whether to
+// include the time zone database in a program.
+//
+// The database is a compressed copy of $GOROOT/lib/time/zoneinfo.zip,
+// generated by running
+//
+//	go run generate_zipdata.go
+//
+// in this directory.
+package tzdata
+
+import (
+	"archive/zip"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+)
+
+func init() {
+	time.RegisterTZData(loadFromEmbeddedTZData)
+}
+
+var (
+	once  sync.Once
+	files map[string]*zip.File
+	err   error
+)
+
+// index builds the table of contents of the embedded database.
+func index() {
+	r, e := zip.NewReader(strings.NewReader(zipdata), int64(len(zipdata)))
+	if e != nil {
+		err = errors.New("time/tzdata: corrupt embedded database: " + e.Error())
+		return
+	}
+	files = make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+}
+
+// loadFromEmbeddedTZData returns the contents of the
+// zoneinfo file for the named zone.
+func loadFromEmbeddedTZData(name string) ([]byte, error) {
+	once.Do(index)
+	if err != nil {
+		return nil, err
+	}
+	f := files[name]
+	if f == nil {
+		return nil, errors.New("unknown time zone " + name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return ioutil.ReadAll(rc)
+}
@@ -0,0 +1,69 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tzdata
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEmbeddedZones(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		month  time.Month
+		abbrev string
+		offset int
+	}{
+		{"America/New_York", time.January, "EST", -5 * 60 * 60},
+		{"America/New_York", time.July, "EDT", -4 * 60 * 60},
+		{"Europe/Berlin", time.July, "CEST", 2 * 60 * 60},
+		{"Asia/Tokyo", time.January, "JST", 9 * 60 * 60},
+		{"Australia/Sydney", time.January, "EST", 11 * 60 * 60},
+	} {
+		data, err := loadFromEmbeddedTZData(tt.name)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		loc, err := time.LoadLocationFromTZData(tt.name, data)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if loc.String() != tt.name {
+			t.Errorf("%s: location name = %q", tt.name, loc.String())
+		}
+		abbrev, offset := time.Date(2012, tt.month, 15, 12, 0, 0, 0, loc).Zone()
+		if abbrev != tt.abbrev || offset != tt.offset {
+			t.Errorf("%s in %v: zone = %s %d, want %s %d", tt.name, tt.month, abbrev, offset, tt.abbrev, tt.offset)
+		}
+	}
+}
+
+func TestEmbeddedUnknownZone(t *testing.T) {
+	if _, err := loadFromEmbeddedTZData("Not/A_Zone"); err == nil {
+		t.Errorf("loading unknown zone succeeded")
+	}
+}
+
+func TestEmbeddedAllZones(t *testing.T) {
+	once.Do(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) < 400 {
+		t.Fatalf("embedded database has only %d zones", len(files))
+	}
+	for name := range files {
+		data, err := loadFromEmbeddedTZData(name)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if _, err := time.LoadLocationFromTZData(name, data); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
@@ -196,7 +196,9 @@ var zoneinfo, _ = syscall.Getenv("ZONEINFO")
 // LoadLocation looks in the directory or uncompressed zip file
 // named by the ZONEINFO environment variable, if any, then looks in
 // known installation locations on Unix systems,
-// and finally looks in $GOROOT/lib/time/zoneinfo.zip.
+// then looks in $GOROOT/lib/time/zoneinfo.zip,
+// and finally looks in the database embedded by package time/tzdata,
+// if that package has been imported.
 func LoadLocation(name string) (*Location, error) {
 	if name == "" || name == "UTC" {
 		return UTC, nil
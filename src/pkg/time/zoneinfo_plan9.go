@@ -152,5 +152,8 @@ func loadLocation(name string) (*Location, error) {
 		z.name = name
 		return z, nil
 	}
+	if z, err := loadEmbeddedLocation(name); err == nil {
+		return z, nil
+	}
 	return nil, errors.New("unknown time zone " + name)
 }
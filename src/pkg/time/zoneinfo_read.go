@@ -196,6 +196,49 @@ func loadZoneData(bytes []byte) (l *Location, err error) {
 	return l, nil
 }
 
+// LoadLocationFromTZData returns a Location with the given name
+// initialized from the IANA Time Zone database-formatted data.
+// The data should be in the format of a standard IANA time zone file
+// (for example, the content of /etc/localtime on Unix systems).
+func LoadLocationFromTZData(name string, data []byte) (*Location, error) {
+	l, err := loadZoneData(data)
+	if err != nil {
+		return nil, err
+	}
+	l.name = name
+	return l, nil
+}
+
+// loadFromEmbeddedTZData, if set, returns the zoneinfo data for the
+// named zone from a time zone database linked into the program.
+// It is set by RegisterTZData.
+var loadFromEmbeddedTZData func(name string) ([]byte, error)
+
+// RegisterTZData registers a function that returns the IANA Time Zone
+// database-formatted data for a zone name such as "America/New_York".
+// LoadLocation consults it when the zone cannot be found in any of the
+// usual locations on the system.
+//
+// RegisterTZData exists for the use of package time/tzdata.
+// Programs wanting an embedded database should import that package
+// for its side effect instead of calling RegisterTZData directly.
+func RegisterTZData(load func(name string) ([]byte, error)) {
+	loadFromEmbeddedTZData = load
+}
+
+// loadEmbeddedLocation loads the named zone from the
+// database registered with RegisterTZData, if any.
+func loadEmbeddedLocation(name string) (*Location, error) {
+	if loadFromEmbeddedTZData == nil {
+		return nil, errors.New("unknown time zone " + name)
+	}
+	data, err := loadFromEmbeddedTZData(name)
+	if err != nil {
+		return nil, err
+	}
+	return LoadLocationFromTZData(name, data)
+}
+
 func loadZoneFile(dir, name string) (l *Location, err error) {
 	if len(dir) > 4 && dir[len(dir)-4:] == ".zip" {
 		return loadZoneZip(dir, name)
@@ -0,0 +1,77 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package time_test
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// readZoneZip returns the raw zoneinfo data for name
+// from $GOROOT/lib/time/zoneinfo.zip.
+func readZoneZip(t *testing.T, name string) []byte {
+	r, err := zip.OpenReader(runtime.GOROOT() + "/lib/time/zoneinfo.zip")
+	if err != nil {
+		t.Skipf("cannot open zoneinfo.zip: %v", err)
+	}
+	defer r.Close()
+	for _, f := range r.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rc.Close()
+		data, err := ioutil.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	t.Fatalf("%s not found in zoneinfo.zip", name)
+	return nil
+}
+
+func TestLoadLocationFromTZData(t *testing.T) {
+	const name = "America/Los_Angeles"
+	loc, err := time.LoadLocationFromTZData(name, readZoneZip(t, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loc.String() != name {
+		t.Errorf("location name = %q, want %q", loc.String(), name)
+	}
+	for _, tt := range []struct {
+		month  time.Month
+		abbrev string
+		offset int
+	}{
+		{time.January, "PST", -8 * 60 * 60},
+		{time.July, "PDT", -7 * 60 * 60},
+	} {
+		abbrev, offset := time.Date(2012, tt.month, 1, 12, 0, 0, 0, loc).Zone()
+		if abbrev != tt.abbrev || offset != tt.offset {
+			t.Errorf("zone in %v = %s %d, want %s %d", tt.month, abbrev, offset, tt.abbrev, tt.offset)
+		}
+	}
+}
+
+func TestLoadLocationFromTZDataBadData(t *testing.T) {
+	for _, data := range []string{
+		"",
+		"TZif",
+		"not a zoneinfo file",
+		"TZif2\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+	} {
+		if _, err := time.LoadLocationFromTZData("Bad", []byte(data)); err == nil {
+			t.Errorf("LoadLocationFromTZData(%q) succeeded, want error", data)
+		}
+	}
+}
@@ -68,5 +68,8 @@ func loadLocation(name string) (*Location, error) {
 			return z, nil
 		}
 	}
+	if z, err := loadEmbeddedLocation(name); err == nil {
+		return z, nil
+	}
 	return nil, errors.New("unknown time zone " + name)
 }